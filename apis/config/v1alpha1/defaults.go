@@ -35,6 +35,14 @@ func SetDefaults_LandscaperConfiguration(obj *LandscaperConfiguration) {
 	SetDefaults_CommonControllerConfig(&obj.Controllers.Executions.CommonControllerConfig)
 	SetDefaults_CommonControllerConfig(&obj.Controllers.DeployItems.CommonControllerConfig)
 	SetDefaults_CommonControllerConfig(&obj.Controllers.Contexts.CommonControllerConfig)
+	SetDefaults_CommonControllerConfig(&obj.Controllers.Orphans.CommonControllerConfig)
+	if obj.Controllers.Installations.FailedReconcile == nil {
+		obj.Controllers.Installations.FailedReconcile = &FailedReconcileDefaults{}
+	}
+	SetDefaults_FailedReconcileDefaults(obj.Controllers.Installations.FailedReconcile)
+	if obj.Controllers.Orphans.GracePeriod == nil {
+		obj.Controllers.Orphans.GracePeriod = &metav1.Duration{Duration: 24 * time.Hour}
+	}
 
 	if len(obj.DeployerManagement.Namespace) == 0 {
 		obj.DeployerManagement.Namespace = "ls-system"
@@ -53,6 +61,9 @@ func SetDefaults_LandscaperConfiguration(obj *LandscaperConfiguration) {
 	}
 
 	SetDefaults_BlueprintStore(&obj.BlueprintStore)
+	if obj.DataObjectStorage != nil {
+		SetDefaults_DataObjectStorageConfiguration(obj.DataObjectStorage)
+	}
 	SetDefaults_CrdManagementConfiguration(&obj.CrdManagement)
 
 	if obj.RepositoryContext != nil && obj.Controllers.Contexts.Config.Default.RepositoryContext == nil {
@@ -157,3 +168,29 @@ func SetDefaults_BlueprintStore(obj *BlueprintStore) {
 		obj.PreservedHitsProportion = PreservedHitsProportion
 	}
 }
+
+// SetDefaults_FailedReconcileDefaults sets the defaults for the automatically repeated reconciliations of failed installations.
+func SetDefaults_FailedReconcileDefaults(obj *FailedReconcileDefaults) {
+	if obj.Interval == nil {
+		obj.Interval = &v1alpha1.Duration{Duration: 5 * time.Minute}
+	}
+	if obj.ErrorCodes == nil {
+		obj.ErrorCodes = &v1alpha1.RetryErrorCodes{
+			NoRetry:   []v1alpha1.ErrorCode{v1alpha1.ErrorConfigurationProblem},
+			FastRetry: []v1alpha1.ErrorCode{v1alpha1.ErrorRegistryProblem},
+		}
+	}
+	if obj.ErrorCodes.FastRetryInterval == nil {
+		obj.ErrorCodes.FastRetryInterval = &v1alpha1.Duration{Duration: 30 * time.Second}
+	}
+}
+
+// SetDefaults_DataObjectStorageConfiguration sets the defaults for the data object storage configuration.
+func SetDefaults_DataObjectStorageConfiguration(obj *DataObjectStorageConfiguration) {
+	if len(obj.Threshold) == 0 {
+		obj.Threshold = "256Ki"
+	}
+	if len(obj.ChunkSize) == 0 {
+		obj.ChunkSize = "512Ki"
+	}
+}
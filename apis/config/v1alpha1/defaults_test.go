@@ -61,9 +61,28 @@ var _ = Describe("Defaults", func() {
 			checkCommonConfig(&cfg.Controllers.Executions.CommonControllerConfig)
 			checkCommonConfig(&cfg.Controllers.DeployItems.CommonControllerConfig)
 			checkCommonConfig(&cfg.Controllers.Contexts.CommonControllerConfig)
+			checkCommonConfig(&cfg.Controllers.Orphans.CommonControllerConfig)
 		})
 	})
 
+	It("should default the grace period of the orphans controller", func() {
+		cfg := &v1alpha1.LandscaperConfiguration{}
+		v1alpha1.SetDefaults_LandscaperConfiguration(cfg)
+		Expect(cfg.Controllers.Orphans.GracePeriod).ToNot(BeNil())
+		Expect(cfg.Controllers.Orphans.GracePeriod.Duration).To(Equal(24 * time.Hour))
+	})
+
+	It("should default the data object storage only if it is configured", func() {
+		cfg := &v1alpha1.LandscaperConfiguration{}
+		v1alpha1.SetDefaults_LandscaperConfiguration(cfg)
+		Expect(cfg.DataObjectStorage).To(BeNil())
+
+		cfg.DataObjectStorage = &v1alpha1.DataObjectStorageConfiguration{ChunkSize: "100Ki"}
+		v1alpha1.SetDefaults_LandscaperConfiguration(cfg)
+		Expect(cfg.DataObjectStorage.Threshold).To(Equal("256Ki"))
+		Expect(cfg.DataObjectStorage.ChunkSize).To(Equal("100Ki"))
+	})
+
 	It("should default the repository context in the context controller", func() {
 		repoCtx, _ := cdv2.NewUnstructured(cdv2.NewOCIRegistryRepository("example.com", ""))
 		cfg := &v1alpha1.LandscaperConfiguration{}
@@ -29,6 +29,10 @@ type LandscaperConfiguration struct {
 	// Metrics allows to configure how metrics are exposed
 	//+optional
 	Metrics *MetricsConfiguration `json:"metrics,omitempty"`
+	// Tracing configures the export of traces of the landscaper controllers.
+	// Tracing is disabled if not set.
+	// +optional
+	Tracing *TracingConfiguration `json:"tracing,omitempty"`
 	// CrdManagement configures whether the landscaper controller should deploy the CRDs it needs into the cluster
 	// +optional
 	CrdManagement CrdManagementConfiguration `json:"crdManagement,omitempty"`
@@ -41,6 +45,10 @@ type LandscaperConfiguration struct {
 	// LsDeployments contains the names of the landscaper deployments
 	// +optional
 	LsDeployments *LsDeployments `json:"lsDeployments,omitempty"`
+	// DataObjectStorage configures the storage of large DataObject values in a blob store.
+	// The data is always stored in the DataObject itself if not set.
+	// +optional
+	DataObjectStorage *DataObjectStorageConfiguration `json:"dataObjectStorage,omitempty"`
 }
 
 // LsDeployments contains the names of the landscaper deployments.
@@ -88,11 +96,34 @@ type Controllers struct {
 	DeployItems DeployItemsController `json:"deployItems"`
 	// Contexts contains the controller config that reconciles context objects.
 	Contexts ContextsController `json:"contexts"`
+	// Orphans contains the controller config that detects and deletes orphaned DataObjects and Targets.
+	Orphans OrphansController `json:"orphans"`
 }
 
 // InstallationsController contains the controller config that reconciles installations.
 type InstallationsController struct {
 	CommonControllerConfig
+	// FailedReconcile contains the defaults for the automatically repeated reconciliations of failed installations.
+	// The defaults are used for all settings that are not configured in the installation.
+	// +optional
+	FailedReconcile *FailedReconcileDefaults `json:"failedReconcile,omitempty"`
+}
+
+// FailedReconcileDefaults contains the defaults for the automatically repeated reconciliations of failed installations.
+// Repeated reconciliations are only triggered for installations that activate them with spec.automaticReconcile.failedReconcile.
+type FailedReconcileDefaults struct {
+	// Interval specifies the default interval between two subsequent repeated reconciliations.
+	// Defaults to 5 minutes.
+	// +optional
+	Interval *lsv1alpha1.Duration `json:"interval,omitempty"`
+	// Backoff configures the default exponential backoff.
+	// The interval is constant if not set.
+	// +optional
+	Backoff *lsv1alpha1.RetryBackoff `json:"backoff,omitempty"`
+	// ErrorCodes configures the default retry decisions depending on the error codes of the last error.
+	// Defaults to no retries for configuration problems and fast retries for registry problems.
+	// +optional
+	ErrorCodes *lsv1alpha1.RetryErrorCodes `json:"errorCodes,omitempty"`
 }
 
 // ExecutionsController contains the controller config that reconciles executions.
@@ -111,6 +142,21 @@ type ContextsController struct {
 	Config ContextControllerConfig `json:"config"`
 }
 
+// OrphansController contains the configuration for the controller that detects DataObjects and Targets
+// that are neither produced nor consumed by any installation.
+type OrphansController struct {
+	CommonControllerConfig
+	// Disable disables the detection of orphaned DataObjects and Targets.
+	Disable bool `json:"disable"`
+	// DeleteOrphans enables the deletion of orphaned DataObjects and Targets after the grace period.
+	// Orphaned objects are only reported if not enabled.
+	DeleteOrphans bool `json:"deleteOrphans"`
+	// GracePeriod defines how long an object has to be orphaned before it is deleted.
+	// Defaults to 24 hours.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
 // ContextControllerConfig contains the context specific configuration.
 type ContextControllerConfig struct {
 	Default ContextControllerDefaultConfig `json:"default"`
@@ -157,6 +203,11 @@ type RegistryConfiguration struct {
 	// OCI defines a oci registry to use for definitions
 	// +optional
 	OCI *OCIConfiguration `json:"oci,omitempty"`
+
+	// CTF defines a registry for component archives and common transport format archives
+	// that are stored in a filesystem.
+	// +optional
+	CTF *CTFRegistryConfiguration `json:"ctf,omitempty"`
 }
 
 // LocalRegistryConfiguration contains the configuration for a local registry
@@ -166,6 +217,14 @@ type LocalRegistryConfiguration struct {
 	RootPath string `json:"rootPath"`
 }
 
+// CTFRegistryConfiguration contains the configuration for a registry of component archives
+// and common transport format archives.
+type CTFRegistryConfiguration struct {
+	// RootPath configures the root path of the registry.
+	// The file paths in the repository contexts of type "ctf" are relative to this path.
+	RootPath string `json:"rootPath"`
+}
+
 // OCIConfiguration holds configuration for the oci registry
 type OCIConfiguration struct {
 	// ConfigFiles path to additional docker configuration files
@@ -200,6 +259,19 @@ type MetricsConfiguration struct {
 	Port int32 `json:"port"`
 }
 
+// TracingConfiguration allows to configure how traces are exported
+type TracingConfiguration struct {
+	// Endpoint is the address of the OTLP gRPC collector, e.g. "otel-collector.monitoring:4317".
+	Endpoint string `json:"endpoint"`
+	// Insecure disables the transport security of the connection to the collector.
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+	// ServiceName is the name of the service that is reported with the traces.
+	// Defaults to "landscaper".
+	// +optional
+	ServiceName string `json:"serviceName,omitempty"`
+}
+
 // CrdManagementConfiguration contains the configuration of the CRD management
 type CrdManagementConfiguration struct {
 	// DeployCustomResourceDefinitions specifies if CRDs should be deployed
@@ -261,6 +333,32 @@ type BlueprintStore struct {
 	GarbageCollectionConfiguration
 }
 
+// DataObjectStorageConfiguration contains the configuration for the storage of large DataObject values.
+type DataObjectStorageConfiguration struct {
+	// Type is the type of the blob store the data is stored in.
+	// One of "Secret", "ConfigMap" or "OCI".
+	Type lsv1alpha1.DataObjectBlobStoreType `json:"type"`
+	// Threshold is the size above which the data of a DataObject is stored in the blob store.
+	// See the kubernetes quantity docs for detailed description of the format.
+	// Defaults to 256Ki.
+	// +optional
+	Threshold string `json:"threshold,omitempty"`
+	// ChunkSize is the maximal size of a secret or config map chunk.
+	// Defaults to 512Ki.
+	// +optional
+	ChunkSize string `json:"chunkSize,omitempty"`
+	// OCI configures the oci blob store.
+	// The oci registry is accessed with the credentials of the registry configuration.
+	// +optional
+	OCI *DataObjectOCIStorageConfiguration `json:"oci,omitempty"`
+}
+
+// DataObjectOCIStorageConfiguration contains the configuration for the storage of DataObject values in an oci registry.
+type DataObjectOCIStorageConfiguration struct {
+	// Repository is the oci repository the data is pushed to, e.g. "example.com/landscaper/dataobjects".
+	Repository string `json:"repository"`
+}
+
 // GarbageCollectionConfiguration contains all options for the cache garbage collection.
 type GarbageCollectionConfiguration struct {
 	// Size is the size of the filesystem.
@@ -50,6 +50,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CTFRegistryConfiguration)(nil), (*config.CTFRegistryConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CTFRegistryConfiguration_To_config_CTFRegistryConfiguration(a.(*CTFRegistryConfiguration), b.(*config.CTFRegistryConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CTFRegistryConfiguration)(nil), (*CTFRegistryConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CTFRegistryConfiguration_To_v1alpha1_CTFRegistryConfiguration(a.(*config.CTFRegistryConfiguration), b.(*CTFRegistryConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CommonControllerConfig)(nil), (*config.CommonControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CommonControllerConfig_To_config_CommonControllerConfig(a.(*CommonControllerConfig), b.(*config.CommonControllerConfig), scope)
 	}); err != nil {
@@ -110,6 +120,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DataObjectOCIStorageConfiguration)(nil), (*config.DataObjectOCIStorageConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DataObjectOCIStorageConfiguration_To_config_DataObjectOCIStorageConfiguration(a.(*DataObjectOCIStorageConfiguration), b.(*config.DataObjectOCIStorageConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DataObjectOCIStorageConfiguration)(nil), (*DataObjectOCIStorageConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DataObjectOCIStorageConfiguration_To_v1alpha1_DataObjectOCIStorageConfiguration(a.(*config.DataObjectOCIStorageConfiguration), b.(*DataObjectOCIStorageConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DataObjectStorageConfiguration)(nil), (*config.DataObjectStorageConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DataObjectStorageConfiguration_To_config_DataObjectStorageConfiguration(a.(*DataObjectStorageConfiguration), b.(*config.DataObjectStorageConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DataObjectStorageConfiguration)(nil), (*DataObjectStorageConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DataObjectStorageConfiguration_To_v1alpha1_DataObjectStorageConfiguration(a.(*config.DataObjectStorageConfiguration), b.(*DataObjectStorageConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DeployItemTimeouts)(nil), (*config.DeployItemTimeouts)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DeployItemTimeouts_To_config_DeployItemTimeouts(a.(*DeployItemTimeouts), b.(*config.DeployItemTimeouts), scope)
 	}); err != nil {
@@ -150,6 +180,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FailedReconcileDefaults)(nil), (*config.FailedReconcileDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FailedReconcileDefaults_To_config_FailedReconcileDefaults(a.(*FailedReconcileDefaults), b.(*config.FailedReconcileDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.FailedReconcileDefaults)(nil), (*FailedReconcileDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FailedReconcileDefaults_To_v1alpha1_FailedReconcileDefaults(a.(*config.FailedReconcileDefaults), b.(*FailedReconcileDefaults), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GarbageCollectionConfiguration)(nil), (*config.GarbageCollectionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_GarbageCollectionConfiguration_To_config_GarbageCollectionConfiguration(a.(*GarbageCollectionConfiguration), b.(*config.GarbageCollectionConfiguration), scope)
 	}); err != nil {
@@ -240,6 +280,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*OrphansController)(nil), (*config.OrphansController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OrphansController_To_config_OrphansController(a.(*OrphansController), b.(*config.OrphansController), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.OrphansController)(nil), (*OrphansController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_OrphansController_To_v1alpha1_OrphansController(a.(*config.OrphansController), b.(*OrphansController), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RegistryConfiguration)(nil), (*config.RegistryConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RegistryConfiguration_To_config_RegistryConfiguration(a.(*RegistryConfiguration), b.(*config.RegistryConfiguration), scope)
 	}); err != nil {
@@ -250,6 +300,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TracingConfiguration)(nil), (*config.TracingConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TracingConfiguration_To_config_TracingConfiguration(a.(*TracingConfiguration), b.(*config.TracingConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TracingConfiguration)(nil), (*TracingConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TracingConfiguration_To_v1alpha1_TracingConfiguration(a.(*config.TracingConfiguration), b.(*TracingConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -311,6 +371,26 @@ func Convert_config_BlueprintStore_To_v1alpha1_BlueprintStore(in *config.Bluepri
 	return autoConvert_config_BlueprintStore_To_v1alpha1_BlueprintStore(in, out, s)
 }
 
+func autoConvert_v1alpha1_CTFRegistryConfiguration_To_config_CTFRegistryConfiguration(in *CTFRegistryConfiguration, out *config.CTFRegistryConfiguration, s conversion.Scope) error {
+	out.RootPath = in.RootPath
+	return nil
+}
+
+// Convert_v1alpha1_CTFRegistryConfiguration_To_config_CTFRegistryConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_CTFRegistryConfiguration_To_config_CTFRegistryConfiguration(in *CTFRegistryConfiguration, out *config.CTFRegistryConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CTFRegistryConfiguration_To_config_CTFRegistryConfiguration(in, out, s)
+}
+
+func autoConvert_config_CTFRegistryConfiguration_To_v1alpha1_CTFRegistryConfiguration(in *config.CTFRegistryConfiguration, out *CTFRegistryConfiguration, s conversion.Scope) error {
+	out.RootPath = in.RootPath
+	return nil
+}
+
+// Convert_config_CTFRegistryConfiguration_To_v1alpha1_CTFRegistryConfiguration is an autogenerated conversion function.
+func Convert_config_CTFRegistryConfiguration_To_v1alpha1_CTFRegistryConfiguration(in *config.CTFRegistryConfiguration, out *CTFRegistryConfiguration, s conversion.Scope) error {
+	return autoConvert_config_CTFRegistryConfiguration_To_v1alpha1_CTFRegistryConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_CommonControllerConfig_To_config_CommonControllerConfig(in *CommonControllerConfig, out *config.CommonControllerConfig, s conversion.Scope) error {
 	out.Workers = in.Workers
 	out.CacheSyncTimeout = (*v1.Duration)(unsafe.Pointer(in.CacheSyncTimeout))
@@ -425,6 +505,9 @@ func autoConvert_v1alpha1_Controllers_To_config_Controllers(in *Controllers, out
 	if err := Convert_v1alpha1_ContextsController_To_config_ContextsController(&in.Contexts, &out.Contexts, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_OrphansController_To_config_OrphansController(&in.Orphans, &out.Orphans, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -447,6 +530,9 @@ func autoConvert_config_Controllers_To_v1alpha1_Controllers(in *config.Controlle
 	if err := Convert_config_ContextsController_To_v1alpha1_ContextsController(&in.Contexts, &out.Contexts, s); err != nil {
 		return err
 	}
+	if err := Convert_config_OrphansController_To_v1alpha1_OrphansController(&in.Orphans, &out.Orphans, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -477,6 +563,52 @@ func Convert_config_CrdManagementConfiguration_To_v1alpha1_CrdManagementConfigur
 	return autoConvert_config_CrdManagementConfiguration_To_v1alpha1_CrdManagementConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_DataObjectOCIStorageConfiguration_To_config_DataObjectOCIStorageConfiguration(in *DataObjectOCIStorageConfiguration, out *config.DataObjectOCIStorageConfiguration, s conversion.Scope) error {
+	out.Repository = in.Repository
+	return nil
+}
+
+// Convert_v1alpha1_DataObjectOCIStorageConfiguration_To_config_DataObjectOCIStorageConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_DataObjectOCIStorageConfiguration_To_config_DataObjectOCIStorageConfiguration(in *DataObjectOCIStorageConfiguration, out *config.DataObjectOCIStorageConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DataObjectOCIStorageConfiguration_To_config_DataObjectOCIStorageConfiguration(in, out, s)
+}
+
+func autoConvert_config_DataObjectOCIStorageConfiguration_To_v1alpha1_DataObjectOCIStorageConfiguration(in *config.DataObjectOCIStorageConfiguration, out *DataObjectOCIStorageConfiguration, s conversion.Scope) error {
+	out.Repository = in.Repository
+	return nil
+}
+
+// Convert_config_DataObjectOCIStorageConfiguration_To_v1alpha1_DataObjectOCIStorageConfiguration is an autogenerated conversion function.
+func Convert_config_DataObjectOCIStorageConfiguration_To_v1alpha1_DataObjectOCIStorageConfiguration(in *config.DataObjectOCIStorageConfiguration, out *DataObjectOCIStorageConfiguration, s conversion.Scope) error {
+	return autoConvert_config_DataObjectOCIStorageConfiguration_To_v1alpha1_DataObjectOCIStorageConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_DataObjectStorageConfiguration_To_config_DataObjectStorageConfiguration(in *DataObjectStorageConfiguration, out *config.DataObjectStorageConfiguration, s conversion.Scope) error {
+	out.Type = core.DataObjectBlobStoreType(in.Type)
+	out.Threshold = in.Threshold
+	out.ChunkSize = in.ChunkSize
+	out.OCI = (*config.DataObjectOCIStorageConfiguration)(unsafe.Pointer(in.OCI))
+	return nil
+}
+
+// Convert_v1alpha1_DataObjectStorageConfiguration_To_config_DataObjectStorageConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_DataObjectStorageConfiguration_To_config_DataObjectStorageConfiguration(in *DataObjectStorageConfiguration, out *config.DataObjectStorageConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DataObjectStorageConfiguration_To_config_DataObjectStorageConfiguration(in, out, s)
+}
+
+func autoConvert_config_DataObjectStorageConfiguration_To_v1alpha1_DataObjectStorageConfiguration(in *config.DataObjectStorageConfiguration, out *DataObjectStorageConfiguration, s conversion.Scope) error {
+	out.Type = corev1alpha1.DataObjectBlobStoreType(in.Type)
+	out.Threshold = in.Threshold
+	out.ChunkSize = in.ChunkSize
+	out.OCI = (*DataObjectOCIStorageConfiguration)(unsafe.Pointer(in.OCI))
+	return nil
+}
+
+// Convert_config_DataObjectStorageConfiguration_To_v1alpha1_DataObjectStorageConfiguration is an autogenerated conversion function.
+func Convert_config_DataObjectStorageConfiguration_To_v1alpha1_DataObjectStorageConfiguration(in *config.DataObjectStorageConfiguration, out *DataObjectStorageConfiguration, s conversion.Scope) error {
+	return autoConvert_config_DataObjectStorageConfiguration_To_v1alpha1_DataObjectStorageConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_DeployItemTimeouts_To_config_DeployItemTimeouts(in *DeployItemTimeouts, out *config.DeployItemTimeouts, s conversion.Scope) error {
 	out.Pickup = (*core.Duration)(unsafe.Pointer(in.Pickup))
 	out.Abort = (*core.Duration)(unsafe.Pointer(in.Abort))
@@ -579,6 +711,30 @@ func Convert_config_ExecutionsController_To_v1alpha1_ExecutionsController(in *co
 	return autoConvert_config_ExecutionsController_To_v1alpha1_ExecutionsController(in, out, s)
 }
 
+func autoConvert_v1alpha1_FailedReconcileDefaults_To_config_FailedReconcileDefaults(in *FailedReconcileDefaults, out *config.FailedReconcileDefaults, s conversion.Scope) error {
+	out.Interval = (*core.Duration)(unsafe.Pointer(in.Interval))
+	out.Backoff = (*core.RetryBackoff)(unsafe.Pointer(in.Backoff))
+	out.ErrorCodes = (*core.RetryErrorCodes)(unsafe.Pointer(in.ErrorCodes))
+	return nil
+}
+
+// Convert_v1alpha1_FailedReconcileDefaults_To_config_FailedReconcileDefaults is an autogenerated conversion function.
+func Convert_v1alpha1_FailedReconcileDefaults_To_config_FailedReconcileDefaults(in *FailedReconcileDefaults, out *config.FailedReconcileDefaults, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FailedReconcileDefaults_To_config_FailedReconcileDefaults(in, out, s)
+}
+
+func autoConvert_config_FailedReconcileDefaults_To_v1alpha1_FailedReconcileDefaults(in *config.FailedReconcileDefaults, out *FailedReconcileDefaults, s conversion.Scope) error {
+	out.Interval = (*corev1alpha1.Duration)(unsafe.Pointer(in.Interval))
+	out.Backoff = (*corev1alpha1.RetryBackoff)(unsafe.Pointer(in.Backoff))
+	out.ErrorCodes = (*corev1alpha1.RetryErrorCodes)(unsafe.Pointer(in.ErrorCodes))
+	return nil
+}
+
+// Convert_config_FailedReconcileDefaults_To_v1alpha1_FailedReconcileDefaults is an autogenerated conversion function.
+func Convert_config_FailedReconcileDefaults_To_v1alpha1_FailedReconcileDefaults(in *config.FailedReconcileDefaults, out *FailedReconcileDefaults, s conversion.Scope) error {
+	return autoConvert_config_FailedReconcileDefaults_To_v1alpha1_FailedReconcileDefaults(in, out, s)
+}
+
 func autoConvert_v1alpha1_GarbageCollectionConfiguration_To_config_GarbageCollectionConfiguration(in *GarbageCollectionConfiguration, out *config.GarbageCollectionConfiguration, s conversion.Scope) error {
 	out.Size = in.Size
 	out.GCHighThreshold = in.GCHighThreshold
@@ -615,6 +771,7 @@ func autoConvert_v1alpha1_InstallationsController_To_config_InstallationsControl
 	if err := Convert_v1alpha1_CommonControllerConfig_To_config_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
 		return err
 	}
+	out.FailedReconcile = (*config.FailedReconcileDefaults)(unsafe.Pointer(in.FailedReconcile))
 	return nil
 }
 
@@ -627,6 +784,7 @@ func autoConvert_config_InstallationsController_To_v1alpha1_InstallationsControl
 	if err := Convert_config_CommonControllerConfig_To_v1alpha1_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
 		return err
 	}
+	out.FailedReconcile = (*FailedReconcileDefaults)(unsafe.Pointer(in.FailedReconcile))
 	return nil
 }
 
@@ -673,6 +831,7 @@ func autoConvert_v1alpha1_LandscaperConfiguration_To_config_LandscaperConfigurat
 		return err
 	}
 	out.Metrics = (*config.MetricsConfiguration)(unsafe.Pointer(in.Metrics))
+	out.Tracing = (*config.TracingConfiguration)(unsafe.Pointer(in.Tracing))
 	if err := Convert_v1alpha1_CrdManagementConfiguration_To_config_CrdManagementConfiguration(&in.CrdManagement, &out.CrdManagement, s); err != nil {
 		return err
 	}
@@ -681,6 +840,7 @@ func autoConvert_v1alpha1_LandscaperConfiguration_To_config_LandscaperConfigurat
 	}
 	out.DeployItemTimeouts = (*config.DeployItemTimeouts)(unsafe.Pointer(in.DeployItemTimeouts))
 	out.LsDeployments = (*config.LsDeployments)(unsafe.Pointer(in.LsDeployments))
+	out.DataObjectStorage = (*config.DataObjectStorageConfiguration)(unsafe.Pointer(in.DataObjectStorage))
 	return nil
 }
 
@@ -701,6 +861,7 @@ func autoConvert_config_LandscaperConfiguration_To_v1alpha1_LandscaperConfigurat
 		return err
 	}
 	out.Metrics = (*MetricsConfiguration)(unsafe.Pointer(in.Metrics))
+	out.Tracing = (*TracingConfiguration)(unsafe.Pointer(in.Tracing))
 	if err := Convert_config_CrdManagementConfiguration_To_v1alpha1_CrdManagementConfiguration(&in.CrdManagement, &out.CrdManagement, s); err != nil {
 		return err
 	}
@@ -709,6 +870,7 @@ func autoConvert_config_LandscaperConfiguration_To_v1alpha1_LandscaperConfigurat
 	}
 	out.DeployItemTimeouts = (*DeployItemTimeouts)(unsafe.Pointer(in.DeployItemTimeouts))
 	out.LsDeployments = (*LsDeployments)(unsafe.Pointer(in.LsDeployments))
+	out.DataObjectStorage = (*DataObjectStorageConfiguration)(unsafe.Pointer(in.DataObjectStorage))
 	return nil
 }
 
@@ -827,9 +989,40 @@ func Convert_config_OCIConfiguration_To_v1alpha1_OCIConfiguration(in *config.OCI
 	return autoConvert_config_OCIConfiguration_To_v1alpha1_OCIConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_OrphansController_To_config_OrphansController(in *OrphansController, out *config.OrphansController, s conversion.Scope) error {
+	if err := Convert_v1alpha1_CommonControllerConfig_To_config_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
+		return err
+	}
+	out.Disable = in.Disable
+	out.DeleteOrphans = in.DeleteOrphans
+	out.GracePeriod = (*v1.Duration)(unsafe.Pointer(in.GracePeriod))
+	return nil
+}
+
+// Convert_v1alpha1_OrphansController_To_config_OrphansController is an autogenerated conversion function.
+func Convert_v1alpha1_OrphansController_To_config_OrphansController(in *OrphansController, out *config.OrphansController, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OrphansController_To_config_OrphansController(in, out, s)
+}
+
+func autoConvert_config_OrphansController_To_v1alpha1_OrphansController(in *config.OrphansController, out *OrphansController, s conversion.Scope) error {
+	if err := Convert_config_CommonControllerConfig_To_v1alpha1_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
+		return err
+	}
+	out.Disable = in.Disable
+	out.DeleteOrphans = in.DeleteOrphans
+	out.GracePeriod = (*v1.Duration)(unsafe.Pointer(in.GracePeriod))
+	return nil
+}
+
+// Convert_config_OrphansController_To_v1alpha1_OrphansController is an autogenerated conversion function.
+func Convert_config_OrphansController_To_v1alpha1_OrphansController(in *config.OrphansController, out *OrphansController, s conversion.Scope) error {
+	return autoConvert_config_OrphansController_To_v1alpha1_OrphansController(in, out, s)
+}
+
 func autoConvert_v1alpha1_RegistryConfiguration_To_config_RegistryConfiguration(in *RegistryConfiguration, out *config.RegistryConfiguration, s conversion.Scope) error {
 	out.Local = (*config.LocalRegistryConfiguration)(unsafe.Pointer(in.Local))
 	out.OCI = (*config.OCIConfiguration)(unsafe.Pointer(in.OCI))
+	out.CTF = (*config.CTFRegistryConfiguration)(unsafe.Pointer(in.CTF))
 	return nil
 }
 
@@ -841,6 +1034,7 @@ func Convert_v1alpha1_RegistryConfiguration_To_config_RegistryConfiguration(in *
 func autoConvert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(in *config.RegistryConfiguration, out *RegistryConfiguration, s conversion.Scope) error {
 	out.Local = (*LocalRegistryConfiguration)(unsafe.Pointer(in.Local))
 	out.OCI = (*OCIConfiguration)(unsafe.Pointer(in.OCI))
+	out.CTF = (*CTFRegistryConfiguration)(unsafe.Pointer(in.CTF))
 	return nil
 }
 
@@ -848,3 +1042,27 @@ func autoConvert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(
 func Convert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(in *config.RegistryConfiguration, out *RegistryConfiguration, s conversion.Scope) error {
 	return autoConvert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(in, out, s)
 }
+
+func autoConvert_v1alpha1_TracingConfiguration_To_config_TracingConfiguration(in *TracingConfiguration, out *config.TracingConfiguration, s conversion.Scope) error {
+	out.Endpoint = in.Endpoint
+	out.Insecure = in.Insecure
+	out.ServiceName = in.ServiceName
+	return nil
+}
+
+// Convert_v1alpha1_TracingConfiguration_To_config_TracingConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_TracingConfiguration_To_config_TracingConfiguration(in *TracingConfiguration, out *config.TracingConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TracingConfiguration_To_config_TracingConfiguration(in, out, s)
+}
+
+func autoConvert_config_TracingConfiguration_To_v1alpha1_TracingConfiguration(in *config.TracingConfiguration, out *TracingConfiguration, s conversion.Scope) error {
+	out.Endpoint = in.Endpoint
+	out.Insecure = in.Insecure
+	out.ServiceName = in.ServiceName
+	return nil
+}
+
+// Convert_config_TracingConfiguration_To_v1alpha1_TracingConfiguration is an autogenerated conversion function.
+func Convert_config_TracingConfiguration_To_v1alpha1_TracingConfiguration(in *config.TracingConfiguration, out *TracingConfiguration, s conversion.Scope) error {
+	return autoConvert_config_TracingConfiguration_To_v1alpha1_TracingConfiguration(in, out, s)
+}
@@ -71,6 +71,22 @@ func (in *BlueprintStore) DeepCopy() *BlueprintStore {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CTFRegistryConfiguration) DeepCopyInto(out *CTFRegistryConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CTFRegistryConfiguration.
+func (in *CTFRegistryConfiguration) DeepCopy() *CTFRegistryConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CTFRegistryConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonControllerConfig) DeepCopyInto(out *CommonControllerConfig) {
 	*out = *in
@@ -164,6 +180,7 @@ func (in *Controllers) DeepCopyInto(out *Controllers) {
 	in.Executions.DeepCopyInto(&out.Executions)
 	in.DeployItems.DeepCopyInto(&out.DeployItems)
 	in.Contexts.DeepCopyInto(&out.Contexts)
+	in.Orphans.DeepCopyInto(&out.Orphans)
 	return
 }
 
@@ -203,6 +220,43 @@ func (in *CrdManagementConfiguration) DeepCopy() *CrdManagementConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataObjectOCIStorageConfiguration) DeepCopyInto(out *DataObjectOCIStorageConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataObjectOCIStorageConfiguration.
+func (in *DataObjectOCIStorageConfiguration) DeepCopy() *DataObjectOCIStorageConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DataObjectOCIStorageConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataObjectStorageConfiguration) DeepCopyInto(out *DataObjectStorageConfiguration) {
+	*out = *in
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(DataObjectOCIStorageConfiguration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataObjectStorageConfiguration.
+func (in *DataObjectStorageConfiguration) DeepCopy() *DataObjectStorageConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DataObjectStorageConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeployItemTimeouts) DeepCopyInto(out *DeployItemTimeouts) {
 	*out = *in
@@ -289,6 +343,37 @@ func (in *ExecutionsController) DeepCopy() *ExecutionsController {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailedReconcileDefaults) DeepCopyInto(out *FailedReconcileDefaults) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(corev1alpha1.Duration)
+		**out = **in
+	}
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(corev1alpha1.RetryBackoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ErrorCodes != nil {
+		in, out := &in.ErrorCodes, &out.ErrorCodes
+		*out = new(corev1alpha1.RetryErrorCodes)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailedReconcileDefaults.
+func (in *FailedReconcileDefaults) DeepCopy() *FailedReconcileDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(FailedReconcileDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GarbageCollectionConfiguration) DeepCopyInto(out *GarbageCollectionConfiguration) {
 	*out = *in
@@ -314,6 +399,11 @@ func (in *GarbageCollectionConfiguration) DeepCopy() *GarbageCollectionConfigura
 func (in *InstallationsController) DeepCopyInto(out *InstallationsController) {
 	*out = *in
 	in.CommonControllerConfig.DeepCopyInto(&out.CommonControllerConfig)
+	if in.FailedReconcile != nil {
+		in, out := &in.FailedReconcile, &out.FailedReconcile
+		*out = new(FailedReconcileDefaults)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -360,6 +450,11 @@ func (in *LandscaperConfiguration) DeepCopyInto(out *LandscaperConfiguration) {
 		*out = new(MetricsConfiguration)
 		**out = **in
 	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(TracingConfiguration)
+		**out = **in
+	}
 	in.CrdManagement.DeepCopyInto(&out.CrdManagement)
 	in.DeployerManagement.DeepCopyInto(&out.DeployerManagement)
 	if in.DeployItemTimeouts != nil {
@@ -372,6 +467,11 @@ func (in *LandscaperConfiguration) DeepCopyInto(out *LandscaperConfiguration) {
 		*out = new(LsDeployments)
 		**out = **in
 	}
+	if in.DataObjectStorage != nil {
+		in, out := &in.DataObjectStorage, &out.DataObjectStorage
+		*out = new(DataObjectStorageConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -483,6 +583,28 @@ func (in *OCIConfiguration) DeepCopy() *OCIConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphansController) DeepCopyInto(out *OrphansController) {
+	*out = *in
+	in.CommonControllerConfig.DeepCopyInto(&out.CommonControllerConfig)
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphansController.
+func (in *OrphansController) DeepCopy() *OrphansController {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphansController)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegistryConfiguration) DeepCopyInto(out *RegistryConfiguration) {
 	*out = *in
@@ -496,6 +618,11 @@ func (in *RegistryConfiguration) DeepCopyInto(out *RegistryConfiguration) {
 		*out = new(OCIConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CTF != nil {
+		in, out := &in.CTF, &out.CTF
+		*out = new(CTFRegistryConfiguration)
+		**out = **in
+	}
 	return
 }
 
@@ -508,3 +635,19 @@ func (in *RegistryConfiguration) DeepCopy() *RegistryConfiguration {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingConfiguration) DeepCopyInto(out *TracingConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingConfiguration.
+func (in *TracingConfiguration) DeepCopy() *TracingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -30,10 +30,17 @@ func SetObjectDefaults_AgentConfiguration(in *AgentConfiguration) {
 func SetObjectDefaults_LandscaperConfiguration(in *LandscaperConfiguration) {
 	SetDefaults_LandscaperConfiguration(in)
 	SetDefaults_CommonControllerConfig(&in.Controllers.Installations.CommonControllerConfig)
+	if in.Controllers.Installations.FailedReconcile != nil {
+		SetDefaults_FailedReconcileDefaults(in.Controllers.Installations.FailedReconcile)
+	}
 	SetDefaults_CommonControllerConfig(&in.Controllers.Executions.CommonControllerConfig)
 	SetDefaults_CommonControllerConfig(&in.Controllers.DeployItems.CommonControllerConfig)
 	SetDefaults_CommonControllerConfig(&in.Controllers.Contexts.CommonControllerConfig)
+	SetDefaults_CommonControllerConfig(&in.Controllers.Orphans.CommonControllerConfig)
 	SetDefaults_BlueprintStore(&in.BlueprintStore)
 	SetDefaults_CrdManagementConfiguration(&in.CrdManagement)
 	SetObjectDefaults_AgentConfiguration(&in.DeployerManagement.Agent.AgentConfiguration)
+	if in.DataObjectStorage != nil {
+		SetDefaults_DataObjectStorageConfiguration(in.DataObjectStorage)
+	}
 }
@@ -72,6 +72,22 @@ func (in *BlueprintStore) DeepCopy() *BlueprintStore {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CTFRegistryConfiguration) DeepCopyInto(out *CTFRegistryConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CTFRegistryConfiguration.
+func (in *CTFRegistryConfiguration) DeepCopy() *CTFRegistryConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CTFRegistryConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonControllerConfig) DeepCopyInto(out *CommonControllerConfig) {
 	*out = *in
@@ -165,6 +181,7 @@ func (in *Controllers) DeepCopyInto(out *Controllers) {
 	in.Executions.DeepCopyInto(&out.Executions)
 	in.DeployItems.DeepCopyInto(&out.DeployItems)
 	in.Contexts.DeepCopyInto(&out.Contexts)
+	in.Orphans.DeepCopyInto(&out.Orphans)
 	return
 }
 
@@ -204,6 +221,43 @@ func (in *CrdManagementConfiguration) DeepCopy() *CrdManagementConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataObjectOCIStorageConfiguration) DeepCopyInto(out *DataObjectOCIStorageConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataObjectOCIStorageConfiguration.
+func (in *DataObjectOCIStorageConfiguration) DeepCopy() *DataObjectOCIStorageConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DataObjectOCIStorageConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataObjectStorageConfiguration) DeepCopyInto(out *DataObjectStorageConfiguration) {
+	*out = *in
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(DataObjectOCIStorageConfiguration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataObjectStorageConfiguration.
+func (in *DataObjectStorageConfiguration) DeepCopy() *DataObjectStorageConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DataObjectStorageConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeployItemTimeouts) DeepCopyInto(out *DeployItemTimeouts) {
 	*out = *in
@@ -290,6 +344,53 @@ func (in *ExecutionsController) DeepCopy() *ExecutionsController {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailedReconcileDefaults) DeepCopyInto(out *FailedReconcileDefaults) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(core.Duration)
+		**out = **in
+	}
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(core.RetryBackoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ErrorCodes != nil {
+		in, out := &in.ErrorCodes, &out.ErrorCodes
+		*out = new(core.RetryErrorCodes)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailedReconcileDefaults.
+func (in *FailedReconcileDefaults) DeepCopy() *FailedReconcileDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(FailedReconcileDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileTargetResolverConfiguration) DeepCopyInto(out *FileTargetResolverConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileTargetResolverConfiguration.
+func (in *FileTargetResolverConfiguration) DeepCopy() *FileTargetResolverConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(FileTargetResolverConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GarbageCollectionConfiguration) DeepCopyInto(out *GarbageCollectionConfiguration) {
 	*out = *in
@@ -311,6 +412,11 @@ func (in *GarbageCollectionConfiguration) DeepCopy() *GarbageCollectionConfigura
 func (in *InstallationsController) DeepCopyInto(out *InstallationsController) {
 	*out = *in
 	in.CommonControllerConfig.DeepCopyInto(&out.CommonControllerConfig)
+	if in.FailedReconcile != nil {
+		in, out := &in.FailedReconcile, &out.FailedReconcile
+		*out = new(FailedReconcileDefaults)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -357,6 +463,11 @@ func (in *LandscaperConfiguration) DeepCopyInto(out *LandscaperConfiguration) {
 		*out = new(MetricsConfiguration)
 		**out = **in
 	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(TracingConfiguration)
+		**out = **in
+	}
 	in.CrdManagement.DeepCopyInto(&out.CrdManagement)
 	in.DeployerManagement.DeepCopyInto(&out.DeployerManagement)
 	if in.DeployItemTimeouts != nil {
@@ -369,6 +480,11 @@ func (in *LandscaperConfiguration) DeepCopyInto(out *LandscaperConfiguration) {
 		*out = new(LsDeployments)
 		**out = **in
 	}
+	if in.DataObjectStorage != nil {
+		in, out := &in.DataObjectStorage, &out.DataObjectStorage
+		*out = new(DataObjectStorageConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -480,6 +596,28 @@ func (in *OCIConfiguration) DeepCopy() *OCIConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphansController) DeepCopyInto(out *OrphansController) {
+	*out = *in
+	in.CommonControllerConfig.DeepCopyInto(&out.CommonControllerConfig)
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphansController.
+func (in *OrphansController) DeepCopy() *OrphansController {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphansController)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegistryConfiguration) DeepCopyInto(out *RegistryConfiguration) {
 	*out = *in
@@ -493,6 +631,11 @@ func (in *RegistryConfiguration) DeepCopyInto(out *RegistryConfiguration) {
 		*out = new(OCIConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CTF != nil {
+		in, out := &in.CTF, &out.CTF
+		*out = new(CTFRegistryConfiguration)
+		**out = **in
+	}
 	return
 }
 
@@ -505,3 +648,61 @@ func (in *RegistryConfiguration) DeepCopy() *RegistryConfiguration {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetResolverConfiguration) DeepCopyInto(out *TargetResolverConfiguration) {
+	*out = *in
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(FileTargetResolverConfiguration)
+		**out = **in
+	}
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultTargetResolverConfiguration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetResolverConfiguration.
+func (in *TargetResolverConfiguration) DeepCopy() *TargetResolverConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetResolverConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingConfiguration) DeepCopyInto(out *TracingConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingConfiguration.
+func (in *TracingConfiguration) DeepCopy() *TracingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultTargetResolverConfiguration) DeepCopyInto(out *VaultTargetResolverConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultTargetResolverConfiguration.
+func (in *VaultTargetResolverConfiguration) DeepCopy() *VaultTargetResolverConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultTargetResolverConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -58,6 +58,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&DeployerRegistrationList{},
 		&TargetSync{},
 		&TargetSyncList{},
+		&OrphanReport{},
+		&OrphanReportList{},
+		&ExportGrant{},
+		&ExportGrantList{},
 	)
 	return nil
 }
@@ -66,6 +66,11 @@ type Blueprint struct {
 	// +optional
 	ImportExecutions []TemplateExecutor `json:"importExecutions,omitempty"`
 
+	// ImportValidations defines CEL rules that validate the imports of the blueprint.
+	// The imports are available in the rules as map "imports" that maps the import names to their values.
+	// +optional
+	ImportValidations []ValidationRule `json:"importValidations,omitempty"`
+
 	// Subinstallations defines an optional list of subinstallations (for aggregating blueprints).
 	// +optional
 	Subinstallations SubinstallationTemplateList `json:"subinstallations,omitempty"`
@@ -112,6 +117,22 @@ type ImportDefinition struct {
 	// todo: see if this works with recursion
 	// +optional
 	ConditionalImports []ImportDefinition `json:"imports,omitempty"`
+
+	// Validations defines CEL rules that validate the imported value.
+	// The imported value is available in the rules as "self".
+	// +optional
+	Validations []ValidationRule `json:"validations,omitempty"`
+}
+
+// ValidationRule defines a CEL expression that validates imports.
+type ValidationRule struct {
+	// Rule is the CEL expression that has to evaluate to true for valid imports.
+	// Ref: https://github.com/google/cel-spec
+	Rule string `json:"rule"`
+	// Message is the error message that is reported if the rule evaluates to false.
+	// Defaults to a message that contains the rule.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // ExportDefinitionList defines a list of export definitions.
@@ -125,6 +146,12 @@ type ExportDefinition struct {
 	// This field should be set and will likely be mandatory in future.
 	// +optional
 	Type ExportType `json:"type,omitempty"`
+
+	// Sensitive marks the exported value as sensitive.
+	// Sensitive values are stored in secrets instead of plain DataObjects and are never logged.
+	// Only data exports can be sensitive.
+	// +optional
+	Sensitive bool `json:"sensitive,omitempty"`
 }
 
 // FieldValueDefinition defines a im- or exported field.
@@ -47,4 +47,23 @@ type Context struct {
 	// If the string is empty, no overwrites will be used.
 	// +optional
 	ComponentVersionOverwritesReference string `json:"componentVersionOverwrites"`
+	// Verification configures the verification of the signatures of the component descriptors
+	// that are used by installations with this context.
+	// If not defined, component descriptors are not verified.
+	// +optional
+	Verification *ComponentVerification `json:"verification,omitempty"`
+}
+
+// ComponentVerification configures the verification of component descriptor signatures.
+// Exactly one of public key and certificate has to be defined.
+type ComponentVerification struct {
+	// SignatureName is the name of the signature of the component descriptors that is verified.
+	SignatureName string `json:"signatureName"`
+	// PublicKey is a PEM encoded RSA public key that is used to verify the signature.
+	// +optional
+	PublicKey string `json:"publicKey,omitempty"`
+	// Certificate is a PEM encoded X.509 certificate with an RSA public key that is used to verify the signature.
+	// The certificate has to be valid at the time of the verification.
+	// +optional
+	Certificate string `json:"certificate,omitempty"`
 }
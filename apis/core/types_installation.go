@@ -86,6 +86,16 @@ type InstallationSpec struct {
 	// AutomaticReconcile allows to configure automatically repeated reconciliations.
 	// +optional
 	AutomaticReconcile *AutomaticReconcile `json:"automaticReconcile,omitempty"`
+
+	// RequireExecutionApproval defines that every change of the deploy items of the installation and
+	// its subinstallations has to be approved before it is deployed.
+	// +optional
+	RequireExecutionApproval bool `json:"requireExecutionApproval,omitempty"`
+
+	// RevisionHistoryLimit is the maximal number of successfully reconciled revisions that are kept
+	// in the status of the installation. Defaults to 3.
+	// +optional
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
 }
 
 // AutomaticReconcile allows to configure automatically repeated reconciliations.
@@ -99,6 +109,12 @@ type AutomaticReconcile struct {
 	// If not set, no such automatically repeated reconciliations are triggered.
 	// +optional
 	FailedReconcile *FailedReconcile `json:"failedReconcile,omitempty"`
+
+	// ComponentVersionUpgrade allows to configure automatic reconciliations of succeeded root installations
+	// when a newer component version satisfies the version constraint of the component reference.
+	// If not set, no such automatic reconciliations are triggered.
+	// +optional
+	ComponentVersionUpgrade *ComponentVersionUpgrade `json:"componentVersionUpgrade,omitempty"`
 }
 
 // SucceededReconcile allows to configure automatically repeated reconciliations for succeeded installations
@@ -109,6 +125,14 @@ type SucceededReconcile struct {
 	Interval *Duration `json:"interval,omitempty"`
 }
 
+// ComponentVersionUpgrade allows to configure automatic reconciliations for newer component versions.
+type ComponentVersionUpgrade struct {
+	// Interval specifies the interval in which the available component versions are checked. If not set, a default
+	// of 1 hour is used.
+	// +optional
+	Interval *Duration `json:"interval,omitempty"`
+}
+
 // FailedReconcile allows to configure automatically repeated reconciliations for failed installations
 type FailedReconcile struct {
 	// NumberOfReconciles specifies the maximal number of automatically repeated reconciliations. If not set, no upper
@@ -116,10 +140,57 @@ type FailedReconcile struct {
 	// +optional
 	NumberOfReconciles *int `json:"numberOfReconciles,omitempty"`
 
-	// Interval specifies the interval between two subsequent repeated reconciliations. If not set, a default
-	// of 5 minutes is used.
+	// Interval specifies the interval between two subsequent repeated reconciliations.
+	// If a backoff is configured, it is the interval before the second repeated reconciliation.
+	// If not set, the default of the landscaper configuration is used, which is 5 minutes if not configured otherwise.
 	// +optional
 	Interval *Duration `json:"interval,omitempty"`
+
+	// Backoff configures an exponentially increasing interval between subsequent repeated reconciliations.
+	// If not set, the default of the landscaper configuration is used. Without a backoff, the interval is constant.
+	// +optional
+	Backoff *RetryBackoff `json:"backoff,omitempty"`
+
+	// ErrorCodes configures the repeated reconciliations depending on the error codes of the last error.
+	// If not set, the default of the landscaper configuration is used.
+	// +optional
+	ErrorCodes *RetryErrorCodes `json:"errorCodes,omitempty"`
+}
+
+// RetryBackoff configures an exponential backoff for automatically repeated reconciliations of failed installations.
+type RetryBackoff struct {
+	// Factor by which the interval is multiplied for each further repeated reconciliation. Defaults to 2.
+	// +optional
+	Factor *int32 `json:"factor,omitempty"`
+
+	// MaxInterval is the upper limit of the interval between two subsequent repeated reconciliations.
+	// Defaults to 1 hour.
+	// +optional
+	MaxInterval *Duration `json:"maxInterval,omitempty"`
+
+	// JitterPercent extends every interval by a random amount of up to the given percentage of the interval,
+	// so that failed installations are not retried all at the same time. Defaults to 10.
+	// +optional
+	JitterPercent *int32 `json:"jitterPercent,omitempty"`
+}
+
+// RetryErrorCodes configures automatically repeated reconciliations of failed installations depending on the
+// error codes of the last error.
+type RetryErrorCodes struct {
+	// NoRetry contains the error codes of errors that are not retried automatically,
+	// e.g. because they can only be fixed by changing the installation or its imports.
+	// +optional
+	NoRetry []ErrorCode `json:"noRetry,omitempty"`
+
+	// FastRetry contains the error codes of transient errors that are retried after the FastRetryInterval
+	// instead of the regular interval. The backoff is not applied to these retries.
+	// +optional
+	FastRetry []ErrorCode `json:"fastRetry,omitempty"`
+
+	// FastRetryInterval specifies the interval before a repeated reconciliation of an error with a FastRetry code.
+	// Defaults to 30 seconds.
+	// +optional
+	FastRetryInterval *Duration `json:"fastRetryInterval,omitempty"`
 }
 
 // InstallationStatus contains the current status of a Installation.
@@ -162,6 +233,61 @@ type InstallationStatus struct {
 	// AutomaticReconcileStatus describes the status of automatically triggered reconciles.
 	// +optional
 	AutomaticReconcileStatus *AutomaticReconcileStatus `json:"automaticReconcileStatus,omitempty"`
+
+	// Plan describes the changes a reconcile of the installation would apply.
+	// It is computed if the installation is annotated with the plan operation.
+	// +optional
+	Plan *InstallationPlan `json:"plan,omitempty"`
+
+	// Revisions is the history of the successfully reconciled revisions of the installation, ordered by their number.
+	// The number of revisions is bounded by the revision history limit of the installation.
+	// +optional
+	Revisions []InstallationRevision `json:"revisions,omitempty"`
+
+	// RollbackRevision is the number of the revision which is re-applied by the current job.
+	// It is set if the installation is annotated with the rollback operation.
+	// The job uses the blueprint, component descriptor, imported values and deploy item templates of the revision
+	// instead of the ones of the spec and the current imports. The spec of the installation is not changed.
+	// +optional
+	RollbackRevision *int64 `json:"rollbackRevision,omitempty"`
+
+	// ResolvedComponentVersion describes the component version that has been resolved
+	// for the version constraint of the component reference.
+	// +optional
+	ResolvedComponentVersion *ResolvedComponentVersion `json:"resolvedComponentVersion,omitempty"`
+
+	// ComponentOverwritesEvaluation describes when the time windows of the component version overwrites
+	// have been evaluated for the current job.
+	// +optional
+	ComponentOverwritesEvaluation *ComponentOverwritesEvaluation `json:"componentOverwritesEvaluation,omitempty"`
+}
+
+// ComponentOverwritesEvaluation describes the evaluation of the time windows of component version overwrites for a job.
+type ComponentOverwritesEvaluation struct {
+	// JobID is the id of the job for which the overwrites have been evaluated.
+	JobID string `json:"jobID"`
+	// Time is the time at which the time windows of the overwrites are evaluated during the job.
+	Time metav1.Time `json:"time"`
+	// NextTransitionTime is the next time after the evaluation at which an overwrite
+	// that selects the installation starts or stops to apply.
+	// +optional
+	NextTransitionTime *metav1.Time `json:"nextTransitionTime,omitempty"`
+}
+
+// ResolvedComponentVersion describes the component version that has been resolved for a version constraint.
+type ResolvedComponentVersion struct {
+	// Constraint is the version constraint that has been resolved.
+	Constraint string `json:"constraint"`
+	// Version is the highest version that satisfied the constraint when the current job was started.
+	Version string `json:"version"`
+	// JobID is the id of the job for which the version has been resolved.
+	JobID string `json:"jobID"`
+	// LatestVersion is the highest version that satisfied the constraint at the last check for newer versions.
+	// +optional
+	LatestVersion string `json:"latestVersion,omitempty"`
+	// LastCheckTime is the time of the last check for newer versions.
+	// +optional
+	LastCheckTime metav1.Time `json:"lastCheckTime,omitempty"`
 }
 
 // AutomaticReconcileStatus describes the status of automatically triggered reconciles.
@@ -180,6 +306,156 @@ type AutomaticReconcileStatus struct {
 	OnFailed bool `json:"onFailed,omitempty"`
 }
 
+// InstallationRevision describes a successfully reconciled revision of an installation.
+type InstallationRevision struct {
+	// Revision is the number of the revision.
+	Revision int64 `json:"revision"`
+
+	// JobID is the ID of the job which has reconciled the revision.
+	JobID string `json:"jobID"`
+
+	// ObservedGeneration is the generation of the installation which has been reconciled.
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// ReconcileTime is the time when the reconcile of the revision has been finished.
+	ReconcileTime metav1.Time `json:"reconcileTime"`
+
+	// RollbackOf is the number of the revision which has been re-applied by the revision.
+	// +optional
+	RollbackOf *int64 `json:"rollbackOf,omitempty"`
+
+	// Blueprint is the blueprint reference of the installation.
+	Blueprint BlueprintDefinition `json:"blueprint"`
+
+	// ComponentDescriptor is the component descriptor reference of the installation.
+	// A referenced component descriptor is recorded with the version that has been used by the revision,
+	// i.e. after version constraints and component overwrites have been applied.
+	// +optional
+	ComponentDescriptor *ComponentDescriptorDefinition `json:"componentDescriptor,omitempty"`
+
+	// ImportsHash is the hash of the import data.
+	// +optional
+	ImportsHash string `json:"importsHash,omitempty"`
+
+	// Imports are the references to the imported objects and their config generations.
+	// The imported values are not part of the status.
+	// +optional
+	Imports []ImportStatus `json:"imports,omitempty"`
+
+	// ImportValuesHash is the hash of the imported values of the revision.
+	// +optional
+	ImportValuesHash string `json:"importValuesHash,omitempty"`
+
+	// ImportValuesSecretRef is the reference to the secret that contains the imported values of the revision,
+	// which are used instead of the current imports if the revision is rolled back.
+	// It is empty if the values and deploy item templates exceed the maximal size of a revision.
+	// +optional
+	ImportValuesSecretRef *SecretReference `json:"importValuesSecretRef,omitempty"`
+
+	// DeployItemsHash is the hash of the rendered deploy item templates of the execution of the installation.
+	// It is empty if the installation has no execution.
+	// +optional
+	DeployItemsHash string `json:"deployItemsHash,omitempty"`
+
+	// DeployItemsSecretRef is the reference to the secret that contains the rendered deploy item templates
+	// of the execution of the installation.
+	// It is empty if the installation has no execution or if the values and templates exceed the maximal size of a revision.
+	// +optional
+	DeployItemsSecretRef *SecretReference `json:"deployItemsSecretRef,omitempty"`
+}
+
+// PlanAction describes how a planned object would be changed by a reconcile.
+type PlanAction string
+
+const (
+	// PlanActionCreate means that the object does not exist yet and would be created.
+	PlanActionCreate PlanAction = "Create"
+	// PlanActionUpdate means that the object exists and would be updated.
+	PlanActionUpdate PlanAction = "Update"
+	// PlanActionDelete means that the object exists but is not part of the rendered result anymore and would be deleted.
+	PlanActionDelete PlanAction = "Delete"
+	// PlanActionUnchanged means that the object exists and would not be changed.
+	PlanActionUnchanged PlanAction = "Unchanged"
+)
+
+// InstallationPlan describes the subinstallations and deploy items a reconcile of an installation would result in.
+type InstallationPlan struct {
+	// ObservedGeneration is the generation of the installation the plan has been computed for.
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// PlanTime is the time when the plan has been computed.
+	PlanTime metav1.Time `json:"planTime"`
+
+	// ImportsHash is the hash of the import data the plan has been computed with.
+	// +optional
+	ImportsHash string `json:"importsHash,omitempty"`
+
+	// Subinstallations contains the planned changes of the subinstallations.
+	// +optional
+	Subinstallations []PlannedObject `json:"subinstallations,omitempty"`
+
+	// DeployItems contains the planned changes of the deploy items of the installation's execution.
+	// +optional
+	DeployItems []PlannedObject `json:"deployItems,omitempty"`
+
+	// DetailsHash is the digest of the plan details.
+	// It is empty if the plan could not be computed.
+	// +optional
+	DetailsHash string `json:"detailsHash,omitempty"`
+
+	// DetailsSecretRef is the reference to the secret that contains the plan details, i.e. the plan including
+	// the rendered specifications and the changes of all planned objects.
+	// It is empty if the plan could not be computed or if the details exceed the maximal size of a plan.
+	// +optional
+	DetailsSecretRef *SecretReference `json:"detailsSecretRef,omitempty"`
+
+	// LastError describes the error that occurred while computing the plan.
+	// +optional
+	LastError *Error `json:"lastError,omitempty"`
+}
+
+// PlannedObject describes the planned change of a subinstallation or deploy item.
+type PlannedObject struct {
+	// Name is the name of the object as defined in the blueprint.
+	Name string `json:"name"`
+
+	// Action describes how the object would be changed.
+	Action PlanAction `json:"action"`
+
+	// Reference is the reference to the currently existing object.
+	// +optional
+	Reference *ObjectReference `json:"ref,omitempty"`
+
+	// NumberOfChanges is the number of fields that would be changed by an update.
+	// +optional
+	NumberOfChanges int `json:"numberOfChanges,omitempty"`
+
+	// Rendered contains the rendered specification of the object.
+	// It is empty if the object would be deleted.
+	// It is only part of the plan details and not shown in the status of the installation.
+	// +optional
+	Rendered *AnyJSON `json:"rendered,omitempty"`
+
+	// Changes contains the differences between the currently existing and the rendered specification.
+	// It is only part of the plan details and not shown in the status of the installation.
+	// +optional
+	Changes []PlannedChange `json:"changes,omitempty"`
+}
+
+// PlannedChange describes a single difference between the currently existing and the rendered specification of an object.
+type PlannedChange struct {
+	// Path is the path of the changed field.
+	Path string `json:"path"`
+
+	// Old is the current value of the field. It is empty if the field would be added.
+	// +optional
+	Old *AnyJSON `json:"old,omitempty"`
+
+	// New is the rendered value of the field. It is empty if the field would be removed.
+	// +optional
+	New *AnyJSON `json:"new,omitempty"`
+}
+
 // InstallationImports defines import of data objects and targets.
 type InstallationImports struct {
 	// Data defines all data object imports.
@@ -224,6 +500,12 @@ type DataImport struct {
 	// This method is not allowed in installation templates.
 	// +optional
 	ConfigMapRef *ConfigMapReference `json:"configMapRef,omitempty"`
+
+	// SharedDataRef defines a reference to a data export of a root installation in another namespace
+	// that is shared by an ExportGrant.
+	// This method is only allowed for root installations.
+	// +optional
+	SharedDataRef *SharedExportReference `json:"sharedDataRef,omitempty"`
 }
 
 // DataExport is a data object export.
@@ -241,19 +523,26 @@ type TargetImport struct {
 	Name string `json:"name"`
 
 	// Target is the name of the in-cluster target object.
-	// Exactly one of Target, Targets, and TargetListReference has to be specified.
+	// Exactly one of Target, Targets, TargetListReference, and SharedTarget has to be specified.
 	// +optional
 	Target string `json:"target,omitempty"`
 
 	// Targets is a list of in-cluster target objects.
-	// Exactly one of Target, Targets, and TargetListReference has to be specified.
+	// Exactly one of Target, Targets, TargetListReference, and SharedTarget has to be specified.
 	// +optional
 	Targets []string `json:"targets"`
 
 	// TargetListReference can (only) be used to import a targetlist that has been imported by the parent installation.
-	// Exactly one of Target, Targets, and TargetListReference has to be specified.
+	// Exactly one of Target, Targets, TargetListReference, and SharedTarget has to be specified.
 	// +optional
 	TargetListReference string `json:"targetListRef,omitempty"`
+
+	// SharedTarget defines a reference to a target export of a root installation in another namespace
+	// that is shared by an ExportGrant.
+	// Exactly one of Target, Targets, TargetListReference, and SharedTarget has to be specified.
+	// This method is only allowed for root installations.
+	// +optional
+	SharedTarget *SharedExportReference `json:"sharedTarget,omitempty"`
 }
 
 // TargetExport is a single target export.
@@ -310,7 +599,14 @@ type ComponentDescriptorReference struct {
 	// ComponentName defines the unique of the component containing the resource.
 	ComponentName string `json:"componentName"`
 	// Version defines the version of the component.
-	Version string `json:"version"`
+	// Either the version or the version constraint has to be set.
+	// +optional
+	Version string `json:"version,omitempty"`
+	// VersionConstraint defines a semantic version constraint for the version of the component, e.g. "~1.4" or ">=2.0 <3.0".
+	// The installation uses the highest version of the component in the repository context that satisfies the constraint.
+	// The constraint is resolved at the start of every job of the installation.
+	// +optional
+	VersionConstraint string `json:"versionConstraint,omitempty"`
 }
 
 // ObjectMeta returns the component descriptor v2 compatible object meta for a resource reference.
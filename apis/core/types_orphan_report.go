@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OrphanReason describes why a data object or target is orphaned.
+type OrphanReason string
+
+const (
+	// OrphanReasonSourceNotFound indicates that the installation that produced the object does not exist anymore.
+	OrphanReasonSourceNotFound OrphanReason = "SourceNotFound"
+	// OrphanReasonNotExported indicates that the installation that produced the object does not export it anymore
+	// and no installation imports it.
+	OrphanReasonNotExported OrphanReason = "NotExported"
+	// OrphanReasonNotImported indicates that the installation the import object was created for does not import it anymore.
+	OrphanReasonNotImported OrphanReason = "NotImported"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OrphanReportList contains a list of OrphanReports
+type OrphanReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrphanReport `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OrphanReport reports the DataObjects and Targets of a namespace
+// that are neither produced nor consumed by any installation anymore.
+type OrphanReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Status contains the orphaned DataObjects and Targets.
+	Status OrphanReportStatus `json:"status"`
+}
+
+// OrphanReportStatus contains the orphaned DataObjects and Targets of a namespace.
+type OrphanReportStatus struct {
+	// LastUpdateTime contains the last time the report was updated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+
+	// DataObjects contains the orphaned DataObjects.
+	// +optional
+	DataObjects []OrphanedObject `json:"dataObjects,omitempty"`
+
+	// Targets contains the orphaned Targets.
+	// +optional
+	Targets []OrphanedObject `json:"targets,omitempty"`
+}
+
+// OrphanedObject describes an orphaned DataObject or Target.
+type OrphanedObject struct {
+	// Name is the name of the object.
+	Name string `json:"name"`
+	// Context is the data object context of the object.
+	// +optional
+	Context string `json:"context,omitempty"`
+	// Key is the export or import key of the object.
+	// +optional
+	Key string `json:"key,omitempty"`
+	// Source is the source of the object.
+	// +optional
+	Source string `json:"source,omitempty"`
+	// SourceType is the source type (import or export) of the object.
+	SourceType string `json:"sourceType"`
+	// Reason describes why the object is orphaned.
+	Reason OrphanReason `json:"reason"`
+	// OrphanedSince is the time when the object was detected as orphaned for the first time.
+	OrphanedSince metav1.Time `json:"orphanedSince"`
+	// DeletionTime is the time after which the object is deleted.
+	// It is only set if the deletion of orphaned objects is enabled.
+	// +optional
+	DeletionTime *metav1.Time `json:"deletionTime,omitempty"`
+}
@@ -160,6 +160,13 @@ const (
 	ErrorWebhook ErrorCode = "ERR_WEBHOOK"
 	// ErrorUnfinished indicates that there are unfinished sub-objects.
 	ErrorUnfinished ErrorCode = "ERR_UNFINISHED"
+	// ErrorVerificationFailed indicates that the signature of a component descriptor or the digest of a resource could not be verified.
+	ErrorVerificationFailed ErrorCode = "ERR_VERIFICATION_FAILED"
+	// ErrorRegistryProblem indicates that a component descriptor or blueprint could not be fetched from a registry.
+	// Such errors are often transient.
+	ErrorRegistryProblem ErrorCode = "ERR_REGISTRY_PROBLEM"
+	// ErrorTestFailed indicates that a test of a deployment has failed, e.g. a helm test.
+	ErrorTestFailed ErrorCode = "ERR_TEST_FAILED"
 )
 
 // Condition holds the information about the state of a resource.
@@ -216,6 +223,16 @@ const (
 	// deployer could do some cleanup.
 	InterruptOperation Operation = "interrupt"
 
+	// PlanOperation is the annotation to let the landscaper compute the subinstallations and deploy items an
+	// installation would result in, without creating or updating anything. The result is written to the plan in the
+	// status of the installation.
+	PlanOperation Operation = "plan"
+
+	// RollbackOperation is the annotation to let the landscaper re-apply a previously reconciled revision of a root
+	// installation. The revision is selected by the rollback-revision annotation; if it is missing, the revision
+	// before the latest one is re-applied.
+	RollbackOperation Operation = "rollback"
+
 	// TestReconcileOperation is only used for test purposes. If set at a DeployItem, it triggers a reconciliation
 	// of that DeployItem. It must not be used in a productive scenario.
 	TestReconcileOperation Operation = "test-reconcile"
@@ -51,6 +51,19 @@ const (
 	// TouchAnnotation can be used to trigger a reconciliation event for a landscaper resource.
 	TouchAnnotation = LandscaperDomain + "/touch"
 
+	// ApproveGenerationAnnotation is the execution annotation that approves the given generation of the
+	// deploy item templates of an execution that requires an approval.
+	ApproveGenerationAnnotation = LandscaperDomain + "/approve-generation"
+
+	// ApprovedByAnnotation is the execution annotation that identifies the operator who approved a generation
+	// with the ApproveGenerationAnnotation. It is required for an approval and has to contain the name of the user
+	// who sets the annotations, which is verified by the execution webhook.
+	ApprovedByAnnotation = LandscaperDomain + "/approved-by"
+
+	// RollbackRevisionAnnotation is the installation annotation that selects the revision which is re-applied
+	// by the rollback operation.
+	RollbackRevisionAnnotation = LandscaperDomain + "/rollback-revision"
+
 	// RotateTokenAnnotation is the annotation that specifies to rotate a token (used e.g. in the context of TargetSyncObjects)
 	RotateTokenAnnotation = LandscaperDomain + "/rotate-token"
 
@@ -63,6 +63,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&DeployerRegistrationList{},
 		&TargetSync{},
 		&TargetSyncList{},
+		&OrphanReport{},
+		&OrphanReportList{},
+		&ExportGrant{},
+		&ExportGrantList{},
 	)
 	if err := RegisterConversions(scheme); err != nil {
 		return err
@@ -90,6 +94,8 @@ var ResourceDefinition = func() lsschema.CustomResourceDefinitions {
 			EnvironmentDefinition,
 			ComponentVersionOverwritesDefinition,
 			TargetSyncDefinition,
+			OrphanReportDefinition,
+			ExportGrantDefinition,
 		},
 	}
 }()
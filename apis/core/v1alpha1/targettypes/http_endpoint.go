@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targettypes
+
+import (
+	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// HTTPEndpointTargetType defines the landscaper http endpoint target.
+const HTTPEndpointTargetType v1alpha1.TargetType = core.GroupName + "/http-endpoint"
+
+// HTTPEndpointTargetConfig defines the landscaper http endpoint target config.
+type HTTPEndpointTargetConfig struct {
+	// URL is the base url of the endpoint.
+	URL string `json:"url"`
+	// CACert is the PEM encoded ca certificate that is used to verify the certificate of the endpoint.
+	// +optional
+	CACert string `json:"caCert,omitempty"`
+	// InsecureSkipVerify disables the verification of the certificate of the endpoint.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+	// Auth defines the authentication against the endpoint.
+	// +optional
+	Auth *HTTPEndpointAuth `json:"auth,omitempty"`
+}
+
+// HTTPEndpointAuth defines the authentication against a http endpoint.
+// Either basic authentication with username and password or a bearer token must be configured.
+type HTTPEndpointAuth struct {
+	// Username is the username for basic authentication.
+	// +optional
+	Username string `json:"username,omitempty"`
+	// Password is the password for basic authentication.
+	// +optional
+	Password string `json:"password,omitempty"`
+	// Token is the bearer token.
+	// +optional
+	Token string `json:"token,omitempty"`
+}
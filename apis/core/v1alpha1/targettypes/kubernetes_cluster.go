@@ -20,6 +20,33 @@ const KubernetesClusterTargetType v1alpha1.TargetType = core.GroupName + "/kuber
 type KubernetesClusterTargetConfig struct {
 	// Kubeconfig defines kubeconfig as string.
 	Kubeconfig ValueRef `json:"kubeconfig"`
+
+	// ServiceAccount optionally defines a service account on the target cluster.
+	// If set, a short-lived kubeconfig is generated for the service account and reused until shortly before its token expires.
+	// The kubeconfig above is only used to request tokens for the service account.
+	// It is still a long-lived credential that is stored in the target,
+	// so it should only be permitted to request tokens for the service account.
+	// +optional
+	ServiceAccount *ServiceAccountTokenConfig `json:"serviceAccount,omitempty"`
+}
+
+// ServiceAccountTokenConfig defines a service account on a target cluster for which short-lived tokens are requested.
+type ServiceAccountTokenConfig struct {
+	// Name is the name of the service account.
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the service account.
+	Namespace string `json:"namespace"`
+
+	// ExpirationSeconds is the requested validity duration of the token.
+	// Defaults to 3600 seconds.
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+
+	// Audiences are the intended audiences of the token.
+	// Defaults to the audiences of the api server of the target cluster.
+	// +optional
+	Audiences []string `json:"audiences,omitempty"`
 }
 
 // DefaultKubeconfigKey is the default that is used to hold a kubeconfig.
@@ -35,7 +62,8 @@ type ValueRef struct {
 
 // kubeconfigJSON is a helper struct for decoding.
 type kubeconfigJSON struct {
-	Kubeconfig *ValueRef `json:"kubeconfig"`
+	Kubeconfig     *ValueRef                  `json:"kubeconfig"`
+	ServiceAccount *ServiceAccountTokenConfig `json:"serviceAccount,omitempty"`
 }
 
 // valueRefJSON is a helper struct to decode json into a secret ref object.
@@ -80,6 +108,7 @@ func (kc *KubernetesClusterTargetConfig) UnmarshalJSON(data []byte) error {
 	if err == nil && kj.Kubeconfig != nil {
 		// parsing was successful
 		kc.Kubeconfig = *kj.Kubeconfig
+		kc.ServiceAccount = kj.ServiceAccount
 		return nil
 	}
 	return kc.Kubeconfig.UnmarshalJSON(data)
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targettypes
+
+import (
+	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// OCIRegistryTargetType defines the landscaper oci registry target.
+const OCIRegistryTargetType v1alpha1.TargetType = core.GroupName + "/oci-registry"
+
+// OCIRegistryTargetConfig defines the landscaper oci registry target config.
+type OCIRegistryTargetConfig struct {
+	// Host is the hostname of the registry including an optional port, e.g. "eu.gcr.io".
+	Host string `json:"host"`
+	// Username is the username that is used to authenticate against the registry.
+	// +optional
+	Username string `json:"username,omitempty"`
+	// Password is the password or token that is used to authenticate against the registry.
+	// +optional
+	Password string `json:"password,omitempty"`
+	// Insecure allows to access the registry via plain http.
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+}
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targettypes
+
+import (
+	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// SSHTargetType defines the landscaper ssh host target.
+const SSHTargetType v1alpha1.TargetType = core.GroupName + "/ssh"
+
+// DefaultSSHPort is the port that is used if a ssh target config does not define a port.
+const DefaultSSHPort = 22
+
+// SSHTargetConfig defines the landscaper ssh host target config.
+type SSHTargetConfig struct {
+	// Host is the hostname or ip address of the ssh host.
+	Host string `json:"host"`
+	// Port is the port of the ssh server. Defaults to 22.
+	// +optional
+	Port int `json:"port,omitempty"`
+	// User is the name of the user that is used to log in.
+	User string `json:"user"`
+	// PrivateKey is the PEM encoded private key that is used to log in.
+	// Either the private key or the password must be set.
+	// +optional
+	PrivateKey string `json:"privateKey,omitempty"`
+	// Password is the password that is used to log in.
+	// Either the private key or the password must be set.
+	// +optional
+	Password string `json:"password,omitempty"`
+	// HostPublicKey is the public key of the ssh host in the authorized_keys format.
+	// If set, the identity of the host is verified.
+	// +optional
+	HostPublicKey string `json:"hostPublicKey,omitempty"`
+}
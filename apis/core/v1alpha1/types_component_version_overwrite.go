@@ -31,13 +31,13 @@ var ComponentVersionOverwritesDefinition = lsschema.CustomResourceDefinition{
 		},
 		Kind: "ComponentVersionOverwrites",
 	},
-	Scope:   lsschema.NamespaceScoped,
-	Storage: true,
-	Served:  true,
+	Scope:             lsschema.NamespaceScoped,
+	Storage:           true,
+	Served:            true,
+	SubresourceStatus: true,
 }
 
 // +genclient
-// +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // ComponentVersionOverwrites contain overwrites for specific (versions of) components.
@@ -46,6 +46,9 @@ type ComponentVersionOverwrites struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// Overwrites defines a list of component overwrites
 	Overwrites ComponentVersionOverwriteList `json:"overwrites,omitempty"`
+	// Status contains the installations that are currently affected by the overwrites.
+	// +optional
+	Status ComponentVersionOverwritesStatus `json:"status,omitempty"`
 }
 
 // ComponentVersionOverwriteList is a list of component overwrites.
@@ -57,6 +60,16 @@ type ComponentVersionOverwrite struct {
 	Source ComponentVersionOverwriteReference `json:"source"`
 	// Substitution defines the replacement target for the component or version.
 	Substitution ComponentVersionOverwriteReference `json:"substitution"`
+	// InstallationSelector restricts the overwrite to installations with matching labels.
+	// The overwrite applies to all installations using the context if no selector is defined.
+	// +optional
+	InstallationSelector *metav1.LabelSelector `json:"installationSelector,omitempty"`
+	// ValidFrom defines the time from which on the overwrite is applied.
+	// +optional
+	ValidFrom *metav1.Time `json:"validFrom,omitempty"`
+	// ValidUntil defines the time from which on the overwrite is not applied anymore.
+	// +optional
+	ValidUntil *metav1.Time `json:"validUntil,omitempty"`
 }
 
 // ComponentVersionOverwriteReference defines a component reference by
@@ -71,3 +84,27 @@ type ComponentVersionOverwriteReference struct {
 	// +optional
 	Version string `json:"version"`
 }
+
+// ComponentVersionOverwritesStatus contains the status of a ComponentVersionOverwrites object.
+type ComponentVersionOverwritesStatus struct {
+	// ObservedGeneration is the most recent generation observed.
+	ObservedGeneration int64 `json:"observedGeneration"`
+	// LastUpdateTime is the time when the list of affected installations has changed the last time.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+	// AffectedInstallations lists the installations whose component reference is currently overwritten.
+	// +optional
+	AffectedInstallations []AffectedInstallation `json:"affectedInstallations,omitempty"`
+}
+
+// AffectedInstallation describes an installation whose component reference is overwritten.
+type AffectedInstallation struct {
+	// Name is the name of the installation.
+	Name string `json:"name"`
+	// Context is the name of the context that references the overwrites.
+	Context string `json:"context"`
+	// Original is the component reference of the installation before the overwrites are applied.
+	Original ComponentVersionOverwriteReference `json:"original"`
+	// Substitution is the component reference of the installation after the overwrites are applied.
+	Substitution ComponentVersionOverwriteReference `json:"substitution"`
+}
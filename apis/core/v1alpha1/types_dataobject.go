@@ -38,6 +38,10 @@ const DataObjectSourceLabel = "data.landscaper.gardener.cloud/source"
 // DataObjectIndexLabel defines the name of the annotation that specifies the index of the dataobject (for list-type imports)
 const DataObjectIndexLabel = "data.landscaper.gardener.cloud/index"
 
+// DataObjectBlobOwnerLabel defines the name of the label that identifies the DataObject
+// that owns a secret or config map holding a chunk of its data.
+const DataObjectBlobOwnerLabel = "data.landscaper.gardener.cloud/blob-owner"
+
 // DataObjectHashAnnotation defines the name of the annotation that specifies the hash of the data.
 const DataObjectHashAnnotation = "data.landscaper.gardener.cloud/hash"
 
@@ -91,5 +95,42 @@ type DataObject struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// Data contains the data of the object as string.
+	// The data is not set if it is stored in a blob store.
+	// +optional
 	Data AnyJSON `json:"data"`
+	// BlobRef references the data of the object if it is stored in a blob store instead of the object itself.
+	// +optional
+	BlobRef *DataObjectBlobReference `json:"blobRef,omitempty"`
+	// Sensitive marks the data as sensitive.
+	// The data of sensitive objects is always stored in secrets and never logged.
+	// +optional
+	Sensitive bool `json:"sensitive,omitempty"`
+}
+
+// DataObjectBlobStoreType defines the type of blob store that holds the data of a DataObject.
+type DataObjectBlobStoreType string
+
+const (
+	// SecretDataObjectBlobStoreType stores the data in chunks of secrets in the namespace of the DataObject.
+	SecretDataObjectBlobStoreType DataObjectBlobStoreType = "Secret"
+	// ConfigMapDataObjectBlobStoreType stores the data in chunks of config maps in the namespace of the DataObject.
+	ConfigMapDataObjectBlobStoreType DataObjectBlobStoreType = "ConfigMap"
+	// OCIDataObjectBlobStoreType stores the data as oci artifact in an oci registry.
+	OCIDataObjectBlobStoreType DataObjectBlobStoreType = "OCI"
+)
+
+// DataObjectBlobReference references the data of a DataObject that is stored in a blob store.
+type DataObjectBlobReference struct {
+	// Type is the type of the blob store.
+	Type DataObjectBlobStoreType `json:"type"`
+	// Reference is the reference of the data in the blob store.
+	// It is the name prefix of the chunks for secrets and config maps and the oci reference for oci artifacts.
+	Reference string `json:"reference"`
+	// Digest is the digest of the data.
+	Digest string `json:"digest"`
+	// Size is the size of the data in bytes.
+	Size int64 `json:"size"`
+	// Chunks is the number of secrets or config maps the data is split into.
+	// +optional
+	Chunks int `json:"chunks,omitempty"`
 }
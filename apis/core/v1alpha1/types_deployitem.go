@@ -15,6 +15,10 @@ import (
 // DeployItemValidationCondition is the Conditions type to indicate the deploy items configuration validation status.
 const DeployItemValidationCondition ConditionType = "DeployItemValidation"
 
+// DeployItemDriftCondition is the Conditions type to indicate whether the resources deployed by a deploy item
+// differ from their last applied state.
+const DeployItemDriftCondition ConditionType = "Drift"
+
 // DeployItemType defines the type of the deploy item
 type DeployItemType string
 
@@ -43,11 +43,12 @@ const (
 type ExecPhase string
 
 const (
-	ExecPhaseInit        ExecPhase = "Init"
-	ExecPhaseProgressing ExecPhase = "Progressing"
-	ExecPhaseCompleting  ExecPhase = "Completing"
-	ExecPhaseSucceeded   ExecPhase = "Succeeded"
-	ExecPhaseFailed      ExecPhase = "Failed"
+	ExecPhaseInit             ExecPhase = "Init"
+	ExecPhaseAwaitingApproval ExecPhase = "AwaitingApproval"
+	ExecPhaseProgressing      ExecPhase = "Progressing"
+	ExecPhaseCompleting       ExecPhase = "Completing"
+	ExecPhaseSucceeded        ExecPhase = "Succeeded"
+	ExecPhaseFailed           ExecPhase = "Failed"
 
 	ExecPhaseInitDelete    ExecPhase = "InitDelete"
 	ExecPhaseTriggerDelete ExecPhase = "TriggerDelete"
@@ -131,6 +132,11 @@ type ExecutionSpec struct {
 	// Note that the type information is used to determine the secret key and the type of the secret.
 	// +optional
 	RegistryPullSecrets []ObjectReference `json:"registryPullSecrets,omitempty"`
+
+	// RequireApproval defines that every new generation of the deploy item templates has to be approved
+	// before the deploy items are created or updated.
+	// +optional
+	RequireApproval bool `json:"requireApproval,omitempty"`
 }
 
 // ExecutionStatus contains the current status of a execution.
@@ -170,6 +176,23 @@ type ExecutionStatus struct {
 
 	// ExecutionPhase is the current phase of the execution.
 	ExecutionPhase ExecPhase `json:"phase,omitempty"`
+
+	// Approval describes the last approval of the deploy item templates of the execution.
+	// It is either set by the landscaper when the approval annotations are processed or directly by an operator.
+	// +optional
+	Approval *ExecutionApproval `json:"approval,omitempty"`
+}
+
+// ExecutionApproval describes the approval of a generation of the deploy item templates of an execution.
+type ExecutionApproval struct {
+	// ApprovedGeneration is the generation of the execution that has been approved.
+	ApprovedGeneration int64 `json:"approvedGeneration"`
+	// ApprovedBy identifies the operator who approved the generation.
+	// +optional
+	ApprovedBy string `json:"approvedBy,omitempty"`
+	// ApprovalTime is the time when the generation has been approved.
+	// +optional
+	ApprovalTime metav1.Time `json:"approvalTime,omitempty"`
 }
 
 // ExecutionGeneration links a deployitem to the generation of the execution when it was applied.
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsschema "github.com/gardener/landscaper/apis/schema"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ExportGrantList contains a list of ExportGrants
+type ExportGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ExportGrant `json:"items"`
+}
+
+// ExportGrantDefinition defines the ExportGrant resource CRD.
+var ExportGrantDefinition = lsschema.CustomResourceDefinition{
+	Names: lsschema.CustomResourceDefinitionNames{
+		Plural:   "exportgrants",
+		Singular: "exportgrant",
+		ShortNames: []string{
+			"expgrant",
+		},
+		Kind: "ExportGrant",
+	},
+	Scope:   lsschema.NamespaceScoped,
+	Storage: true,
+	Served:  true,
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ExportGrant allows root installations of other namespaces to import DataObjects and Targets
+// that are exported by the root installations of the namespace of the grant.
+type ExportGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec contains the shared exports and the consumers that are allowed to import them.
+	Spec ExportGrantSpec `json:"spec"`
+}
+
+// ExportGrantSpec contains the shared exports and the consumers that are allowed to import them.
+type ExportGrantSpec struct {
+	// DataRefs are the names of the shared data exports of the root installations.
+	// +optional
+	DataRefs []string `json:"dataRefs,omitempty"`
+
+	// Targets are the names of the shared target exports of the root installations.
+	// +optional
+	Targets []string `json:"targets,omitempty"`
+
+	// Consumers are the installations that are allowed to import the shared exports.
+	Consumers []ExportGrantConsumer `json:"consumers"`
+}
+
+// ExportGrantConsumer defines root installations that are allowed to import shared exports.
+type ExportGrantConsumer struct {
+	// Namespace is the namespace of the consuming root installations.
+	Namespace string `json:"namespace"`
+
+	// Installations are the names of the consuming root installations.
+	// All root installations of the namespace are allowed to import the shared exports if no installation is given.
+	// +optional
+	Installations []string `json:"installations,omitempty"`
+}
+
+// SharedExportReference references an export that is shared with other namespaces by an ExportGrant.
+type SharedExportReference struct {
+	// Namespace is the namespace of the exporting root installation and the ExportGrant.
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the shared data or target export.
+	Name string `json:"name"`
+}
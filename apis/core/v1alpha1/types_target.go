@@ -85,14 +85,32 @@ type TargetSpec struct {
 	Type TargetType `json:"type"`
 
 	// Configuration contains the target type specific configuration.
-	// Exactly one of the fields Configuration and SecretRef must be set
+	// Exactly one of the fields Configuration, SecretRef and ExternalSecretRef must be set
 	// +optional
 	Configuration *AnyJSON `json:"config,omitempty"`
 
 	// Reference to a secret containing the target type specific configuration.
-	// Exactly one of the fields Configuration and SecretRef must be set
+	// Exactly one of the fields Configuration, SecretRef and ExternalSecretRef must be set
 	// +optional
 	SecretRef *LocalSecretReference `json:"secretRef,omitempty"`
+
+	// Reference to a secret in an external secret store containing the target type specific configuration.
+	// The secret is resolved by the deployer when the target is used.
+	// Exactly one of the fields Configuration, SecretRef and ExternalSecretRef must be set
+	// +optional
+	ExternalSecretRef *ExternalSecretReference `json:"externalSecretRef,omitempty"`
+}
+
+// ExternalSecretReference is a reference to data in an external secret store.
+type ExternalSecretReference struct {
+	// Resolver is the name of the target resolver of the deployer which reads the secret from the external store,
+	// e.g. "vault" or "file".
+	Resolver string `json:"resolver"`
+	// Path is the path of the secret in the external store.
+	Path string `json:"path"`
+	// Key is the name of the key in the secret that holds the data.
+	// +optional
+	Key string `json:"key,omitempty"`
 }
 
 // TargetTemplate exposes specific parts of a target that are used in the exports
@@ -16,8 +16,8 @@ import (
 	unsafe "unsafe"
 
 	v2 "github.com/gardener/component-spec/bindings-go/apis/v2"
-	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	selection "k8s.io/apimachinery/pkg/selection"
@@ -32,6 +32,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AffectedInstallation)(nil), (*core.AffectedInstallation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AffectedInstallation_To_core_AffectedInstallation(a.(*AffectedInstallation), b.(*core.AffectedInstallation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AffectedInstallation)(nil), (*AffectedInstallation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AffectedInstallation_To_v1alpha1_AffectedInstallation(a.(*core.AffectedInstallation), b.(*AffectedInstallation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AnyJSON)(nil), (*core.AnyJSON)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_AnyJSON_To_core_AnyJSON(a.(*AnyJSON), b.(*core.AnyJSON), scope)
 	}); err != nil {
@@ -132,6 +142,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ComponentOverwritesEvaluation)(nil), (*core.ComponentOverwritesEvaluation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComponentOverwritesEvaluation_To_core_ComponentOverwritesEvaluation(a.(*ComponentOverwritesEvaluation), b.(*core.ComponentOverwritesEvaluation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ComponentOverwritesEvaluation)(nil), (*ComponentOverwritesEvaluation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ComponentOverwritesEvaluation_To_v1alpha1_ComponentOverwritesEvaluation(a.(*core.ComponentOverwritesEvaluation), b.(*ComponentOverwritesEvaluation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ComponentVerification)(nil), (*core.ComponentVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComponentVerification_To_core_ComponentVerification(a.(*ComponentVerification), b.(*core.ComponentVerification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ComponentVerification)(nil), (*ComponentVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ComponentVerification_To_v1alpha1_ComponentVerification(a.(*core.ComponentVerification), b.(*ComponentVerification), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ComponentVersionOverwrite)(nil), (*core.ComponentVersionOverwrite)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ComponentVersionOverwrite_To_core_ComponentVersionOverwrite(a.(*ComponentVersionOverwrite), b.(*core.ComponentVersionOverwrite), scope)
 	}); err != nil {
@@ -172,6 +202,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ComponentVersionOverwritesStatus)(nil), (*core.ComponentVersionOverwritesStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComponentVersionOverwritesStatus_To_core_ComponentVersionOverwritesStatus(a.(*ComponentVersionOverwritesStatus), b.(*core.ComponentVersionOverwritesStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ComponentVersionOverwritesStatus)(nil), (*ComponentVersionOverwritesStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ComponentVersionOverwritesStatus_To_v1alpha1_ComponentVersionOverwritesStatus(a.(*core.ComponentVersionOverwritesStatus), b.(*ComponentVersionOverwritesStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ComponentVersionUpgrade)(nil), (*core.ComponentVersionUpgrade)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComponentVersionUpgrade_To_core_ComponentVersionUpgrade(a.(*ComponentVersionUpgrade), b.(*core.ComponentVersionUpgrade), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ComponentVersionUpgrade)(nil), (*ComponentVersionUpgrade)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ComponentVersionUpgrade_To_v1alpha1_ComponentVersionUpgrade(a.(*core.ComponentVersionUpgrade), b.(*ComponentVersionUpgrade), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Condition)(nil), (*core.Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Condition_To_core_Condition(a.(*Condition), b.(*core.Condition), scope)
 	}); err != nil {
@@ -242,6 +292,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DataObjectBlobReference)(nil), (*core.DataObjectBlobReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DataObjectBlobReference_To_core_DataObjectBlobReference(a.(*DataObjectBlobReference), b.(*core.DataObjectBlobReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DataObjectBlobReference)(nil), (*DataObjectBlobReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DataObjectBlobReference_To_v1alpha1_DataObjectBlobReference(a.(*core.DataObjectBlobReference), b.(*DataObjectBlobReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DataObjectList)(nil), (*core.DataObjectList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DataObjectList_To_core_DataObjectList(a.(*DataObjectList), b.(*core.DataObjectList), scope)
 	}); err != nil {
@@ -432,6 +492,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ExecutionApproval)(nil), (*core.ExecutionApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExecutionApproval_To_core_ExecutionApproval(a.(*ExecutionApproval), b.(*core.ExecutionApproval), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ExecutionApproval)(nil), (*ExecutionApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ExecutionApproval_To_v1alpha1_ExecutionApproval(a.(*core.ExecutionApproval), b.(*ExecutionApproval), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExecutionGeneration)(nil), (*core.ExecutionGeneration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ExecutionGeneration_To_core_ExecutionGeneration(a.(*ExecutionGeneration), b.(*core.ExecutionGeneration), scope)
 	}); err != nil {
@@ -472,6 +542,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ExportGrant)(nil), (*core.ExportGrant)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExportGrant_To_core_ExportGrant(a.(*ExportGrant), b.(*core.ExportGrant), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ExportGrant)(nil), (*ExportGrant)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ExportGrant_To_v1alpha1_ExportGrant(a.(*core.ExportGrant), b.(*ExportGrant), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ExportGrantConsumer)(nil), (*core.ExportGrantConsumer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExportGrantConsumer_To_core_ExportGrantConsumer(a.(*ExportGrantConsumer), b.(*core.ExportGrantConsumer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ExportGrantConsumer)(nil), (*ExportGrantConsumer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ExportGrantConsumer_To_v1alpha1_ExportGrantConsumer(a.(*core.ExportGrantConsumer), b.(*ExportGrantConsumer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ExportGrantList)(nil), (*core.ExportGrantList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExportGrantList_To_core_ExportGrantList(a.(*ExportGrantList), b.(*core.ExportGrantList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ExportGrantList)(nil), (*ExportGrantList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ExportGrantList_To_v1alpha1_ExportGrantList(a.(*core.ExportGrantList), b.(*ExportGrantList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ExportGrantSpec)(nil), (*core.ExportGrantSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExportGrantSpec_To_core_ExportGrantSpec(a.(*ExportGrantSpec), b.(*core.ExportGrantSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ExportGrantSpec)(nil), (*ExportGrantSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ExportGrantSpec_To_v1alpha1_ExportGrantSpec(a.(*core.ExportGrantSpec), b.(*ExportGrantSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ExternalSecretReference)(nil), (*core.ExternalSecretReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExternalSecretReference_To_core_ExternalSecretReference(a.(*ExternalSecretReference), b.(*core.ExternalSecretReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ExternalSecretReference)(nil), (*ExternalSecretReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ExternalSecretReference_To_v1alpha1_ExternalSecretReference(a.(*core.ExternalSecretReference), b.(*ExternalSecretReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*FailedReconcile)(nil), (*core.FailedReconcile)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_FailedReconcile_To_core_FailedReconcile(a.(*FailedReconcile), b.(*core.FailedReconcile), scope)
 	}); err != nil {
@@ -562,6 +682,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InstallationPlan)(nil), (*core.InstallationPlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationPlan_To_core_InstallationPlan(a.(*InstallationPlan), b.(*core.InstallationPlan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationPlan)(nil), (*InstallationPlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationPlan_To_v1alpha1_InstallationPlan(a.(*core.InstallationPlan), b.(*InstallationPlan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InstallationRevision)(nil), (*core.InstallationRevision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationRevision_To_core_InstallationRevision(a.(*InstallationRevision), b.(*core.InstallationRevision), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationRevision)(nil), (*InstallationRevision)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationRevision_To_v1alpha1_InstallationRevision(a.(*core.InstallationRevision), b.(*InstallationRevision), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InstallationSpec)(nil), (*core.InstallationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InstallationSpec_To_core_InstallationSpec(a.(*InstallationSpec), b.(*core.InstallationSpec), scope)
 	}); err != nil {
@@ -662,6 +802,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*OrphanReport)(nil), (*core.OrphanReport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OrphanReport_To_core_OrphanReport(a.(*OrphanReport), b.(*core.OrphanReport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.OrphanReport)(nil), (*OrphanReport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_OrphanReport_To_v1alpha1_OrphanReport(a.(*core.OrphanReport), b.(*OrphanReport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OrphanReportList)(nil), (*core.OrphanReportList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OrphanReportList_To_core_OrphanReportList(a.(*OrphanReportList), b.(*core.OrphanReportList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.OrphanReportList)(nil), (*OrphanReportList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_OrphanReportList_To_v1alpha1_OrphanReportList(a.(*core.OrphanReportList), b.(*OrphanReportList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OrphanReportStatus)(nil), (*core.OrphanReportStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OrphanReportStatus_To_core_OrphanReportStatus(a.(*OrphanReportStatus), b.(*core.OrphanReportStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.OrphanReportStatus)(nil), (*OrphanReportStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_OrphanReportStatus_To_v1alpha1_OrphanReportStatus(a.(*core.OrphanReportStatus), b.(*OrphanReportStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OrphanedObject)(nil), (*core.OrphanedObject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OrphanedObject_To_core_OrphanedObject(a.(*OrphanedObject), b.(*core.OrphanedObject), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.OrphanedObject)(nil), (*OrphanedObject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_OrphanedObject_To_v1alpha1_OrphanedObject(a.(*core.OrphanedObject), b.(*OrphanedObject), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PlannedChange)(nil), (*core.PlannedChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PlannedChange_To_core_PlannedChange(a.(*PlannedChange), b.(*core.PlannedChange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PlannedChange)(nil), (*PlannedChange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PlannedChange_To_v1alpha1_PlannedChange(a.(*core.PlannedChange), b.(*PlannedChange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PlannedObject)(nil), (*core.PlannedObject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PlannedObject_To_core_PlannedObject(a.(*PlannedObject), b.(*core.PlannedObject), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PlannedObject)(nil), (*PlannedObject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PlannedObject_To_v1alpha1_PlannedObject(a.(*core.PlannedObject), b.(*PlannedObject), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RemoteBlueprintReference)(nil), (*core.RemoteBlueprintReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RemoteBlueprintReference_To_core_RemoteBlueprintReference(a.(*RemoteBlueprintReference), b.(*core.RemoteBlueprintReference), scope)
 	}); err != nil {
@@ -682,6 +882,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ResolvedComponentVersion)(nil), (*core.ResolvedComponentVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResolvedComponentVersion_To_core_ResolvedComponentVersion(a.(*ResolvedComponentVersion), b.(*core.ResolvedComponentVersion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ResolvedComponentVersion)(nil), (*ResolvedComponentVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ResolvedComponentVersion_To_v1alpha1_ResolvedComponentVersion(a.(*core.ResolvedComponentVersion), b.(*ResolvedComponentVersion), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ResolvedTarget)(nil), (*core.ResolvedTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ResolvedTarget_To_core_ResolvedTarget(a.(*ResolvedTarget), b.(*core.ResolvedTarget), scope)
 	}); err != nil {
@@ -702,6 +912,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RetryBackoff)(nil), (*core.RetryBackoff)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RetryBackoff_To_core_RetryBackoff(a.(*RetryBackoff), b.(*core.RetryBackoff), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RetryBackoff)(nil), (*RetryBackoff)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RetryBackoff_To_v1alpha1_RetryBackoff(a.(*core.RetryBackoff), b.(*RetryBackoff), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RetryErrorCodes)(nil), (*core.RetryErrorCodes)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RetryErrorCodes_To_core_RetryErrorCodes(a.(*RetryErrorCodes), b.(*core.RetryErrorCodes), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RetryErrorCodes)(nil), (*RetryErrorCodes)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RetryErrorCodes_To_v1alpha1_RetryErrorCodes(a.(*core.RetryErrorCodes), b.(*RetryErrorCodes), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SecretLabelSelectorRef)(nil), (*core.SecretLabelSelectorRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SecretLabelSelectorRef_To_core_SecretLabelSelectorRef(a.(*SecretLabelSelectorRef), b.(*core.SecretLabelSelectorRef), scope)
 	}); err != nil {
@@ -722,6 +952,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SharedExportReference)(nil), (*core.SharedExportReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SharedExportReference_To_core_SharedExportReference(a.(*SharedExportReference), b.(*core.SharedExportReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SharedExportReference)(nil), (*SharedExportReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SharedExportReference_To_v1alpha1_SharedExportReference(a.(*core.SharedExportReference), b.(*SharedExportReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*StaticDataSource)(nil), (*core.StaticDataSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_StaticDataSource_To_core_StaticDataSource(a.(*StaticDataSource), b.(*core.StaticDataSource), scope)
 	}); err != nil {
@@ -922,6 +1162,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ValidationRule)(nil), (*core.ValidationRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ValidationRule_To_core_ValidationRule(a.(*ValidationRule), b.(*core.ValidationRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ValidationRule)(nil), (*ValidationRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ValidationRule_To_v1alpha1_ValidationRule(a.(*core.ValidationRule), b.(*ValidationRule), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VersionedNamedObjectReference)(nil), (*core.VersionedNamedObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VersionedNamedObjectReference_To_core_VersionedNamedObjectReference(a.(*VersionedNamedObjectReference), b.(*core.VersionedNamedObjectReference), scope)
 	}); err != nil {
@@ -975,6 +1225,40 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_AffectedInstallation_To_core_AffectedInstallation(in *AffectedInstallation, out *core.AffectedInstallation, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Context = in.Context
+	if err := Convert_v1alpha1_ComponentVersionOverwriteReference_To_core_ComponentVersionOverwriteReference(&in.Original, &out.Original, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_ComponentVersionOverwriteReference_To_core_ComponentVersionOverwriteReference(&in.Substitution, &out.Substitution, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_AffectedInstallation_To_core_AffectedInstallation is an autogenerated conversion function.
+func Convert_v1alpha1_AffectedInstallation_To_core_AffectedInstallation(in *AffectedInstallation, out *core.AffectedInstallation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AffectedInstallation_To_core_AffectedInstallation(in, out, s)
+}
+
+func autoConvert_core_AffectedInstallation_To_v1alpha1_AffectedInstallation(in *core.AffectedInstallation, out *AffectedInstallation, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Context = in.Context
+	if err := Convert_core_ComponentVersionOverwriteReference_To_v1alpha1_ComponentVersionOverwriteReference(&in.Original, &out.Original, s); err != nil {
+		return err
+	}
+	if err := Convert_core_ComponentVersionOverwriteReference_To_v1alpha1_ComponentVersionOverwriteReference(&in.Substitution, &out.Substitution, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_AffectedInstallation_To_v1alpha1_AffectedInstallation is an autogenerated conversion function.
+func Convert_core_AffectedInstallation_To_v1alpha1_AffectedInstallation(in *core.AffectedInstallation, out *AffectedInstallation, s conversion.Scope) error {
+	return autoConvert_core_AffectedInstallation_To_v1alpha1_AffectedInstallation(in, out, s)
+}
+
 func autoConvert_v1alpha1_AnyJSON_To_core_AnyJSON(in *AnyJSON, out *core.AnyJSON, s conversion.Scope) error {
 	out.RawMessage = *(*json.RawMessage)(unsafe.Pointer(&in.RawMessage))
 	return nil
@@ -998,6 +1282,7 @@ func Convert_core_AnyJSON_To_v1alpha1_AnyJSON(in *core.AnyJSON, out *AnyJSON, s
 func autoConvert_v1alpha1_AutomaticReconcile_To_core_AutomaticReconcile(in *AutomaticReconcile, out *core.AutomaticReconcile, s conversion.Scope) error {
 	out.SucceededReconcile = (*core.SucceededReconcile)(unsafe.Pointer(in.SucceededReconcile))
 	out.FailedReconcile = (*core.FailedReconcile)(unsafe.Pointer(in.FailedReconcile))
+	out.ComponentVersionUpgrade = (*core.ComponentVersionUpgrade)(unsafe.Pointer(in.ComponentVersionUpgrade))
 	return nil
 }
 
@@ -1009,6 +1294,7 @@ func Convert_v1alpha1_AutomaticReconcile_To_core_AutomaticReconcile(in *Automati
 func autoConvert_core_AutomaticReconcile_To_v1alpha1_AutomaticReconcile(in *core.AutomaticReconcile, out *AutomaticReconcile, s conversion.Scope) error {
 	out.SucceededReconcile = (*SucceededReconcile)(unsafe.Pointer(in.SucceededReconcile))
 	out.FailedReconcile = (*FailedReconcile)(unsafe.Pointer(in.FailedReconcile))
+	out.ComponentVersionUpgrade = (*ComponentVersionUpgrade)(unsafe.Pointer(in.ComponentVersionUpgrade))
 	return nil
 }
 
@@ -1049,6 +1335,7 @@ func autoConvert_v1alpha1_Blueprint_To_core_Blueprint(in *Blueprint, out *core.B
 	out.LocalTypes = *(*map[string]core.JSONSchemaDefinition)(unsafe.Pointer(&in.LocalTypes))
 	out.Imports = *(*core.ImportDefinitionList)(unsafe.Pointer(&in.Imports))
 	out.ImportExecutions = *(*[]core.TemplateExecutor)(unsafe.Pointer(&in.ImportExecutions))
+	out.ImportValidations = *(*[]core.ValidationRule)(unsafe.Pointer(&in.ImportValidations))
 	out.Exports = *(*core.ExportDefinitionList)(unsafe.Pointer(&in.Exports))
 	out.Subinstallations = *(*core.SubinstallationTemplateList)(unsafe.Pointer(&in.Subinstallations))
 	out.SubinstallationExecutions = *(*[]core.TemplateExecutor)(unsafe.Pointer(&in.SubinstallationExecutions))
@@ -1069,6 +1356,7 @@ func autoConvert_core_Blueprint_To_v1alpha1_Blueprint(in *core.Blueprint, out *B
 	out.Imports = *(*ImportDefinitionList)(unsafe.Pointer(&in.Imports))
 	out.Exports = *(*ExportDefinitionList)(unsafe.Pointer(&in.Exports))
 	out.ImportExecutions = *(*[]TemplateExecutor)(unsafe.Pointer(&in.ImportExecutions))
+	out.ImportValidations = *(*[]ValidationRule)(unsafe.Pointer(&in.ImportValidations))
 	out.Subinstallations = *(*SubinstallationTemplateList)(unsafe.Pointer(&in.Subinstallations))
 	out.SubinstallationExecutions = *(*[]TemplateExecutor)(unsafe.Pointer(&in.SubinstallationExecutions))
 	out.DeployExecutions = *(*[]TemplateExecutor)(unsafe.Pointer(&in.DeployExecutions))
@@ -1203,6 +1491,7 @@ func autoConvert_v1alpha1_ComponentDescriptorReference_To_core_ComponentDescript
 	out.RepositoryContext = (*v2.UnstructuredTypedObject)(unsafe.Pointer(in.RepositoryContext))
 	out.ComponentName = in.ComponentName
 	out.Version = in.Version
+	out.VersionConstraint = in.VersionConstraint
 	return nil
 }
 
@@ -1215,6 +1504,7 @@ func autoConvert_core_ComponentDescriptorReference_To_v1alpha1_ComponentDescript
 	out.RepositoryContext = (*v2.UnstructuredTypedObject)(unsafe.Pointer(in.RepositoryContext))
 	out.ComponentName = in.ComponentName
 	out.Version = in.Version
+	out.VersionConstraint = in.VersionConstraint
 	return nil
 }
 
@@ -1223,6 +1513,54 @@ func Convert_core_ComponentDescriptorReference_To_v1alpha1_ComponentDescriptorRe
 	return autoConvert_core_ComponentDescriptorReference_To_v1alpha1_ComponentDescriptorReference(in, out, s)
 }
 
+func autoConvert_v1alpha1_ComponentOverwritesEvaluation_To_core_ComponentOverwritesEvaluation(in *ComponentOverwritesEvaluation, out *core.ComponentOverwritesEvaluation, s conversion.Scope) error {
+	out.JobID = in.JobID
+	out.Time = in.Time
+	out.NextTransitionTime = (*v1.Time)(unsafe.Pointer(in.NextTransitionTime))
+	return nil
+}
+
+// Convert_v1alpha1_ComponentOverwritesEvaluation_To_core_ComponentOverwritesEvaluation is an autogenerated conversion function.
+func Convert_v1alpha1_ComponentOverwritesEvaluation_To_core_ComponentOverwritesEvaluation(in *ComponentOverwritesEvaluation, out *core.ComponentOverwritesEvaluation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComponentOverwritesEvaluation_To_core_ComponentOverwritesEvaluation(in, out, s)
+}
+
+func autoConvert_core_ComponentOverwritesEvaluation_To_v1alpha1_ComponentOverwritesEvaluation(in *core.ComponentOverwritesEvaluation, out *ComponentOverwritesEvaluation, s conversion.Scope) error {
+	out.JobID = in.JobID
+	out.Time = in.Time
+	out.NextTransitionTime = (*v1.Time)(unsafe.Pointer(in.NextTransitionTime))
+	return nil
+}
+
+// Convert_core_ComponentOverwritesEvaluation_To_v1alpha1_ComponentOverwritesEvaluation is an autogenerated conversion function.
+func Convert_core_ComponentOverwritesEvaluation_To_v1alpha1_ComponentOverwritesEvaluation(in *core.ComponentOverwritesEvaluation, out *ComponentOverwritesEvaluation, s conversion.Scope) error {
+	return autoConvert_core_ComponentOverwritesEvaluation_To_v1alpha1_ComponentOverwritesEvaluation(in, out, s)
+}
+
+func autoConvert_v1alpha1_ComponentVerification_To_core_ComponentVerification(in *ComponentVerification, out *core.ComponentVerification, s conversion.Scope) error {
+	out.SignatureName = in.SignatureName
+	out.PublicKey = in.PublicKey
+	out.Certificate = in.Certificate
+	return nil
+}
+
+// Convert_v1alpha1_ComponentVerification_To_core_ComponentVerification is an autogenerated conversion function.
+func Convert_v1alpha1_ComponentVerification_To_core_ComponentVerification(in *ComponentVerification, out *core.ComponentVerification, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComponentVerification_To_core_ComponentVerification(in, out, s)
+}
+
+func autoConvert_core_ComponentVerification_To_v1alpha1_ComponentVerification(in *core.ComponentVerification, out *ComponentVerification, s conversion.Scope) error {
+	out.SignatureName = in.SignatureName
+	out.PublicKey = in.PublicKey
+	out.Certificate = in.Certificate
+	return nil
+}
+
+// Convert_core_ComponentVerification_To_v1alpha1_ComponentVerification is an autogenerated conversion function.
+func Convert_core_ComponentVerification_To_v1alpha1_ComponentVerification(in *core.ComponentVerification, out *ComponentVerification, s conversion.Scope) error {
+	return autoConvert_core_ComponentVerification_To_v1alpha1_ComponentVerification(in, out, s)
+}
+
 func autoConvert_v1alpha1_ComponentVersionOverwrite_To_core_ComponentVersionOverwrite(in *ComponentVersionOverwrite, out *core.ComponentVersionOverwrite, s conversion.Scope) error {
 	if err := Convert_v1alpha1_ComponentVersionOverwriteReference_To_core_ComponentVersionOverwriteReference(&in.Source, &out.Source, s); err != nil {
 		return err
@@ -1230,6 +1568,9 @@ func autoConvert_v1alpha1_ComponentVersionOverwrite_To_core_ComponentVersionOver
 	if err := Convert_v1alpha1_ComponentVersionOverwriteReference_To_core_ComponentVersionOverwriteReference(&in.Substitution, &out.Substitution, s); err != nil {
 		return err
 	}
+	out.InstallationSelector = (*v1.LabelSelector)(unsafe.Pointer(in.InstallationSelector))
+	out.ValidFrom = (*v1.Time)(unsafe.Pointer(in.ValidFrom))
+	out.ValidUntil = (*v1.Time)(unsafe.Pointer(in.ValidUntil))
 	return nil
 }
 
@@ -1245,6 +1586,9 @@ func autoConvert_core_ComponentVersionOverwrite_To_v1alpha1_ComponentVersionOver
 	if err := Convert_core_ComponentVersionOverwriteReference_To_v1alpha1_ComponentVersionOverwriteReference(&in.Substitution, &out.Substitution, s); err != nil {
 		return err
 	}
+	out.InstallationSelector = (*v1.LabelSelector)(unsafe.Pointer(in.InstallationSelector))
+	out.ValidFrom = (*v1.Time)(unsafe.Pointer(in.ValidFrom))
+	out.ValidUntil = (*v1.Time)(unsafe.Pointer(in.ValidUntil))
 	return nil
 }
 
@@ -1280,6 +1624,9 @@ func Convert_core_ComponentVersionOverwriteReference_To_v1alpha1_ComponentVersio
 func autoConvert_v1alpha1_ComponentVersionOverwrites_To_core_ComponentVersionOverwrites(in *ComponentVersionOverwrites, out *core.ComponentVersionOverwrites, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Overwrites = *(*core.ComponentVersionOverwriteList)(unsafe.Pointer(&in.Overwrites))
+	if err := Convert_v1alpha1_ComponentVersionOverwritesStatus_To_core_ComponentVersionOverwritesStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1291,6 +1638,9 @@ func Convert_v1alpha1_ComponentVersionOverwrites_To_core_ComponentVersionOverwri
 func autoConvert_core_ComponentVersionOverwrites_To_v1alpha1_ComponentVersionOverwrites(in *core.ComponentVersionOverwrites, out *ComponentVersionOverwrites, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Overwrites = *(*ComponentVersionOverwriteList)(unsafe.Pointer(&in.Overwrites))
+	if err := Convert_core_ComponentVersionOverwritesStatus_To_v1alpha1_ComponentVersionOverwritesStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1321,6 +1671,50 @@ func Convert_core_ComponentVersionOverwritesList_To_v1alpha1_ComponentVersionOve
 	return autoConvert_core_ComponentVersionOverwritesList_To_v1alpha1_ComponentVersionOverwritesList(in, out, s)
 }
 
+func autoConvert_v1alpha1_ComponentVersionOverwritesStatus_To_core_ComponentVersionOverwritesStatus(in *ComponentVersionOverwritesStatus, out *core.ComponentVersionOverwritesStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastUpdateTime = (*v1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.AffectedInstallations = *(*[]core.AffectedInstallation)(unsafe.Pointer(&in.AffectedInstallations))
+	return nil
+}
+
+// Convert_v1alpha1_ComponentVersionOverwritesStatus_To_core_ComponentVersionOverwritesStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ComponentVersionOverwritesStatus_To_core_ComponentVersionOverwritesStatus(in *ComponentVersionOverwritesStatus, out *core.ComponentVersionOverwritesStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComponentVersionOverwritesStatus_To_core_ComponentVersionOverwritesStatus(in, out, s)
+}
+
+func autoConvert_core_ComponentVersionOverwritesStatus_To_v1alpha1_ComponentVersionOverwritesStatus(in *core.ComponentVersionOverwritesStatus, out *ComponentVersionOverwritesStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastUpdateTime = (*v1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.AffectedInstallations = *(*[]AffectedInstallation)(unsafe.Pointer(&in.AffectedInstallations))
+	return nil
+}
+
+// Convert_core_ComponentVersionOverwritesStatus_To_v1alpha1_ComponentVersionOverwritesStatus is an autogenerated conversion function.
+func Convert_core_ComponentVersionOverwritesStatus_To_v1alpha1_ComponentVersionOverwritesStatus(in *core.ComponentVersionOverwritesStatus, out *ComponentVersionOverwritesStatus, s conversion.Scope) error {
+	return autoConvert_core_ComponentVersionOverwritesStatus_To_v1alpha1_ComponentVersionOverwritesStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ComponentVersionUpgrade_To_core_ComponentVersionUpgrade(in *ComponentVersionUpgrade, out *core.ComponentVersionUpgrade, s conversion.Scope) error {
+	out.Interval = (*core.Duration)(unsafe.Pointer(in.Interval))
+	return nil
+}
+
+// Convert_v1alpha1_ComponentVersionUpgrade_To_core_ComponentVersionUpgrade is an autogenerated conversion function.
+func Convert_v1alpha1_ComponentVersionUpgrade_To_core_ComponentVersionUpgrade(in *ComponentVersionUpgrade, out *core.ComponentVersionUpgrade, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComponentVersionUpgrade_To_core_ComponentVersionUpgrade(in, out, s)
+}
+
+func autoConvert_core_ComponentVersionUpgrade_To_v1alpha1_ComponentVersionUpgrade(in *core.ComponentVersionUpgrade, out *ComponentVersionUpgrade, s conversion.Scope) error {
+	out.Interval = (*Duration)(unsafe.Pointer(in.Interval))
+	return nil
+}
+
+// Convert_core_ComponentVersionUpgrade_To_v1alpha1_ComponentVersionUpgrade is an autogenerated conversion function.
+func Convert_core_ComponentVersionUpgrade_To_v1alpha1_ComponentVersionUpgrade(in *core.ComponentVersionUpgrade, out *ComponentVersionUpgrade, s conversion.Scope) error {
+	return autoConvert_core_ComponentVersionUpgrade_To_v1alpha1_ComponentVersionUpgrade(in, out, s)
+}
+
 func autoConvert_v1alpha1_Condition_To_core_Condition(in *Condition, out *core.Condition, s conversion.Scope) error {
 	out.Type = core.ConditionType(in.Type)
 	out.Status = core.ConditionStatus(in.Status)
@@ -1382,9 +1776,10 @@ func Convert_core_ConfigMapReference_To_v1alpha1_ConfigMapReference(in *core.Con
 func autoConvert_v1alpha1_Context_To_core_Context(in *Context, out *core.Context, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.RepositoryContext = (*v2.UnstructuredTypedObject)(unsafe.Pointer(in.RepositoryContext))
-	out.RegistryPullSecrets = *(*[]v1.LocalObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
+	out.RegistryPullSecrets = *(*[]corev1.LocalObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
 	out.Configurations = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Configurations))
 	out.ComponentVersionOverwritesReference = in.ComponentVersionOverwritesReference
+	out.Verification = (*core.ComponentVerification)(unsafe.Pointer(in.Verification))
 	return nil
 }
 
@@ -1396,9 +1791,10 @@ func Convert_v1alpha1_Context_To_core_Context(in *Context, out *core.Context, s
 func autoConvert_core_Context_To_v1alpha1_Context(in *core.Context, out *Context, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.RepositoryContext = (*v2.UnstructuredTypedObject)(unsafe.Pointer(in.RepositoryContext))
-	out.RegistryPullSecrets = *(*[]v1.LocalObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
+	out.RegistryPullSecrets = *(*[]corev1.LocalObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
 	out.Configurations = *(*map[string]AnyJSON)(unsafe.Pointer(&in.Configurations))
 	out.ComponentVersionOverwritesReference = in.ComponentVersionOverwritesReference
+	out.Verification = (*ComponentVerification)(unsafe.Pointer(in.Verification))
 	return nil
 }
 
@@ -1457,6 +1853,7 @@ func autoConvert_v1alpha1_DataImport_To_core_DataImport(in *DataImport, out *cor
 	out.Version = in.Version
 	out.SecretRef = (*core.SecretReference)(unsafe.Pointer(in.SecretRef))
 	out.ConfigMapRef = (*core.ConfigMapReference)(unsafe.Pointer(in.ConfigMapRef))
+	out.SharedDataRef = (*core.SharedExportReference)(unsafe.Pointer(in.SharedDataRef))
 	return nil
 }
 
@@ -1471,6 +1868,7 @@ func autoConvert_core_DataImport_To_v1alpha1_DataImport(in *core.DataImport, out
 	out.Version = in.Version
 	out.SecretRef = (*SecretReference)(unsafe.Pointer(in.SecretRef))
 	out.ConfigMapRef = (*ConfigMapReference)(unsafe.Pointer(in.ConfigMapRef))
+	out.SharedDataRef = (*SharedExportReference)(unsafe.Pointer(in.SharedDataRef))
 	return nil
 }
 
@@ -1484,6 +1882,8 @@ func autoConvert_v1alpha1_DataObject_To_core_DataObject(in *DataObject, out *cor
 	if err := Convert_v1alpha1_AnyJSON_To_core_AnyJSON(&in.Data, &out.Data, s); err != nil {
 		return err
 	}
+	out.BlobRef = (*core.DataObjectBlobReference)(unsafe.Pointer(in.BlobRef))
+	out.Sensitive = in.Sensitive
 	return nil
 }
 
@@ -1497,6 +1897,8 @@ func autoConvert_core_DataObject_To_v1alpha1_DataObject(in *core.DataObject, out
 	if err := Convert_core_AnyJSON_To_v1alpha1_AnyJSON(&in.Data, &out.Data, s); err != nil {
 		return err
 	}
+	out.BlobRef = (*DataObjectBlobReference)(unsafe.Pointer(in.BlobRef))
+	out.Sensitive = in.Sensitive
 	return nil
 }
 
@@ -1505,6 +1907,34 @@ func Convert_core_DataObject_To_v1alpha1_DataObject(in *core.DataObject, out *Da
 	return autoConvert_core_DataObject_To_v1alpha1_DataObject(in, out, s)
 }
 
+func autoConvert_v1alpha1_DataObjectBlobReference_To_core_DataObjectBlobReference(in *DataObjectBlobReference, out *core.DataObjectBlobReference, s conversion.Scope) error {
+	out.Type = core.DataObjectBlobStoreType(in.Type)
+	out.Reference = in.Reference
+	out.Digest = in.Digest
+	out.Size = in.Size
+	out.Chunks = in.Chunks
+	return nil
+}
+
+// Convert_v1alpha1_DataObjectBlobReference_To_core_DataObjectBlobReference is an autogenerated conversion function.
+func Convert_v1alpha1_DataObjectBlobReference_To_core_DataObjectBlobReference(in *DataObjectBlobReference, out *core.DataObjectBlobReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DataObjectBlobReference_To_core_DataObjectBlobReference(in, out, s)
+}
+
+func autoConvert_core_DataObjectBlobReference_To_v1alpha1_DataObjectBlobReference(in *core.DataObjectBlobReference, out *DataObjectBlobReference, s conversion.Scope) error {
+	out.Type = DataObjectBlobStoreType(in.Type)
+	out.Reference = in.Reference
+	out.Digest = in.Digest
+	out.Size = in.Size
+	out.Chunks = in.Chunks
+	return nil
+}
+
+// Convert_core_DataObjectBlobReference_To_v1alpha1_DataObjectBlobReference is an autogenerated conversion function.
+func Convert_core_DataObjectBlobReference_To_v1alpha1_DataObjectBlobReference(in *core.DataObjectBlobReference, out *DataObjectBlobReference, s conversion.Scope) error {
+	return autoConvert_core_DataObjectBlobReference_To_v1alpha1_DataObjectBlobReference(in, out, s)
+}
+
 func autoConvert_v1alpha1_DataObjectList_To_core_DataObjectList(in *DataObjectList, out *core.DataObjectList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]core.DataObject)(unsafe.Pointer(&in.Items))
@@ -1644,7 +2074,7 @@ func autoConvert_v1alpha1_DeployItemStatus_To_core_DeployItemStatus(in *DeployIt
 	out.LastError = (*core.Error)(unsafe.Pointer(in.LastError))
 	out.LastErrors = *(*[]*core.Error)(unsafe.Pointer(&in.LastErrors))
 	out.FirstError = (*core.Error)(unsafe.Pointer(in.FirstError))
-	out.LastReconcileTime = (*metav1.Time)(unsafe.Pointer(in.LastReconcileTime))
+	out.LastReconcileTime = (*v1.Time)(unsafe.Pointer(in.LastReconcileTime))
 	if err := Convert_v1alpha1_DeployerInformation_To_core_DeployerInformation(&in.Deployer, &out.Deployer, s); err != nil {
 		return err
 	}
@@ -1652,7 +2082,7 @@ func autoConvert_v1alpha1_DeployItemStatus_To_core_DeployItemStatus(in *DeployIt
 	out.ExportReference = (*core.ObjectReference)(unsafe.Pointer(in.ExportReference))
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
-	out.JobIDGenerationTime = (*metav1.Time)(unsafe.Pointer(in.JobIDGenerationTime))
+	out.JobIDGenerationTime = (*v1.Time)(unsafe.Pointer(in.JobIDGenerationTime))
 	out.DeployItemPhase = core.DeployItemPhase(in.DeployItemPhase)
 	return nil
 }
@@ -1669,7 +2099,7 @@ func autoConvert_core_DeployItemStatus_To_v1alpha1_DeployItemStatus(in *core.Dep
 	out.LastError = (*Error)(unsafe.Pointer(in.LastError))
 	out.LastErrors = *(*[]*Error)(unsafe.Pointer(&in.LastErrors))
 	out.FirstError = (*Error)(unsafe.Pointer(in.FirstError))
-	out.LastReconcileTime = (*metav1.Time)(unsafe.Pointer(in.LastReconcileTime))
+	out.LastReconcileTime = (*v1.Time)(unsafe.Pointer(in.LastReconcileTime))
 	if err := Convert_core_DeployerInformation_To_v1alpha1_DeployerInformation(&in.Deployer, &out.Deployer, s); err != nil {
 		return err
 	}
@@ -1677,7 +2107,7 @@ func autoConvert_core_DeployItemStatus_To_v1alpha1_DeployItemStatus(in *core.Dep
 	out.ExportReference = (*ObjectReference)(unsafe.Pointer(in.ExportReference))
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
-	out.JobIDGenerationTime = (*metav1.Time)(unsafe.Pointer(in.JobIDGenerationTime))
+	out.JobIDGenerationTime = (*v1.Time)(unsafe.Pointer(in.JobIDGenerationTime))
 	out.DeployItemPhase = DeployItemPhase(in.DeployItemPhase)
 	return nil
 }
@@ -2041,6 +2471,30 @@ func Convert_core_Execution_To_v1alpha1_Execution(in *core.Execution, out *Execu
 	return autoConvert_core_Execution_To_v1alpha1_Execution(in, out, s)
 }
 
+func autoConvert_v1alpha1_ExecutionApproval_To_core_ExecutionApproval(in *ExecutionApproval, out *core.ExecutionApproval, s conversion.Scope) error {
+	out.ApprovedGeneration = in.ApprovedGeneration
+	out.ApprovedBy = in.ApprovedBy
+	out.ApprovalTime = in.ApprovalTime
+	return nil
+}
+
+// Convert_v1alpha1_ExecutionApproval_To_core_ExecutionApproval is an autogenerated conversion function.
+func Convert_v1alpha1_ExecutionApproval_To_core_ExecutionApproval(in *ExecutionApproval, out *core.ExecutionApproval, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExecutionApproval_To_core_ExecutionApproval(in, out, s)
+}
+
+func autoConvert_core_ExecutionApproval_To_v1alpha1_ExecutionApproval(in *core.ExecutionApproval, out *ExecutionApproval, s conversion.Scope) error {
+	out.ApprovedGeneration = in.ApprovedGeneration
+	out.ApprovedBy = in.ApprovedBy
+	out.ApprovalTime = in.ApprovalTime
+	return nil
+}
+
+// Convert_core_ExecutionApproval_To_v1alpha1_ExecutionApproval is an autogenerated conversion function.
+func Convert_core_ExecutionApproval_To_v1alpha1_ExecutionApproval(in *core.ExecutionApproval, out *ExecutionApproval, s conversion.Scope) error {
+	return autoConvert_core_ExecutionApproval_To_v1alpha1_ExecutionApproval(in, out, s)
+}
+
 func autoConvert_v1alpha1_ExecutionGeneration_To_core_ExecutionGeneration(in *ExecutionGeneration, out *core.ExecutionGeneration, s conversion.Scope) error {
 	out.Name = in.Name
 	out.ObservedGeneration = in.ObservedGeneration
@@ -2109,6 +2563,7 @@ func autoConvert_v1alpha1_ExecutionSpec_To_core_ExecutionSpec(in *ExecutionSpec,
 	out.Context = in.Context
 	out.DeployItems = *(*core.DeployItemTemplateList)(unsafe.Pointer(&in.DeployItems))
 	out.RegistryPullSecrets = *(*[]core.ObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
+	out.RequireApproval = in.RequireApproval
 	return nil
 }
 
@@ -2116,6 +2571,7 @@ func autoConvert_core_ExecutionSpec_To_v1alpha1_ExecutionSpec(in *core.Execution
 	out.Context = in.Context
 	out.DeployItems = *(*DeployItemTemplateList)(unsafe.Pointer(&in.DeployItems))
 	out.RegistryPullSecrets = *(*[]ObjectReference)(unsafe.Pointer(&in.RegistryPullSecrets))
+	out.RequireApproval = in.RequireApproval
 	return nil
 }
 
@@ -2129,6 +2585,7 @@ func autoConvert_v1alpha1_ExecutionStatus_To_core_ExecutionStatus(in *ExecutionS
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
 	out.ExecutionPhase = core.ExecPhase(in.ExecutionPhase)
+	out.Approval = (*core.ExecutionApproval)(unsafe.Pointer(in.Approval))
 	return nil
 }
 
@@ -2147,6 +2604,7 @@ func autoConvert_core_ExecutionStatus_To_v1alpha1_ExecutionStatus(in *core.Execu
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
 	out.ExecutionPhase = ExecPhase(in.ExecutionPhase)
+	out.Approval = (*ExecutionApproval)(unsafe.Pointer(in.Approval))
 	return nil
 }
 
@@ -2160,6 +2618,7 @@ func autoConvert_v1alpha1_ExportDefinition_To_core_ExportDefinition(in *ExportDe
 		return err
 	}
 	out.Type = core.ExportType(in.Type)
+	out.Sensitive = in.Sensitive
 	return nil
 }
 
@@ -2173,6 +2632,7 @@ func autoConvert_core_ExportDefinition_To_v1alpha1_ExportDefinition(in *core.Exp
 		return err
 	}
 	out.Type = ExportType(in.Type)
+	out.Sensitive = in.Sensitive
 	return nil
 }
 
@@ -2181,9 +2641,129 @@ func Convert_core_ExportDefinition_To_v1alpha1_ExportDefinition(in *core.ExportD
 	return autoConvert_core_ExportDefinition_To_v1alpha1_ExportDefinition(in, out, s)
 }
 
+func autoConvert_v1alpha1_ExportGrant_To_core_ExportGrant(in *ExportGrant, out *core.ExportGrant, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ExportGrantSpec_To_core_ExportGrantSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ExportGrant_To_core_ExportGrant is an autogenerated conversion function.
+func Convert_v1alpha1_ExportGrant_To_core_ExportGrant(in *ExportGrant, out *core.ExportGrant, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExportGrant_To_core_ExportGrant(in, out, s)
+}
+
+func autoConvert_core_ExportGrant_To_v1alpha1_ExportGrant(in *core.ExportGrant, out *ExportGrant, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_ExportGrantSpec_To_v1alpha1_ExportGrantSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_ExportGrant_To_v1alpha1_ExportGrant is an autogenerated conversion function.
+func Convert_core_ExportGrant_To_v1alpha1_ExportGrant(in *core.ExportGrant, out *ExportGrant, s conversion.Scope) error {
+	return autoConvert_core_ExportGrant_To_v1alpha1_ExportGrant(in, out, s)
+}
+
+func autoConvert_v1alpha1_ExportGrantConsumer_To_core_ExportGrantConsumer(in *ExportGrantConsumer, out *core.ExportGrantConsumer, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Installations = *(*[]string)(unsafe.Pointer(&in.Installations))
+	return nil
+}
+
+// Convert_v1alpha1_ExportGrantConsumer_To_core_ExportGrantConsumer is an autogenerated conversion function.
+func Convert_v1alpha1_ExportGrantConsumer_To_core_ExportGrantConsumer(in *ExportGrantConsumer, out *core.ExportGrantConsumer, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExportGrantConsumer_To_core_ExportGrantConsumer(in, out, s)
+}
+
+func autoConvert_core_ExportGrantConsumer_To_v1alpha1_ExportGrantConsumer(in *core.ExportGrantConsumer, out *ExportGrantConsumer, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Installations = *(*[]string)(unsafe.Pointer(&in.Installations))
+	return nil
+}
+
+// Convert_core_ExportGrantConsumer_To_v1alpha1_ExportGrantConsumer is an autogenerated conversion function.
+func Convert_core_ExportGrantConsumer_To_v1alpha1_ExportGrantConsumer(in *core.ExportGrantConsumer, out *ExportGrantConsumer, s conversion.Scope) error {
+	return autoConvert_core_ExportGrantConsumer_To_v1alpha1_ExportGrantConsumer(in, out, s)
+}
+
+func autoConvert_v1alpha1_ExportGrantList_To_core_ExportGrantList(in *ExportGrantList, out *core.ExportGrantList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.ExportGrant)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_ExportGrantList_To_core_ExportGrantList is an autogenerated conversion function.
+func Convert_v1alpha1_ExportGrantList_To_core_ExportGrantList(in *ExportGrantList, out *core.ExportGrantList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExportGrantList_To_core_ExportGrantList(in, out, s)
+}
+
+func autoConvert_core_ExportGrantList_To_v1alpha1_ExportGrantList(in *core.ExportGrantList, out *ExportGrantList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ExportGrant)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_ExportGrantList_To_v1alpha1_ExportGrantList is an autogenerated conversion function.
+func Convert_core_ExportGrantList_To_v1alpha1_ExportGrantList(in *core.ExportGrantList, out *ExportGrantList, s conversion.Scope) error {
+	return autoConvert_core_ExportGrantList_To_v1alpha1_ExportGrantList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ExportGrantSpec_To_core_ExportGrantSpec(in *ExportGrantSpec, out *core.ExportGrantSpec, s conversion.Scope) error {
+	out.DataRefs = *(*[]string)(unsafe.Pointer(&in.DataRefs))
+	out.Targets = *(*[]string)(unsafe.Pointer(&in.Targets))
+	out.Consumers = *(*[]core.ExportGrantConsumer)(unsafe.Pointer(&in.Consumers))
+	return nil
+}
+
+// Convert_v1alpha1_ExportGrantSpec_To_core_ExportGrantSpec is an autogenerated conversion function.
+func Convert_v1alpha1_ExportGrantSpec_To_core_ExportGrantSpec(in *ExportGrantSpec, out *core.ExportGrantSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExportGrantSpec_To_core_ExportGrantSpec(in, out, s)
+}
+
+func autoConvert_core_ExportGrantSpec_To_v1alpha1_ExportGrantSpec(in *core.ExportGrantSpec, out *ExportGrantSpec, s conversion.Scope) error {
+	out.DataRefs = *(*[]string)(unsafe.Pointer(&in.DataRefs))
+	out.Targets = *(*[]string)(unsafe.Pointer(&in.Targets))
+	out.Consumers = *(*[]ExportGrantConsumer)(unsafe.Pointer(&in.Consumers))
+	return nil
+}
+
+// Convert_core_ExportGrantSpec_To_v1alpha1_ExportGrantSpec is an autogenerated conversion function.
+func Convert_core_ExportGrantSpec_To_v1alpha1_ExportGrantSpec(in *core.ExportGrantSpec, out *ExportGrantSpec, s conversion.Scope) error {
+	return autoConvert_core_ExportGrantSpec_To_v1alpha1_ExportGrantSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_ExternalSecretReference_To_core_ExternalSecretReference(in *ExternalSecretReference, out *core.ExternalSecretReference, s conversion.Scope) error {
+	out.Resolver = in.Resolver
+	out.Path = in.Path
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_v1alpha1_ExternalSecretReference_To_core_ExternalSecretReference is an autogenerated conversion function.
+func Convert_v1alpha1_ExternalSecretReference_To_core_ExternalSecretReference(in *ExternalSecretReference, out *core.ExternalSecretReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExternalSecretReference_To_core_ExternalSecretReference(in, out, s)
+}
+
+func autoConvert_core_ExternalSecretReference_To_v1alpha1_ExternalSecretReference(in *core.ExternalSecretReference, out *ExternalSecretReference, s conversion.Scope) error {
+	out.Resolver = in.Resolver
+	out.Path = in.Path
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_core_ExternalSecretReference_To_v1alpha1_ExternalSecretReference is an autogenerated conversion function.
+func Convert_core_ExternalSecretReference_To_v1alpha1_ExternalSecretReference(in *core.ExternalSecretReference, out *ExternalSecretReference, s conversion.Scope) error {
+	return autoConvert_core_ExternalSecretReference_To_v1alpha1_ExternalSecretReference(in, out, s)
+}
+
 func autoConvert_v1alpha1_FailedReconcile_To_core_FailedReconcile(in *FailedReconcile, out *core.FailedReconcile, s conversion.Scope) error {
 	out.NumberOfReconciles = (*int)(unsafe.Pointer(in.NumberOfReconciles))
 	out.Interval = (*core.Duration)(unsafe.Pointer(in.Interval))
+	out.Backoff = (*core.RetryBackoff)(unsafe.Pointer(in.Backoff))
+	out.ErrorCodes = (*core.RetryErrorCodes)(unsafe.Pointer(in.ErrorCodes))
 	return nil
 }
 
@@ -2195,6 +2775,8 @@ func Convert_v1alpha1_FailedReconcile_To_core_FailedReconcile(in *FailedReconcil
 func autoConvert_core_FailedReconcile_To_v1alpha1_FailedReconcile(in *core.FailedReconcile, out *FailedReconcile, s conversion.Scope) error {
 	out.NumberOfReconciles = (*int)(unsafe.Pointer(in.NumberOfReconciles))
 	out.Interval = (*Duration)(unsafe.Pointer(in.Interval))
+	out.Backoff = (*RetryBackoff)(unsafe.Pointer(in.Backoff))
+	out.ErrorCodes = (*RetryErrorCodes)(unsafe.Pointer(in.ErrorCodes))
 	return nil
 }
 
@@ -2237,6 +2819,7 @@ func autoConvert_v1alpha1_ImportDefinition_To_core_ImportDefinition(in *ImportDe
 		return err
 	}
 	out.ConditionalImports = *(*[]core.ImportDefinition)(unsafe.Pointer(&in.ConditionalImports))
+	out.Validations = *(*[]core.ValidationRule)(unsafe.Pointer(&in.Validations))
 	return nil
 }
 
@@ -2255,6 +2838,7 @@ func autoConvert_core_ImportDefinition_To_v1alpha1_ImportDefinition(in *core.Imp
 		return err
 	}
 	out.ConditionalImports = *(*ImportDefinitionList)(unsafe.Pointer(&in.ConditionalImports))
+	out.Validations = *(*[]ValidationRule)(unsafe.Pointer(&in.Validations))
 	return nil
 }
 
@@ -2421,6 +3005,88 @@ func Convert_core_InstallationList_To_v1alpha1_InstallationList(in *core.Install
 	return autoConvert_core_InstallationList_To_v1alpha1_InstallationList(in, out, s)
 }
 
+func autoConvert_v1alpha1_InstallationPlan_To_core_InstallationPlan(in *InstallationPlan, out *core.InstallationPlan, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.PlanTime = in.PlanTime
+	out.ImportsHash = in.ImportsHash
+	out.Subinstallations = *(*[]core.PlannedObject)(unsafe.Pointer(&in.Subinstallations))
+	out.DeployItems = *(*[]core.PlannedObject)(unsafe.Pointer(&in.DeployItems))
+	out.DetailsHash = in.DetailsHash
+	out.DetailsSecretRef = (*core.SecretReference)(unsafe.Pointer(in.DetailsSecretRef))
+	out.LastError = (*core.Error)(unsafe.Pointer(in.LastError))
+	return nil
+}
+
+// Convert_v1alpha1_InstallationPlan_To_core_InstallationPlan is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationPlan_To_core_InstallationPlan(in *InstallationPlan, out *core.InstallationPlan, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationPlan_To_core_InstallationPlan(in, out, s)
+}
+
+func autoConvert_core_InstallationPlan_To_v1alpha1_InstallationPlan(in *core.InstallationPlan, out *InstallationPlan, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.PlanTime = in.PlanTime
+	out.ImportsHash = in.ImportsHash
+	out.Subinstallations = *(*[]PlannedObject)(unsafe.Pointer(&in.Subinstallations))
+	out.DeployItems = *(*[]PlannedObject)(unsafe.Pointer(&in.DeployItems))
+	out.DetailsHash = in.DetailsHash
+	out.DetailsSecretRef = (*SecretReference)(unsafe.Pointer(in.DetailsSecretRef))
+	out.LastError = (*Error)(unsafe.Pointer(in.LastError))
+	return nil
+}
+
+// Convert_core_InstallationPlan_To_v1alpha1_InstallationPlan is an autogenerated conversion function.
+func Convert_core_InstallationPlan_To_v1alpha1_InstallationPlan(in *core.InstallationPlan, out *InstallationPlan, s conversion.Scope) error {
+	return autoConvert_core_InstallationPlan_To_v1alpha1_InstallationPlan(in, out, s)
+}
+
+func autoConvert_v1alpha1_InstallationRevision_To_core_InstallationRevision(in *InstallationRevision, out *core.InstallationRevision, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.JobID = in.JobID
+	out.ObservedGeneration = in.ObservedGeneration
+	out.ReconcileTime = in.ReconcileTime
+	out.RollbackOf = (*int64)(unsafe.Pointer(in.RollbackOf))
+	if err := Convert_v1alpha1_BlueprintDefinition_To_core_BlueprintDefinition(&in.Blueprint, &out.Blueprint, s); err != nil {
+		return err
+	}
+	out.ComponentDescriptor = (*core.ComponentDescriptorDefinition)(unsafe.Pointer(in.ComponentDescriptor))
+	out.ImportsHash = in.ImportsHash
+	out.Imports = *(*[]core.ImportStatus)(unsafe.Pointer(&in.Imports))
+	out.ImportValuesHash = in.ImportValuesHash
+	out.ImportValuesSecretRef = (*core.SecretReference)(unsafe.Pointer(in.ImportValuesSecretRef))
+	out.DeployItemsHash = in.DeployItemsHash
+	out.DeployItemsSecretRef = (*core.SecretReference)(unsafe.Pointer(in.DeployItemsSecretRef))
+	return nil
+}
+
+// Convert_v1alpha1_InstallationRevision_To_core_InstallationRevision is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationRevision_To_core_InstallationRevision(in *InstallationRevision, out *core.InstallationRevision, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationRevision_To_core_InstallationRevision(in, out, s)
+}
+
+func autoConvert_core_InstallationRevision_To_v1alpha1_InstallationRevision(in *core.InstallationRevision, out *InstallationRevision, s conversion.Scope) error {
+	out.Revision = in.Revision
+	out.JobID = in.JobID
+	out.ObservedGeneration = in.ObservedGeneration
+	out.ReconcileTime = in.ReconcileTime
+	out.RollbackOf = (*int64)(unsafe.Pointer(in.RollbackOf))
+	if err := Convert_core_BlueprintDefinition_To_v1alpha1_BlueprintDefinition(&in.Blueprint, &out.Blueprint, s); err != nil {
+		return err
+	}
+	out.ComponentDescriptor = (*ComponentDescriptorDefinition)(unsafe.Pointer(in.ComponentDescriptor))
+	out.ImportsHash = in.ImportsHash
+	out.Imports = *(*[]ImportStatus)(unsafe.Pointer(&in.Imports))
+	out.ImportValuesHash = in.ImportValuesHash
+	out.ImportValuesSecretRef = (*SecretReference)(unsafe.Pointer(in.ImportValuesSecretRef))
+	out.DeployItemsHash = in.DeployItemsHash
+	out.DeployItemsSecretRef = (*SecretReference)(unsafe.Pointer(in.DeployItemsSecretRef))
+	return nil
+}
+
+// Convert_core_InstallationRevision_To_v1alpha1_InstallationRevision is an autogenerated conversion function.
+func Convert_core_InstallationRevision_To_v1alpha1_InstallationRevision(in *core.InstallationRevision, out *InstallationRevision, s conversion.Scope) error {
+	return autoConvert_core_InstallationRevision_To_v1alpha1_InstallationRevision(in, out, s)
+}
+
 func autoConvert_v1alpha1_InstallationSpec_To_core_InstallationSpec(in *InstallationSpec, out *core.InstallationSpec, s conversion.Scope) error {
 	out.Context = in.Context
 	out.ComponentDescriptor = (*core.ComponentDescriptorDefinition)(unsafe.Pointer(in.ComponentDescriptor))
@@ -2437,6 +3103,8 @@ func autoConvert_v1alpha1_InstallationSpec_To_core_InstallationSpec(in *Installa
 	}
 	out.ExportDataMappings = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.AutomaticReconcile = (*core.AutomaticReconcile)(unsafe.Pointer(in.AutomaticReconcile))
+	out.RequireExecutionApproval = in.RequireExecutionApproval
+	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	return nil
 }
 
@@ -2461,6 +3129,8 @@ func autoConvert_core_InstallationSpec_To_v1alpha1_InstallationSpec(in *core.Ins
 	}
 	out.ExportDataMappings = *(*map[string]AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.AutomaticReconcile = (*AutomaticReconcile)(unsafe.Pointer(in.AutomaticReconcile))
+	out.RequireExecutionApproval = in.RequireExecutionApproval
+	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	return nil
 }
 
@@ -2482,6 +3152,11 @@ func autoConvert_v1alpha1_InstallationStatus_To_core_InstallationStatus(in *Inst
 	out.InstallationPhase = core.InstallationPhase(in.InstallationPhase)
 	out.ImportsHash = in.ImportsHash
 	out.AutomaticReconcileStatus = (*core.AutomaticReconcileStatus)(unsafe.Pointer(in.AutomaticReconcileStatus))
+	out.Plan = (*core.InstallationPlan)(unsafe.Pointer(in.Plan))
+	out.Revisions = *(*[]core.InstallationRevision)(unsafe.Pointer(&in.Revisions))
+	out.RollbackRevision = (*int64)(unsafe.Pointer(in.RollbackRevision))
+	out.ResolvedComponentVersion = (*core.ResolvedComponentVersion)(unsafe.Pointer(in.ResolvedComponentVersion))
+	out.ComponentOverwritesEvaluation = (*core.ComponentOverwritesEvaluation)(unsafe.Pointer(in.ComponentOverwritesEvaluation))
 	return nil
 }
 
@@ -2503,6 +3178,11 @@ func autoConvert_core_InstallationStatus_To_v1alpha1_InstallationStatus(in *core
 	out.InstallationPhase = InstallationPhase(in.InstallationPhase)
 	out.ImportsHash = in.ImportsHash
 	out.AutomaticReconcileStatus = (*AutomaticReconcileStatus)(unsafe.Pointer(in.AutomaticReconcileStatus))
+	out.Plan = (*InstallationPlan)(unsafe.Pointer(in.Plan))
+	out.Revisions = *(*[]InstallationRevision)(unsafe.Pointer(&in.Revisions))
+	out.RollbackRevision = (*int64)(unsafe.Pointer(in.RollbackRevision))
+	out.ResolvedComponentVersion = (*ResolvedComponentVersion)(unsafe.Pointer(in.ResolvedComponentVersion))
+	out.ComponentOverwritesEvaluation = (*ComponentOverwritesEvaluation)(unsafe.Pointer(in.ComponentOverwritesEvaluation))
 	return nil
 }
 
@@ -2717,6 +3397,166 @@ func Convert_core_ObjectReference_To_v1alpha1_ObjectReference(in *core.ObjectRef
 	return autoConvert_core_ObjectReference_To_v1alpha1_ObjectReference(in, out, s)
 }
 
+func autoConvert_v1alpha1_OrphanReport_To_core_OrphanReport(in *OrphanReport, out *core.OrphanReport, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_OrphanReportStatus_To_core_OrphanReportStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_OrphanReport_To_core_OrphanReport is an autogenerated conversion function.
+func Convert_v1alpha1_OrphanReport_To_core_OrphanReport(in *OrphanReport, out *core.OrphanReport, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OrphanReport_To_core_OrphanReport(in, out, s)
+}
+
+func autoConvert_core_OrphanReport_To_v1alpha1_OrphanReport(in *core.OrphanReport, out *OrphanReport, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_OrphanReportStatus_To_v1alpha1_OrphanReportStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_OrphanReport_To_v1alpha1_OrphanReport is an autogenerated conversion function.
+func Convert_core_OrphanReport_To_v1alpha1_OrphanReport(in *core.OrphanReport, out *OrphanReport, s conversion.Scope) error {
+	return autoConvert_core_OrphanReport_To_v1alpha1_OrphanReport(in, out, s)
+}
+
+func autoConvert_v1alpha1_OrphanReportList_To_core_OrphanReportList(in *OrphanReportList, out *core.OrphanReportList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.OrphanReport)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_OrphanReportList_To_core_OrphanReportList is an autogenerated conversion function.
+func Convert_v1alpha1_OrphanReportList_To_core_OrphanReportList(in *OrphanReportList, out *core.OrphanReportList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OrphanReportList_To_core_OrphanReportList(in, out, s)
+}
+
+func autoConvert_core_OrphanReportList_To_v1alpha1_OrphanReportList(in *core.OrphanReportList, out *OrphanReportList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]OrphanReport)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_OrphanReportList_To_v1alpha1_OrphanReportList is an autogenerated conversion function.
+func Convert_core_OrphanReportList_To_v1alpha1_OrphanReportList(in *core.OrphanReportList, out *OrphanReportList, s conversion.Scope) error {
+	return autoConvert_core_OrphanReportList_To_v1alpha1_OrphanReportList(in, out, s)
+}
+
+func autoConvert_v1alpha1_OrphanReportStatus_To_core_OrphanReportStatus(in *OrphanReportStatus, out *core.OrphanReportStatus, s conversion.Scope) error {
+	out.LastUpdateTime = in.LastUpdateTime
+	out.DataObjects = *(*[]core.OrphanedObject)(unsafe.Pointer(&in.DataObjects))
+	out.Targets = *(*[]core.OrphanedObject)(unsafe.Pointer(&in.Targets))
+	return nil
+}
+
+// Convert_v1alpha1_OrphanReportStatus_To_core_OrphanReportStatus is an autogenerated conversion function.
+func Convert_v1alpha1_OrphanReportStatus_To_core_OrphanReportStatus(in *OrphanReportStatus, out *core.OrphanReportStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OrphanReportStatus_To_core_OrphanReportStatus(in, out, s)
+}
+
+func autoConvert_core_OrphanReportStatus_To_v1alpha1_OrphanReportStatus(in *core.OrphanReportStatus, out *OrphanReportStatus, s conversion.Scope) error {
+	out.LastUpdateTime = in.LastUpdateTime
+	out.DataObjects = *(*[]OrphanedObject)(unsafe.Pointer(&in.DataObjects))
+	out.Targets = *(*[]OrphanedObject)(unsafe.Pointer(&in.Targets))
+	return nil
+}
+
+// Convert_core_OrphanReportStatus_To_v1alpha1_OrphanReportStatus is an autogenerated conversion function.
+func Convert_core_OrphanReportStatus_To_v1alpha1_OrphanReportStatus(in *core.OrphanReportStatus, out *OrphanReportStatus, s conversion.Scope) error {
+	return autoConvert_core_OrphanReportStatus_To_v1alpha1_OrphanReportStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_OrphanedObject_To_core_OrphanedObject(in *OrphanedObject, out *core.OrphanedObject, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Context = in.Context
+	out.Key = in.Key
+	out.Source = in.Source
+	out.SourceType = string(in.SourceType)
+	out.Reason = core.OrphanReason(in.Reason)
+	out.OrphanedSince = in.OrphanedSince
+	out.DeletionTime = (*v1.Time)(unsafe.Pointer(in.DeletionTime))
+	return nil
+}
+
+// Convert_v1alpha1_OrphanedObject_To_core_OrphanedObject is an autogenerated conversion function.
+func Convert_v1alpha1_OrphanedObject_To_core_OrphanedObject(in *OrphanedObject, out *core.OrphanedObject, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OrphanedObject_To_core_OrphanedObject(in, out, s)
+}
+
+func autoConvert_core_OrphanedObject_To_v1alpha1_OrphanedObject(in *core.OrphanedObject, out *OrphanedObject, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Context = in.Context
+	out.Key = in.Key
+	out.Source = in.Source
+	out.SourceType = DataObjectSourceType(in.SourceType)
+	out.Reason = OrphanReason(in.Reason)
+	out.OrphanedSince = in.OrphanedSince
+	out.DeletionTime = (*v1.Time)(unsafe.Pointer(in.DeletionTime))
+	return nil
+}
+
+// Convert_core_OrphanedObject_To_v1alpha1_OrphanedObject is an autogenerated conversion function.
+func Convert_core_OrphanedObject_To_v1alpha1_OrphanedObject(in *core.OrphanedObject, out *OrphanedObject, s conversion.Scope) error {
+	return autoConvert_core_OrphanedObject_To_v1alpha1_OrphanedObject(in, out, s)
+}
+
+func autoConvert_v1alpha1_PlannedChange_To_core_PlannedChange(in *PlannedChange, out *core.PlannedChange, s conversion.Scope) error {
+	out.Path = in.Path
+	out.Old = (*core.AnyJSON)(unsafe.Pointer(in.Old))
+	out.New = (*core.AnyJSON)(unsafe.Pointer(in.New))
+	return nil
+}
+
+// Convert_v1alpha1_PlannedChange_To_core_PlannedChange is an autogenerated conversion function.
+func Convert_v1alpha1_PlannedChange_To_core_PlannedChange(in *PlannedChange, out *core.PlannedChange, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PlannedChange_To_core_PlannedChange(in, out, s)
+}
+
+func autoConvert_core_PlannedChange_To_v1alpha1_PlannedChange(in *core.PlannedChange, out *PlannedChange, s conversion.Scope) error {
+	out.Path = in.Path
+	out.Old = (*AnyJSON)(unsafe.Pointer(in.Old))
+	out.New = (*AnyJSON)(unsafe.Pointer(in.New))
+	return nil
+}
+
+// Convert_core_PlannedChange_To_v1alpha1_PlannedChange is an autogenerated conversion function.
+func Convert_core_PlannedChange_To_v1alpha1_PlannedChange(in *core.PlannedChange, out *PlannedChange, s conversion.Scope) error {
+	return autoConvert_core_PlannedChange_To_v1alpha1_PlannedChange(in, out, s)
+}
+
+func autoConvert_v1alpha1_PlannedObject_To_core_PlannedObject(in *PlannedObject, out *core.PlannedObject, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Action = core.PlanAction(in.Action)
+	out.Reference = (*core.ObjectReference)(unsafe.Pointer(in.Reference))
+	out.NumberOfChanges = in.NumberOfChanges
+	out.Rendered = (*core.AnyJSON)(unsafe.Pointer(in.Rendered))
+	out.Changes = *(*[]core.PlannedChange)(unsafe.Pointer(&in.Changes))
+	return nil
+}
+
+// Convert_v1alpha1_PlannedObject_To_core_PlannedObject is an autogenerated conversion function.
+func Convert_v1alpha1_PlannedObject_To_core_PlannedObject(in *PlannedObject, out *core.PlannedObject, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PlannedObject_To_core_PlannedObject(in, out, s)
+}
+
+func autoConvert_core_PlannedObject_To_v1alpha1_PlannedObject(in *core.PlannedObject, out *PlannedObject, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Action = PlanAction(in.Action)
+	out.Reference = (*ObjectReference)(unsafe.Pointer(in.Reference))
+	out.NumberOfChanges = in.NumberOfChanges
+	out.Rendered = (*AnyJSON)(unsafe.Pointer(in.Rendered))
+	out.Changes = *(*[]PlannedChange)(unsafe.Pointer(&in.Changes))
+	return nil
+}
+
+// Convert_core_PlannedObject_To_v1alpha1_PlannedObject is an autogenerated conversion function.
+func Convert_core_PlannedObject_To_v1alpha1_PlannedObject(in *core.PlannedObject, out *PlannedObject, s conversion.Scope) error {
+	return autoConvert_core_PlannedObject_To_v1alpha1_PlannedObject(in, out, s)
+}
+
 func autoConvert_v1alpha1_RemoteBlueprintReference_To_core_RemoteBlueprintReference(in *RemoteBlueprintReference, out *core.RemoteBlueprintReference, s conversion.Scope) error {
 	out.ResourceName = in.ResourceName
 	return nil
@@ -2761,6 +3601,34 @@ func Convert_core_Requirement_To_v1alpha1_Requirement(in *core.Requirement, out
 	return autoConvert_core_Requirement_To_v1alpha1_Requirement(in, out, s)
 }
 
+func autoConvert_v1alpha1_ResolvedComponentVersion_To_core_ResolvedComponentVersion(in *ResolvedComponentVersion, out *core.ResolvedComponentVersion, s conversion.Scope) error {
+	out.Constraint = in.Constraint
+	out.Version = in.Version
+	out.JobID = in.JobID
+	out.LatestVersion = in.LatestVersion
+	out.LastCheckTime = in.LastCheckTime
+	return nil
+}
+
+// Convert_v1alpha1_ResolvedComponentVersion_To_core_ResolvedComponentVersion is an autogenerated conversion function.
+func Convert_v1alpha1_ResolvedComponentVersion_To_core_ResolvedComponentVersion(in *ResolvedComponentVersion, out *core.ResolvedComponentVersion, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResolvedComponentVersion_To_core_ResolvedComponentVersion(in, out, s)
+}
+
+func autoConvert_core_ResolvedComponentVersion_To_v1alpha1_ResolvedComponentVersion(in *core.ResolvedComponentVersion, out *ResolvedComponentVersion, s conversion.Scope) error {
+	out.Constraint = in.Constraint
+	out.Version = in.Version
+	out.JobID = in.JobID
+	out.LatestVersion = in.LatestVersion
+	out.LastCheckTime = in.LastCheckTime
+	return nil
+}
+
+// Convert_core_ResolvedComponentVersion_To_v1alpha1_ResolvedComponentVersion is an autogenerated conversion function.
+func Convert_core_ResolvedComponentVersion_To_v1alpha1_ResolvedComponentVersion(in *core.ResolvedComponentVersion, out *ResolvedComponentVersion, s conversion.Scope) error {
+	return autoConvert_core_ResolvedComponentVersion_To_v1alpha1_ResolvedComponentVersion(in, out, s)
+}
+
 func autoConvert_v1alpha1_ResolvedTarget_To_core_ResolvedTarget(in *ResolvedTarget, out *core.ResolvedTarget, s conversion.Scope) error {
 	out.Target = (*core.Target)(unsafe.Pointer(in.Target))
 	out.Content = in.Content
@@ -2805,6 +3673,54 @@ func Convert_core_ResourceReference_To_v1alpha1_ResourceReference(in *core.Resou
 	return autoConvert_core_ResourceReference_To_v1alpha1_ResourceReference(in, out, s)
 }
 
+func autoConvert_v1alpha1_RetryBackoff_To_core_RetryBackoff(in *RetryBackoff, out *core.RetryBackoff, s conversion.Scope) error {
+	out.Factor = (*int32)(unsafe.Pointer(in.Factor))
+	out.MaxInterval = (*core.Duration)(unsafe.Pointer(in.MaxInterval))
+	out.JitterPercent = (*int32)(unsafe.Pointer(in.JitterPercent))
+	return nil
+}
+
+// Convert_v1alpha1_RetryBackoff_To_core_RetryBackoff is an autogenerated conversion function.
+func Convert_v1alpha1_RetryBackoff_To_core_RetryBackoff(in *RetryBackoff, out *core.RetryBackoff, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RetryBackoff_To_core_RetryBackoff(in, out, s)
+}
+
+func autoConvert_core_RetryBackoff_To_v1alpha1_RetryBackoff(in *core.RetryBackoff, out *RetryBackoff, s conversion.Scope) error {
+	out.Factor = (*int32)(unsafe.Pointer(in.Factor))
+	out.MaxInterval = (*Duration)(unsafe.Pointer(in.MaxInterval))
+	out.JitterPercent = (*int32)(unsafe.Pointer(in.JitterPercent))
+	return nil
+}
+
+// Convert_core_RetryBackoff_To_v1alpha1_RetryBackoff is an autogenerated conversion function.
+func Convert_core_RetryBackoff_To_v1alpha1_RetryBackoff(in *core.RetryBackoff, out *RetryBackoff, s conversion.Scope) error {
+	return autoConvert_core_RetryBackoff_To_v1alpha1_RetryBackoff(in, out, s)
+}
+
+func autoConvert_v1alpha1_RetryErrorCodes_To_core_RetryErrorCodes(in *RetryErrorCodes, out *core.RetryErrorCodes, s conversion.Scope) error {
+	out.NoRetry = *(*[]core.ErrorCode)(unsafe.Pointer(&in.NoRetry))
+	out.FastRetry = *(*[]core.ErrorCode)(unsafe.Pointer(&in.FastRetry))
+	out.FastRetryInterval = (*core.Duration)(unsafe.Pointer(in.FastRetryInterval))
+	return nil
+}
+
+// Convert_v1alpha1_RetryErrorCodes_To_core_RetryErrorCodes is an autogenerated conversion function.
+func Convert_v1alpha1_RetryErrorCodes_To_core_RetryErrorCodes(in *RetryErrorCodes, out *core.RetryErrorCodes, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RetryErrorCodes_To_core_RetryErrorCodes(in, out, s)
+}
+
+func autoConvert_core_RetryErrorCodes_To_v1alpha1_RetryErrorCodes(in *core.RetryErrorCodes, out *RetryErrorCodes, s conversion.Scope) error {
+	out.NoRetry = *(*[]ErrorCode)(unsafe.Pointer(&in.NoRetry))
+	out.FastRetry = *(*[]ErrorCode)(unsafe.Pointer(&in.FastRetry))
+	out.FastRetryInterval = (*Duration)(unsafe.Pointer(in.FastRetryInterval))
+	return nil
+}
+
+// Convert_core_RetryErrorCodes_To_v1alpha1_RetryErrorCodes is an autogenerated conversion function.
+func Convert_core_RetryErrorCodes_To_v1alpha1_RetryErrorCodes(in *core.RetryErrorCodes, out *RetryErrorCodes, s conversion.Scope) error {
+	return autoConvert_core_RetryErrorCodes_To_v1alpha1_RetryErrorCodes(in, out, s)
+}
+
 func autoConvert_v1alpha1_SecretLabelSelectorRef_To_core_SecretLabelSelectorRef(in *SecretLabelSelectorRef, out *core.SecretLabelSelectorRef, s conversion.Scope) error {
 	out.Selector = *(*map[string]string)(unsafe.Pointer(&in.Selector))
 	out.Key = in.Key
@@ -2853,6 +3769,28 @@ func Convert_core_SecretReference_To_v1alpha1_SecretReference(in *core.SecretRef
 	return autoConvert_core_SecretReference_To_v1alpha1_SecretReference(in, out, s)
 }
 
+func autoConvert_v1alpha1_SharedExportReference_To_core_SharedExportReference(in *SharedExportReference, out *core.SharedExportReference, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_SharedExportReference_To_core_SharedExportReference is an autogenerated conversion function.
+func Convert_v1alpha1_SharedExportReference_To_core_SharedExportReference(in *SharedExportReference, out *core.SharedExportReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SharedExportReference_To_core_SharedExportReference(in, out, s)
+}
+
+func autoConvert_core_SharedExportReference_To_v1alpha1_SharedExportReference(in *core.SharedExportReference, out *SharedExportReference, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_core_SharedExportReference_To_v1alpha1_SharedExportReference is an autogenerated conversion function.
+func Convert_core_SharedExportReference_To_v1alpha1_SharedExportReference(in *core.SharedExportReference, out *SharedExportReference, s conversion.Scope) error {
+	return autoConvert_core_SharedExportReference_To_v1alpha1_SharedExportReference(in, out, s)
+}
+
 func autoConvert_v1alpha1_StaticDataSource_To_core_StaticDataSource(in *StaticDataSource, out *core.StaticDataSource, s conversion.Scope) error {
 	if err := Convert_v1alpha1_AnyJSON_To_core_AnyJSON(&in.Value, &out.Value, s); err != nil {
 		return err
@@ -2880,7 +3818,7 @@ func Convert_core_StaticDataSource_To_v1alpha1_StaticDataSource(in *core.StaticD
 }
 
 func autoConvert_v1alpha1_StaticDataValueFrom_To_core_StaticDataValueFrom(in *StaticDataValueFrom, out *core.StaticDataValueFrom, s conversion.Scope) error {
-	out.SecretKeyRef = (*v1.SecretKeySelector)(unsafe.Pointer(in.SecretKeyRef))
+	out.SecretKeyRef = (*corev1.SecretKeySelector)(unsafe.Pointer(in.SecretKeyRef))
 	out.SecretLabelSelector = (*core.SecretLabelSelectorRef)(unsafe.Pointer(in.SecretLabelSelector))
 	return nil
 }
@@ -2891,7 +3829,7 @@ func Convert_v1alpha1_StaticDataValueFrom_To_core_StaticDataValueFrom(in *Static
 }
 
 func autoConvert_core_StaticDataValueFrom_To_v1alpha1_StaticDataValueFrom(in *core.StaticDataValueFrom, out *StaticDataValueFrom, s conversion.Scope) error {
-	out.SecretKeyRef = (*v1.SecretKeySelector)(unsafe.Pointer(in.SecretKeyRef))
+	out.SecretKeyRef = (*corev1.SecretKeySelector)(unsafe.Pointer(in.SecretKeyRef))
 	out.SecretLabelSelector = (*SecretLabelSelectorRef)(unsafe.Pointer(in.SecretLabelSelector))
 	return nil
 }
@@ -3022,6 +3960,7 @@ func autoConvert_v1alpha1_TargetImport_To_core_TargetImport(in *TargetImport, ou
 	out.Target = in.Target
 	out.Targets = *(*[]string)(unsafe.Pointer(&in.Targets))
 	out.TargetListReference = in.TargetListReference
+	out.SharedTarget = (*core.SharedExportReference)(unsafe.Pointer(in.SharedTarget))
 	return nil
 }
 
@@ -3035,6 +3974,7 @@ func autoConvert_core_TargetImport_To_v1alpha1_TargetImport(in *core.TargetImpor
 	out.Target = in.Target
 	out.Targets = *(*[]string)(unsafe.Pointer(&in.Targets))
 	out.TargetListReference = in.TargetListReference
+	out.SharedTarget = (*SharedExportReference)(unsafe.Pointer(in.SharedTarget))
 	return nil
 }
 
@@ -3117,6 +4057,7 @@ func autoConvert_v1alpha1_TargetSpec_To_core_TargetSpec(in *TargetSpec, out *cor
 	out.Type = core.TargetType(in.Type)
 	out.Configuration = (*core.AnyJSON)(unsafe.Pointer(in.Configuration))
 	out.SecretRef = (*core.LocalSecretReference)(unsafe.Pointer(in.SecretRef))
+	out.ExternalSecretRef = (*core.ExternalSecretReference)(unsafe.Pointer(in.ExternalSecretRef))
 	return nil
 }
 
@@ -3129,6 +4070,7 @@ func autoConvert_core_TargetSpec_To_v1alpha1_TargetSpec(in *core.TargetSpec, out
 	out.Type = TargetType(in.Type)
 	out.Configuration = (*AnyJSON)(unsafe.Pointer(in.Configuration))
 	out.SecretRef = (*LocalSecretReference)(unsafe.Pointer(in.SecretRef))
+	out.ExternalSecretRef = (*ExternalSecretReference)(unsafe.Pointer(in.ExternalSecretRef))
 	return nil
 }
 
@@ -3229,9 +4171,9 @@ func Convert_core_TargetSyncSpec_To_v1alpha1_TargetSyncSpec(in *core.TargetSyncS
 
 func autoConvert_v1alpha1_TargetSyncStatus_To_core_TargetSyncStatus(in *TargetSyncStatus, out *core.TargetSyncStatus, s conversion.Scope) error {
 	out.ObservedGeneration = in.ObservedGeneration
-	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.LastUpdateTime = (*v1.Time)(unsafe.Pointer(in.LastUpdateTime))
 	out.LastErrors = *(*[]string)(unsafe.Pointer(&in.LastErrors))
-	out.LastTokenRotationTime = (*metav1.Time)(unsafe.Pointer(in.LastTokenRotationTime))
+	out.LastTokenRotationTime = (*v1.Time)(unsafe.Pointer(in.LastTokenRotationTime))
 	return nil
 }
 
@@ -3242,9 +4184,9 @@ func Convert_v1alpha1_TargetSyncStatus_To_core_TargetSyncStatus(in *TargetSyncSt
 
 func autoConvert_core_TargetSyncStatus_To_v1alpha1_TargetSyncStatus(in *core.TargetSyncStatus, out *TargetSyncStatus, s conversion.Scope) error {
 	out.ObservedGeneration = in.ObservedGeneration
-	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.LastUpdateTime = (*v1.Time)(unsafe.Pointer(in.LastUpdateTime))
 	out.LastErrors = *(*[]string)(unsafe.Pointer(&in.LastErrors))
-	out.LastTokenRotationTime = (*metav1.Time)(unsafe.Pointer(in.LastTokenRotationTime))
+	out.LastTokenRotationTime = (*v1.Time)(unsafe.Pointer(in.LastTokenRotationTime))
 	return nil
 }
 
@@ -3359,6 +4301,28 @@ func Convert_core_TypedObjectReference_To_v1alpha1_TypedObjectReference(in *core
 	return autoConvert_core_TypedObjectReference_To_v1alpha1_TypedObjectReference(in, out, s)
 }
 
+func autoConvert_v1alpha1_ValidationRule_To_core_ValidationRule(in *ValidationRule, out *core.ValidationRule, s conversion.Scope) error {
+	out.Rule = in.Rule
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_ValidationRule_To_core_ValidationRule is an autogenerated conversion function.
+func Convert_v1alpha1_ValidationRule_To_core_ValidationRule(in *ValidationRule, out *core.ValidationRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ValidationRule_To_core_ValidationRule(in, out, s)
+}
+
+func autoConvert_core_ValidationRule_To_v1alpha1_ValidationRule(in *core.ValidationRule, out *ValidationRule, s conversion.Scope) error {
+	out.Rule = in.Rule
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_core_ValidationRule_To_v1alpha1_ValidationRule is an autogenerated conversion function.
+func Convert_core_ValidationRule_To_v1alpha1_ValidationRule(in *core.ValidationRule, out *ValidationRule, s conversion.Scope) error {
+	return autoConvert_core_ValidationRule_To_v1alpha1_ValidationRule(in, out, s)
+}
+
 func autoConvert_v1alpha1_VersionedNamedObjectReference_To_core_VersionedNamedObjectReference(in *VersionedNamedObjectReference, out *core.VersionedNamedObjectReference, s conversion.Scope) error {
 	out.Name = in.Name
 	if err := Convert_v1alpha1_VersionedObjectReference_To_core_VersionedObjectReference(&in.Reference, &out.Reference, s); err != nil {
@@ -10,6 +10,8 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker/decls"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation"
 
@@ -34,6 +36,7 @@ func ValidateBlueprint(blueprint *core.Blueprint) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, ValidateBlueprintImportDefinitions(field.NewPath("imports"), blueprint.Imports)...)
 	allErrs = append(allErrs, ValidateBlueprintExportDefinitions(field.NewPath("exports"), blueprint.Exports)...)
+	allErrs = append(allErrs, ValidateValidationRules(field.NewPath("importValidations"), blueprint.ImportValidations, ImportsVariable)...)
 	allErrs = append(allErrs, ValidateTemplateExecutorList(field.NewPath("deployExecutions"), blueprint.DeployExecutions)...)
 	allErrs = append(allErrs, ValidateTemplateExecutorList(field.NewPath("exportExecutions"), blueprint.ExportExecutions)...)
 	allErrs = append(allErrs, ValidateSubinstallations(field.NewPath("subinstallations"), blueprint.Subinstallations)...)
@@ -98,6 +101,7 @@ func validateBlueprintImportDefinitions(fldPath *field.Path, imports []core.Impo
 		}
 
 		allErrs = append(allErrs, ValidateFieldValueDefinition(defPath, importDef.FieldValueDefinition)...)
+		allErrs = append(allErrs, ValidateValidationRules(defPath.Child("validations"), importDef.Validations, ImportValueVariable)...)
 		conditionalImportNames, tmpErrs := validateBlueprintImportDefinitions(defPath.Child("conditionalImports"), importDef.ConditionalImports, importNames)
 		allErrs = append(allErrs, tmpErrs...)
 		importNames.Insert(conditionalImportNames.UnsortedList()...)
@@ -106,6 +110,45 @@ func validateBlueprintImportDefinitions(fldPath *field.Path, imports []core.Impo
 	return importNames, allErrs
 }
 
+const (
+	// ImportValueVariable is the name of the variable that contains the imported value in the validation rules of an import.
+	ImportValueVariable = "self"
+	// ImportsVariable is the name of the variable that contains all imports in the import validation rules of a blueprint.
+	ImportsVariable = "imports"
+)
+
+// NewValidationRuleEnv creates the CEL environment in which validation rules are compiled and evaluated.
+// The validated value is available in the rules under the given variable name.
+func NewValidationRuleEnv(variable string) (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Declarations(decls.NewVar(variable, decls.Dyn)),
+		cel.CrossTypeNumericComparisons(true))
+}
+
+// ValidateValidationRules validates a list of CEL validation rules
+// by compiling them in the environment in which they are evaluated when the imports are validated.
+func ValidateValidationRules(fldPath *field.Path, rules []core.ValidationRule, variable string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(rules) == 0 {
+		return allErrs
+	}
+	env, err := NewValidationRuleEnv(variable)
+	if err != nil {
+		return append(allErrs, field.InternalError(fldPath, fmt.Errorf("unable to create CEL environment: %w", err)))
+	}
+	for i, rule := range rules {
+		rulePath := fldPath.Index(i).Child("rule")
+		if len(strings.TrimSpace(rule.Rule)) == 0 {
+			allErrs = append(allErrs, field.Required(rulePath, "rule must not be empty"))
+			continue
+		}
+		if _, issues := env.Compile(rule.Rule); issues != nil && issues.Err() != nil {
+			allErrs = append(allErrs, field.Invalid(rulePath, rule.Rule, issues.Err().Error()))
+		}
+	}
+	return allErrs
+}
+
 // ValidateBlueprintExportDefinitions validates a list of export definitions
 func ValidateBlueprintExportDefinitions(fldPath *field.Path, exports []core.ExportDefinition) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -139,6 +182,9 @@ func ValidateBlueprintExportDefinitions(fldPath *field.Path, exports []core.Expo
 			allErrs = append(allErrs, ValidateExactlyOneOf(defPath, exportDef, "Schema", "TargetType")...)
 		}
 
+		if exportDef.Sensitive && (exportDef.Type == core.ExportTypeTarget || len(exportDef.TargetType) != 0) {
+			allErrs = append(allErrs, field.Forbidden(defPath.Child("sensitive"), "only data exports can be sensitive"))
+		}
 	}
 
 	return allErrs
@@ -437,6 +483,7 @@ func ValidateInstallationTemplate(fldPath *field.Path, template *core.Installati
 	}
 
 	allErrs = append(allErrs, ValidateInstallationTemplateImports(template.Imports, fldPath.Child("imports"))...)
+	allErrs = append(allErrs, validateNoSharedImports(template.Imports, fldPath.Child("imports"))...)
 	allErrs = append(allErrs, ValidateInstallationExports(template.Exports, fldPath.Child("exports"))...)
 
 	return allErrs
@@ -202,6 +202,102 @@ var _ = Describe("Blueprint", func() {
 				"Field": Equal("b[0][myimport]"),
 			}))))
 		})
+
+		It("should pass if a data export is sensitive", func() {
+			exportDefinition := core.ExportDefinition{}
+			exportDefinition.Name = "my-export"
+			exportDefinition.Type = core.ExportTypeData
+			exportDefinition.Schema = &core.JSONSchemaDefinition{}
+			exportDefinition.Sensitive = true
+
+			allErrs := validation.ValidateBlueprintExportDefinitions(field.NewPath("b"), []core.ExportDefinition{exportDefinition})
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should fail if a target export is sensitive", func() {
+			exportDefinition := core.ExportDefinition{}
+			exportDefinition.Name = "my-export"
+			exportDefinition.Type = core.ExportTypeTarget
+			exportDefinition.TargetType = "test"
+			exportDefinition.Sensitive = true
+
+			allErrs := validation.ValidateBlueprintExportDefinitions(field.NewPath("b"), []core.ExportDefinition{exportDefinition})
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("b[0][my-export].sensitive"),
+			}))))
+		})
+	})
+
+	Context("ValidationRules", func() {
+		It("should pass if an import defines validation rules", func() {
+			importDefinition := core.ImportDefinition{}
+			importDefinition.Name = "my-import"
+			importDefinition.Type = core.ImportTypeData
+			importDefinition.Schema = &core.JSONSchemaDefinition{}
+			importDefinition.Validations = []core.ValidationRule{{Rule: "!self.ha || self.replicas >= 3"}}
+
+			allErrs := validation.ValidateBlueprintImportDefinitions(field.NewPath("b"), []core.ImportDefinition{importDefinition})
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should fail if a validation rule of an import is empty", func() {
+			importDefinition := core.ImportDefinition{}
+			importDefinition.Name = "my-import"
+			importDefinition.Type = core.ImportTypeData
+			importDefinition.Schema = &core.JSONSchemaDefinition{}
+			importDefinition.Validations = []core.ValidationRule{{Message: "abc"}}
+
+			allErrs := validation.ValidateBlueprintImportDefinitions(field.NewPath("b"), []core.ImportDefinition{importDefinition})
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("b[0][my-import].validations[0].rule"),
+			}))))
+		})
+
+		It("should fail if an import validation rule of the blueprint is empty", func() {
+			blueprint := &core.Blueprint{}
+			blueprint.ImportValidations = []core.ValidationRule{{Rule: "imports.a == imports.b"}, {Rule: " "}}
+
+			allErrs := validation.ValidateBlueprint(blueprint)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("importValidations[1].rule"),
+			}))))
+		})
+
+		It("should fail if a validation rule of an import cannot be compiled", func() {
+			importDefinition := core.ImportDefinition{}
+			importDefinition.Name = "my-import"
+			importDefinition.Type = core.ImportTypeData
+			importDefinition.Schema = &core.JSONSchemaDefinition{}
+			importDefinition.Validations = []core.ValidationRule{{Rule: "self.replicas >="}, {Rule: "imports.a == 1"}}
+
+			allErrs := validation.ValidateBlueprintImportDefinitions(field.NewPath("b"), []core.ImportDefinition{importDefinition})
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":     Equal(field.ErrorTypeInvalid),
+					"Field":    Equal("b[0][my-import].validations[0].rule"),
+					"BadValue": Equal("self.replicas >="),
+				})),
+				// the imports variable is only available in the import validations of the blueprint
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("b[0][my-import].validations[1].rule"),
+				})),
+			))
+		})
+
+		It("should fail if an import validation rule of the blueprint cannot be compiled", func() {
+			blueprint := &core.Blueprint{}
+			blueprint.ImportValidations = []core.ValidationRule{{Rule: "imports.a == imports.b"}, {Rule: "size(imports.a, 1)"}}
+
+			allErrs := validation.ValidateBlueprint(blueprint)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("importValidations[1].rule"),
+			}))))
+		})
 	})
 
 	Context("TemplateExecutor", func() {
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+)
+
+// ValidateExportGrant validates an ExportGrant
+func ValidateExportGrant(grant *core.ExportGrant) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, ValidateExportGrantSpec(&grant.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateExportGrantSpec validates the spec of an ExportGrant
+func ValidateExportGrantSpec(spec *core.ExportGrantSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.DataRefs) == 0 && len(spec.Targets) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one dataRef or target must be shared"))
+	}
+	allErrs = append(allErrs, validateSharedExportNames(spec.DataRefs, fldPath.Child("dataRefs"))...)
+	allErrs = append(allErrs, validateSharedExportNames(spec.Targets, fldPath.Child("targets"))...)
+
+	if len(spec.Consumers) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("consumers"), "at least one consumer must be defined"))
+	}
+	for idx, consumer := range spec.Consumers {
+		consumerPath := fldPath.Child("consumers").Index(idx)
+		if len(consumer.Namespace) == 0 {
+			allErrs = append(allErrs, field.Required(consumerPath.Child("namespace"), "namespace must not be empty"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(consumer.Namespace) {
+				allErrs = append(allErrs, field.Invalid(consumerPath.Child("namespace"), consumer.Namespace, msg))
+			}
+		}
+		for i, name := range consumer.Installations {
+			if len(name) == 0 {
+				allErrs = append(allErrs, field.Required(consumerPath.Child("installations").Index(i), "installation name must not be empty"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateSharedExportNames(names []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	seen := sets.NewString()
+	for idx, name := range names {
+		if len(name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Index(idx), "name must not be empty"))
+			continue
+		}
+		if seen.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(idx), name))
+		}
+		seen.Insert(name)
+	}
+	return allErrs
+}
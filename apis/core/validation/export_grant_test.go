@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/validation"
+)
+
+var _ = Describe("ExportGrant", func() {
+
+	It("should accept a valid ExportGrant", func() {
+		grant := &core.ExportGrant{
+			Spec: core.ExportGrantSpec{
+				DataRefs: []string{"my-data"},
+				Targets:  []string{"my-cluster"},
+				Consumers: []core.ExportGrantConsumer{
+					{Namespace: "tenant-a"},
+					{Namespace: "tenant-b", Installations: []string{"my-inst"}},
+				},
+			},
+		}
+		Expect(validation.ValidateExportGrant(grant)).To(BeEmpty())
+	})
+
+	It("should reject an ExportGrant without shared exports and consumers", func() {
+		grant := &core.ExportGrant{}
+		allErrs := validation.ValidateExportGrant(grant)
+		Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("spec"),
+		}))))
+		Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("spec.consumers"),
+		}))))
+	})
+
+	It("should reject duplicated shared exports and invalid consumers", func() {
+		grant := &core.ExportGrant{
+			Spec: core.ExportGrantSpec{
+				DataRefs:  []string{"my-data", "my-data"},
+				Consumers: []core.ExportGrantConsumer{{Namespace: "Invalid_Namespace"}, {}},
+			},
+		}
+		allErrs := validation.ValidateExportGrant(grant)
+		Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeDuplicate),
+			"Field": Equal("spec.dataRefs[1]"),
+		}))))
+		Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("spec.consumers[0].namespace"),
+		}))))
+		Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("spec.consumers[1].namespace"),
+		}))))
+	})
+})
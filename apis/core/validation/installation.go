@@ -27,6 +27,35 @@ func ValidateInstallation(inst *core.Installation) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, validateInstallationObjectMeta(&inst.ObjectMeta, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, ValidateInstallationSpec(&inst.Spec, field.NewPath("spec"))...)
+	if isSubinstallation(inst) {
+		allErrs = append(allErrs, validateNoSharedImports(inst.Spec.Imports, field.NewPath("spec").Child("imports"))...)
+	}
+	return allErrs
+}
+
+// isSubinstallation checks whether the installation is owned by another installation.
+func isSubinstallation(inst *core.Installation) bool {
+	for _, ref := range inst.OwnerReferences {
+		if ref.Kind == "Installation" {
+			return true
+		}
+	}
+	return false
+}
+
+// validateNoSharedImports validates that no shared exports of other namespaces are imported.
+func validateNoSharedImports(imports core.InstallationImports, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for idx, imp := range imports.Data {
+		if imp.SharedDataRef != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("data").Index(idx).Child("sharedDataRef"), "shared exports can only be imported by root installations"))
+		}
+	}
+	for idx, imp := range imports.Targets {
+		if imp.SharedTarget != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("targets").Index(idx).Child("sharedTarget"), "shared exports can only be imported by root installations"))
+		}
+	}
 	return allErrs
 }
 
@@ -58,6 +87,28 @@ func ValidateInstallationSpec(spec *core.InstallationSpec, fldPath *field.Path)
 	// check RegistryPullSecrets
 	allErrs = append(allErrs, ValidateObjectReferenceList(spec.RegistryPullSecrets, fldPath.Child("registryPullSecrets"))...)
 
+	if spec.AutomaticReconcile != nil && spec.AutomaticReconcile.FailedReconcile != nil && spec.AutomaticReconcile.FailedReconcile.Backoff != nil {
+		allErrs = append(allErrs, ValidateRetryBackoff(spec.AutomaticReconcile.FailedReconcile.Backoff,
+			fldPath.Child("automaticReconcile", "failedReconcile", "backoff"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateRetryBackoff validates the backoff of automatically repeated reconciliations of failed installations
+func ValidateRetryBackoff(backoff *core.RetryBackoff, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if backoff.Factor != nil && *backoff.Factor < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("factor"), *backoff.Factor, "must be at least 1"))
+	}
+	if backoff.MaxInterval != nil && backoff.MaxInterval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxInterval"), backoff.MaxInterval.Duration.String(), "must be positive"))
+	}
+	if backoff.JitterPercent != nil && (*backoff.JitterPercent < 0 || *backoff.JitterPercent > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("jitterPercent"), *backoff.JitterPercent, "must be between 0 and 100"))
+	}
+
 	return allErrs
 }
 
@@ -78,6 +129,9 @@ func ValidateInstallationComponentDescriptor(cd *core.ComponentDescriptorDefinit
 	// check that a ComponentDescriptor - if given - is either inline or ref but not both
 	if cd != nil {
 		allErrs = append(allErrs, ValidateExactlyOneOf(fldPath.Child("definition"), *cd, "Inline", "Reference")...)
+		if cd.Reference != nil {
+			allErrs = append(allErrs, ValidateExactlyOneOf(fldPath.Child("ref"), *cd.Reference, "Version", "VersionConstraint")...)
+		}
 	}
 
 	return allErrs
@@ -104,7 +158,7 @@ func ValidateInstallationDataImports(imports []core.DataImport, fldPath *field.P
 	for idx, imp := range imports {
 		impPath := fldPath.Index(idx)
 
-		allErrs = append(allErrs, ValidateExactlyOneOf(impPath, imp, "DataRef", "SecretRef", "ConfigMapRef")...)
+		allErrs = append(allErrs, ValidateExactlyOneOf(impPath, imp, "DataRef", "SecretRef", "ConfigMapRef", "SharedDataRef")...)
 
 		if imp.SecretRef != nil {
 			allErrs = append(allErrs, ValidateSecretReference(*imp.SecretRef, impPath.Child("secretRef"))...)
@@ -114,6 +168,10 @@ func ValidateInstallationDataImports(imports []core.DataImport, fldPath *field.P
 			allErrs = append(allErrs, ValidateConfigMapReference(*imp.ConfigMapRef, impPath.Child("configMapRef"))...)
 		}
 
+		if imp.SharedDataRef != nil {
+			allErrs = append(allErrs, ValidateSharedExportReference(*imp.SharedDataRef, impPath.Child("sharedDataRef"))...)
+		}
+
 		if imp.Name == "" {
 			allErrs = append(allErrs, field.Required(impPath.Child("name"), "name must not be empty"))
 			continue
@@ -136,7 +194,10 @@ func ValidateInstallationTargetImports(imports []core.TargetImport, fldPath *fie
 		if imp.Name == "" {
 			allErrs = append(allErrs, field.Required(fldPathIdx.Child("name"), "name must not be empty"))
 		}
-		allErrs = append(allErrs, ValidateExactlyOneOf(fldPathIdx, imp, "Target", "Targets", "TargetListReference")...)
+		allErrs = append(allErrs, ValidateExactlyOneOf(fldPathIdx, imp, "Target", "Targets", "TargetListReference", "SharedTarget")...)
+		if imp.SharedTarget != nil {
+			allErrs = append(allErrs, ValidateSharedExportReference(*imp.SharedTarget, fldPathIdx.Child("sharedTarget"))...)
+		}
 		if len(imp.Targets) > 0 {
 			for idx2, tg := range imp.Targets {
 				if len(tg) == 0 {
@@ -239,6 +300,24 @@ func ValidateSecretReference(sr core.SecretReference, fldPath *field.Path) field
 	return allErrs
 }
 
+// ValidateSharedExportReference validates that the shared export reference is valid
+func ValidateSharedExportReference(ref core.SharedExportReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ref.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "name must not be empty"))
+	}
+	if ref.Namespace == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("namespace"), "namespace must not be empty"))
+	} else {
+		for _, msg := range validation.IsDNS1123Label(ref.Namespace) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("namespace"), ref.Namespace, msg))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateConfigMapReference validates that the secret reference is valid
 func ValidateConfigMapReference(cmr core.ConfigMapReference, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
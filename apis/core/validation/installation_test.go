@@ -5,9 +5,12 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
@@ -202,6 +205,86 @@ var _ = Describe("Installation", func() {
 				"Field": Equal("componentDescriptor.definition"),
 			}))))
 		})
+
+		It("should accept a ComponentDescriptor reference with a version constraint", func() {
+			cdDef := &core.ComponentDescriptorDefinition{
+				Reference: &core.ComponentDescriptorReference{
+					ComponentName:     "foo",
+					VersionConstraint: "~1.4",
+				},
+			}
+
+			allErrs := validation.ValidateInstallationComponentDescriptor(cdDef, field.NewPath("componentDescriptor"))
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should reject a ComponentDescriptor reference with a version and a version constraint", func() {
+			cdDef := &core.ComponentDescriptorDefinition{
+				Reference: &core.ComponentDescriptorReference{
+					ComponentName:     "foo",
+					Version:           "1.4.0",
+					VersionConstraint: "~1.4",
+				},
+			}
+
+			allErrs := validation.ValidateInstallationComponentDescriptor(cdDef, field.NewPath("componentDescriptor"))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("componentDescriptor.ref"),
+			}))))
+		})
+
+		It("should reject a ComponentDescriptor reference without version and version constraint", func() {
+			cdDef := &core.ComponentDescriptorDefinition{
+				Reference: &core.ComponentDescriptorReference{
+					ComponentName: "foo",
+				},
+			}
+
+			allErrs := validation.ValidateInstallationComponentDescriptor(cdDef, field.NewPath("componentDescriptor"))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("componentDescriptor.ref"),
+			}))))
+		})
+	})
+
+	Context("RetryBackoff", func() {
+		It("should accept a valid backoff", func() {
+			factor := int32(3)
+			jitter := int32(20)
+			backoff := &core.RetryBackoff{
+				Factor:        &factor,
+				MaxInterval:   &core.Duration{Duration: time.Hour},
+				JitterPercent: &jitter,
+			}
+			Expect(validation.ValidateRetryBackoff(backoff, field.NewPath("backoff"))).To(BeEmpty())
+		})
+
+		It("should reject an invalid factor, max interval and jitter", func() {
+			factor := int32(0)
+			jitter := int32(101)
+			backoff := &core.RetryBackoff{
+				Factor:        &factor,
+				MaxInterval:   &core.Duration{Duration: -time.Minute},
+				JitterPercent: &jitter,
+			}
+			allErrs := validation.ValidateRetryBackoff(backoff, field.NewPath("backoff"))
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("backoff.factor"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("backoff.maxInterval"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("backoff.jitterPercent"),
+				})),
+			))
+		})
 	})
 
 	Context("InstallationImports", func() {
@@ -470,5 +553,66 @@ var _ = Describe("Installation", func() {
 				"Field": Equal("imports.data[0]"),
 			}))))
 		})
+
+		It("should pass if shared exports are imported", func() {
+			imp := core.InstallationImports{
+				Data: []core.DataImport{
+					{
+						Name:          "imp",
+						SharedDataRef: &core.SharedExportReference{Namespace: "platform", Name: "my-data"},
+					},
+				},
+				Targets: []core.TargetImport{
+					{
+						Name:         "cluster",
+						SharedTarget: &core.SharedExportReference{Namespace: "platform", Name: "my-cluster"},
+					},
+				},
+			}
+
+			allErrs := validation.ValidateInstallationImports(imp, field.NewPath("imports"))
+			Expect(allErrs).To(BeEmpty())
+		})
+
+		It("should fail if a shared export reference contains empty values", func() {
+			imp := core.InstallationImports{
+				Data: []core.DataImport{
+					{
+						Name:          "imp",
+						SharedDataRef: &core.SharedExportReference{},
+					},
+				},
+			}
+
+			allErrs := validation.ValidateInstallationImports(imp, field.NewPath("imports"))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("imports.data[0].sharedDataRef.name"),
+			}))))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("imports.data[0].sharedDataRef.namespace"),
+			}))))
+		})
+
+		It("should fail if a subinstallation imports shared exports", func() {
+			inst := &core.Installation{}
+			inst.Name = "sub"
+			inst.Namespace = "default"
+			inst.OwnerReferences = []metav1.OwnerReference{{Kind: "Installation", Name: "root"}}
+			inst.Spec.Blueprint.Reference = &core.RemoteBlueprintReference{ResourceName: "blueprint"}
+			inst.Spec.Imports.Targets = []core.TargetImport{
+				{
+					Name:         "cluster",
+					SharedTarget: &core.SharedExportReference{Namespace: "platform", Name: "my-cluster"},
+				},
+			}
+
+			allErrs := validation.ValidateInstallation(inst)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.imports.targets[0].sharedTarget"),
+			}))))
+		})
 	})
 })
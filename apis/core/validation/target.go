@@ -24,5 +24,26 @@ func ValidateTargetSpec(spec *core.TargetSpec, fldPath *field.Path) field.ErrorL
 		allErrs = append(allErrs, field.Invalid(fldPath, spec, "either config or secretRef may be set, not both"))
 	}
 
+	if spec.ExternalSecretRef != nil {
+		if spec.Configuration != nil || spec.SecretRef != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, spec, "externalSecretRef must not be set together with config or secretRef"))
+		}
+		allErrs = append(allErrs, ValidateExternalSecretReference(spec.ExternalSecretRef, fldPath.Child("externalSecretRef"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateExternalSecretReference validates a reference to a secret in an external secret store.
+func ValidateExternalSecretReference(ref *core.ExternalSecretReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(ref.Resolver) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("resolver"), "must not be empty"))
+	}
+	if len(ref.Path) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("path"), "must not be empty"))
+	}
+
 	return allErrs
 }
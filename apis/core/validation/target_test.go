@@ -67,5 +67,59 @@ var _ = Describe("Target", func() {
 			Expect(allErrs).To(BeEmpty())
 		})
 
+		It("should accept a Target with an externalSecretRef", func() {
+			t := &core.Target{
+				Spec: core.TargetSpec{
+					ExternalSecretRef: &core.ExternalSecretReference{
+						Resolver: "vault",
+						Path:     "landscaper/my-target",
+					},
+				},
+			}
+
+			allErrs := validation.ValidateTarget(t)
+			Expect(allErrs).To(BeEmpty())
+		})
+
+		It("should reject a Target with externalSecretRef and secretRef set", func() {
+			t := &core.Target{
+				Spec: core.TargetSpec{
+					SecretRef: &core.LocalSecretReference{
+						Name: "foo",
+					},
+					ExternalSecretRef: &core.ExternalSecretReference{
+						Resolver: "vault",
+						Path:     "landscaper/my-target",
+					},
+				},
+			}
+
+			allErrs := validation.ValidateTarget(t)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec"),
+			}))))
+		})
+
+		It("should reject a Target with an externalSecretRef without resolver and path", func() {
+			t := &core.Target{
+				Spec: core.TargetSpec{
+					ExternalSecretRef: &core.ExternalSecretReference{},
+				},
+			}
+
+			allErrs := validation.ValidateTarget(t)
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.externalSecretRef.resolver"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.externalSecretRef.path"),
+				})),
+			))
+		})
+
 	})
 })
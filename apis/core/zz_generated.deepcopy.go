@@ -14,10 +14,29 @@ import (
 	json "encoding/json"
 
 	v2 "github.com/gardener/component-spec/bindings-go/apis/v2"
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AffectedInstallation) DeepCopyInto(out *AffectedInstallation) {
+	*out = *in
+	in.Original.DeepCopyInto(&out.Original)
+	in.Substitution.DeepCopyInto(&out.Substitution)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AffectedInstallation.
+func (in *AffectedInstallation) DeepCopy() *AffectedInstallation {
+	if in == nil {
+		return nil
+	}
+	out := new(AffectedInstallation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AnyJSON) DeepCopyInto(out *AnyJSON) {
 	*out = *in
@@ -52,6 +71,11 @@ func (in *AutomaticReconcile) DeepCopyInto(out *AutomaticReconcile) {
 		*out = new(FailedReconcile)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ComponentVersionUpgrade != nil {
+		in, out := &in.ComponentVersionUpgrade, &out.ComponentVersionUpgrade
+		*out = new(ComponentVersionUpgrade)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -121,6 +145,11 @@ func (in *Blueprint) DeepCopyInto(out *Blueprint) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ImportValidations != nil {
+		in, out := &in.ImportValidations, &out.ImportValidations
+		*out = make([]ValidationRule, len(*in))
+		copy(*out, *in)
+	}
 	if in.Subinstallations != nil {
 		in, out := &in.Subinstallations, &out.Subinstallations
 		*out = make(SubinstallationTemplateList, len(*in))
@@ -297,11 +326,61 @@ func (in *ComponentDescriptorReference) DeepCopy() *ComponentDescriptorReference
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentOverwritesEvaluation) DeepCopyInto(out *ComponentOverwritesEvaluation) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.NextTransitionTime != nil {
+		in, out := &in.NextTransitionTime, &out.NextTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentOverwritesEvaluation.
+func (in *ComponentOverwritesEvaluation) DeepCopy() *ComponentOverwritesEvaluation {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentOverwritesEvaluation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentVerification) DeepCopyInto(out *ComponentVerification) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentVerification.
+func (in *ComponentVerification) DeepCopy() *ComponentVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentVersionOverwrite) DeepCopyInto(out *ComponentVersionOverwrite) {
 	*out = *in
 	in.Source.DeepCopyInto(&out.Source)
 	in.Substitution.DeepCopyInto(&out.Substitution)
+	if in.InstallationSelector != nil {
+		in, out := &in.InstallationSelector, &out.InstallationSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ValidFrom != nil {
+		in, out := &in.ValidFrom, &out.ValidFrom
+		*out = (*in).DeepCopy()
+	}
+	if in.ValidUntil != nil {
+		in, out := &in.ValidUntil, &out.ValidUntil
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -369,6 +448,7 @@ func (in *ComponentVersionOverwrites) DeepCopyInto(out *ComponentVersionOverwrit
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -423,6 +503,54 @@ func (in *ComponentVersionOverwritesList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentVersionOverwritesStatus) DeepCopyInto(out *ComponentVersionOverwritesStatus) {
+	*out = *in
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.AffectedInstallations != nil {
+		in, out := &in.AffectedInstallations, &out.AffectedInstallations
+		*out = make([]AffectedInstallation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentVersionOverwritesStatus.
+func (in *ComponentVersionOverwritesStatus) DeepCopy() *ComponentVersionOverwritesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentVersionOverwritesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentVersionUpgrade) DeepCopyInto(out *ComponentVersionUpgrade) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentVersionUpgrade.
+func (in *ComponentVersionUpgrade) DeepCopy() *ComponentVersionUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentVersionUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
@@ -474,7 +602,7 @@ func (in *Context) DeepCopyInto(out *Context) {
 	}
 	if in.RegistryPullSecrets != nil {
 		in, out := &in.RegistryPullSecrets, &out.RegistryPullSecrets
-		*out = make([]v1.LocalObjectReference, len(*in))
+		*out = make([]corev1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
 	if in.Configurations != nil {
@@ -484,6 +612,11 @@ func (in *Context) DeepCopyInto(out *Context) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ComponentVerification)
+		**out = **in
+	}
 	return
 }
 
@@ -567,6 +700,11 @@ func (in *DataImport) DeepCopyInto(out *DataImport) {
 		*out = new(ConfigMapReference)
 		**out = **in
 	}
+	if in.SharedDataRef != nil {
+		in, out := &in.SharedDataRef, &out.SharedDataRef
+		*out = new(SharedExportReference)
+		**out = **in
+	}
 	return
 }
 
@@ -586,6 +724,11 @@ func (in *DataObject) DeepCopyInto(out *DataObject) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Data.DeepCopyInto(&out.Data)
+	if in.BlobRef != nil {
+		in, out := &in.BlobRef, &out.BlobRef
+		*out = new(DataObjectBlobReference)
+		**out = **in
+	}
 	return
 }
 
@@ -607,6 +750,22 @@ func (in *DataObject) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataObjectBlobReference) DeepCopyInto(out *DataObjectBlobReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataObjectBlobReference.
+func (in *DataObjectBlobReference) DeepCopy() *DataObjectBlobReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DataObjectBlobReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataObjectList) DeepCopyInto(out *DataObjectList) {
 	*out = *in
@@ -1179,6 +1338,23 @@ func (in *Execution) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecutionApproval) DeepCopyInto(out *ExecutionApproval) {
+	*out = *in
+	in.ApprovalTime.DeepCopyInto(&out.ApprovalTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionApproval.
+func (in *ExecutionApproval) DeepCopy() *ExecutionApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecutionApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecutionGeneration) DeepCopyInto(out *ExecutionGeneration) {
 	*out = *in
@@ -1286,6 +1462,11 @@ func (in *ExecutionStatus) DeepCopyInto(out *ExecutionStatus) {
 		*out = make([]ExecutionGeneration, len(*in))
 		copy(*out, *in)
 	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(ExecutionApproval)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1338,6 +1519,136 @@ func (in ExportDefinitionList) DeepCopy() ExportDefinitionList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportGrant) DeepCopyInto(out *ExportGrant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportGrant.
+func (in *ExportGrant) DeepCopy() *ExportGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExportGrant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportGrantConsumer) DeepCopyInto(out *ExportGrantConsumer) {
+	*out = *in
+	if in.Installations != nil {
+		in, out := &in.Installations, &out.Installations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportGrantConsumer.
+func (in *ExportGrantConsumer) DeepCopy() *ExportGrantConsumer {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportGrantConsumer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportGrantList) DeepCopyInto(out *ExportGrantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ExportGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportGrantList.
+func (in *ExportGrantList) DeepCopy() *ExportGrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportGrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExportGrantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportGrantSpec) DeepCopyInto(out *ExportGrantSpec) {
+	*out = *in
+	if in.DataRefs != nil {
+		in, out := &in.DataRefs, &out.DataRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Consumers != nil {
+		in, out := &in.Consumers, &out.Consumers
+		*out = make([]ExportGrantConsumer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportGrantSpec.
+func (in *ExportGrantSpec) DeepCopy() *ExportGrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportGrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalSecretReference) DeepCopyInto(out *ExternalSecretReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSecretReference.
+func (in *ExternalSecretReference) DeepCopy() *ExternalSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FailedReconcile) DeepCopyInto(out *FailedReconcile) {
 	*out = *in
@@ -1351,6 +1662,16 @@ func (in *FailedReconcile) DeepCopyInto(out *FailedReconcile) {
 		*out = new(Duration)
 		**out = **in
 	}
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(RetryBackoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ErrorCodes != nil {
+		in, out := &in.ErrorCodes, &out.ErrorCodes
+		*out = new(RetryErrorCodes)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1402,6 +1723,11 @@ func (in *ImportDefinition) DeepCopyInto(out *ImportDefinition) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Validations != nil {
+		in, out := &in.Validations, &out.Validations
+		*out = make([]ValidationRule, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1599,6 +1925,92 @@ func (in *InstallationList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationPlan) DeepCopyInto(out *InstallationPlan) {
+	*out = *in
+	in.PlanTime.DeepCopyInto(&out.PlanTime)
+	if in.Subinstallations != nil {
+		in, out := &in.Subinstallations, &out.Subinstallations
+		*out = make([]PlannedObject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DeployItems != nil {
+		in, out := &in.DeployItems, &out.DeployItems
+		*out = make([]PlannedObject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DetailsSecretRef != nil {
+		in, out := &in.DetailsSecretRef, &out.DetailsSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		*out = new(Error)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationPlan.
+func (in *InstallationPlan) DeepCopy() *InstallationPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationRevision) DeepCopyInto(out *InstallationRevision) {
+	*out = *in
+	in.ReconcileTime.DeepCopyInto(&out.ReconcileTime)
+	if in.RollbackOf != nil {
+		in, out := &in.RollbackOf, &out.RollbackOf
+		*out = new(int64)
+		**out = **in
+	}
+	in.Blueprint.DeepCopyInto(&out.Blueprint)
+	if in.ComponentDescriptor != nil {
+		in, out := &in.ComponentDescriptor, &out.ComponentDescriptor
+		*out = new(ComponentDescriptorDefinition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Imports != nil {
+		in, out := &in.Imports, &out.Imports
+		*out = make([]ImportStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ImportValuesSecretRef != nil {
+		in, out := &in.ImportValuesSecretRef, &out.ImportValuesSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.DeployItemsSecretRef != nil {
+		in, out := &in.DeployItemsSecretRef, &out.DeployItemsSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationRevision.
+func (in *InstallationRevision) DeepCopy() *InstallationRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 	*out = *in
@@ -1634,6 +2046,11 @@ func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 		*out = new(AutomaticReconcile)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -1684,6 +2101,33 @@ func (in *InstallationStatus) DeepCopyInto(out *InstallationStatus) {
 		*out = new(AutomaticReconcileStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(InstallationPlan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]InstallationRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RollbackRevision != nil {
+		in, out := &in.RollbackRevision, &out.RollbackRevision
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ResolvedComponentVersion != nil {
+		in, out := &in.ResolvedComponentVersion, &out.ResolvedComponentVersion
+		*out = new(ResolvedComponentVersion)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ComponentOverwritesEvaluation != nil {
+		in, out := &in.ComponentOverwritesEvaluation, &out.ComponentOverwritesEvaluation
+		*out = new(ComponentOverwritesEvaluation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1912,6 +2356,177 @@ func (in *ObjectReference) DeepCopy() *ObjectReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanReport) DeepCopyInto(out *OrphanReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanReport.
+func (in *OrphanReport) DeepCopy() *OrphanReport {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrphanReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanReportList) DeepCopyInto(out *OrphanReportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrphanReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanReportList.
+func (in *OrphanReportList) DeepCopy() *OrphanReportList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanReportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrphanReportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanReportStatus) DeepCopyInto(out *OrphanReportStatus) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.DataObjects != nil {
+		in, out := &in.DataObjects, &out.DataObjects
+		*out = make([]OrphanedObject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]OrphanedObject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanReportStatus.
+func (in *OrphanReportStatus) DeepCopy() *OrphanReportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanReportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrphanedObject) DeepCopyInto(out *OrphanedObject) {
+	*out = *in
+	in.OrphanedSince.DeepCopyInto(&out.OrphanedSince)
+	if in.DeletionTime != nil {
+		in, out := &in.DeletionTime, &out.DeletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedObject.
+func (in *OrphanedObject) DeepCopy() *OrphanedObject {
+	if in == nil {
+		return nil
+	}
+	out := new(OrphanedObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlannedChange) DeepCopyInto(out *PlannedChange) {
+	*out = *in
+	if in.Old != nil {
+		in, out := &in.Old, &out.Old
+		*out = new(AnyJSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.New != nil {
+		in, out := &in.New, &out.New
+		*out = new(AnyJSON)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlannedChange.
+func (in *PlannedChange) DeepCopy() *PlannedChange {
+	if in == nil {
+		return nil
+	}
+	out := new(PlannedChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlannedObject) DeepCopyInto(out *PlannedObject) {
+	*out = *in
+	if in.Reference != nil {
+		in, out := &in.Reference, &out.Reference
+		*out = new(ObjectReference)
+		**out = **in
+	}
+	if in.Rendered != nil {
+		in, out := &in.Rendered, &out.Rendered
+		*out = new(AnyJSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]PlannedChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlannedObject.
+func (in *PlannedObject) DeepCopy() *PlannedObject {
+	if in == nil {
+		return nil
+	}
+	out := new(PlannedObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteBlueprintReference) DeepCopyInto(out *RemoteBlueprintReference) {
 	*out = *in
@@ -1949,6 +2564,23 @@ func (in *Requirement) DeepCopy() *Requirement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolvedComponentVersion) DeepCopyInto(out *ResolvedComponentVersion) {
+	*out = *in
+	in.LastCheckTime.DeepCopyInto(&out.LastCheckTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedComponentVersion.
+func (in *ResolvedComponentVersion) DeepCopy() *ResolvedComponentVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolvedComponentVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResolvedTarget) DeepCopyInto(out *ResolvedTarget) {
 	*out = *in
@@ -1986,6 +2618,68 @@ func (in *ResourceReference) DeepCopy() *ResourceReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryBackoff) DeepCopyInto(out *RetryBackoff) {
+	*out = *in
+	if in.Factor != nil {
+		in, out := &in.Factor, &out.Factor
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxInterval != nil {
+		in, out := &in.MaxInterval, &out.MaxInterval
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.JitterPercent != nil {
+		in, out := &in.JitterPercent, &out.JitterPercent
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryBackoff.
+func (in *RetryBackoff) DeepCopy() *RetryBackoff {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryBackoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryErrorCodes) DeepCopyInto(out *RetryErrorCodes) {
+	*out = *in
+	if in.NoRetry != nil {
+		in, out := &in.NoRetry, &out.NoRetry
+		*out = make([]ErrorCode, len(*in))
+		copy(*out, *in)
+	}
+	if in.FastRetry != nil {
+		in, out := &in.FastRetry, &out.FastRetry
+		*out = make([]ErrorCode, len(*in))
+		copy(*out, *in)
+	}
+	if in.FastRetryInterval != nil {
+		in, out := &in.FastRetryInterval, &out.FastRetryInterval
+		*out = new(Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryErrorCodes.
+func (in *RetryErrorCodes) DeepCopy() *RetryErrorCodes {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryErrorCodes)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretLabelSelectorRef) DeepCopyInto(out *SecretLabelSelectorRef) {
 	*out = *in
@@ -2026,6 +2720,22 @@ func (in *SecretReference) DeepCopy() *SecretReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedExportReference) DeepCopyInto(out *SharedExportReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedExportReference.
+func (in *SharedExportReference) DeepCopy() *SharedExportReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedExportReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StaticDataSource) DeepCopyInto(out *StaticDataSource) {
 	*out = *in
@@ -2053,7 +2763,7 @@ func (in *StaticDataValueFrom) DeepCopyInto(out *StaticDataValueFrom) {
 	*out = *in
 	if in.SecretKeyRef != nil {
 		in, out := &in.SecretKeyRef, &out.SecretKeyRef
-		*out = new(v1.SecretKeySelector)
+		*out = new(corev1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.SecretLabelSelector != nil {
@@ -2215,6 +2925,11 @@ func (in *TargetImport) DeepCopyInto(out *TargetImport) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SharedTarget != nil {
+		in, out := &in.SharedTarget, &out.SharedTarget
+		*out = new(SharedExportReference)
+		**out = **in
+	}
 	return
 }
 
@@ -2330,6 +3045,11 @@ func (in *TargetSpec) DeepCopyInto(out *TargetSpec) {
 		*out = new(LocalSecretReference)
 		**out = **in
 	}
+	if in.ExternalSecretRef != nil {
+		in, out := &in.ExternalSecretRef, &out.ExternalSecretRef
+		*out = new(ExternalSecretReference)
+		**out = **in
+	}
 	return
 }
 
@@ -2536,6 +3256,22 @@ func (in *TypedObjectReference) DeepCopy() *TypedObjectReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidationRule) DeepCopyInto(out *ValidationRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidationRule.
+func (in *ValidationRule) DeepCopy() *ValidationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VersionedNamedObjectReference) DeepCopyInto(out *VersionedNamedObjectReference) {
 	*out = *in
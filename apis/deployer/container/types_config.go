@@ -30,6 +30,9 @@ type Configuration struct {
 
 	// TargetSelector describes all selectors the deployer should depend on.
 	TargetSelector []lsv1alpha1.TargetSelector `json:"targetSelector,omitempty"`
+	// TargetResolvers configures the resolvers for targets with an external secret reference.
+	// +optional
+	TargetResolvers []config.TargetResolverConfiguration `json:"targetResolvers,omitempty"`
 
 	// Namespace defines the namespace where the pods should be executed.
 	Namespace string `json:"namespace"`
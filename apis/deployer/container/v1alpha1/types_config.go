@@ -35,6 +35,9 @@ type Configuration struct {
 
 	// TargetSelector describes all selectors the deployer should depend on.
 	TargetSelector []lsv1alpha1.TargetSelector `json:"targetSelector,omitempty"`
+	// TargetResolvers configures the resolvers for targets with an external secret reference.
+	// +optional
+	TargetResolvers []config.TargetResolverConfiguration `json:"targetResolvers,omitempty"`
 
 	// DefaultImage configures the default images that is used if the DeployItem
 	// does not specify one.
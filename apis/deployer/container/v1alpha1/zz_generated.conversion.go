@@ -130,6 +130,7 @@ func autoConvert_v1alpha1_Configuration_To_container_Configuration(in *Configura
 	out.OCI = (*config.OCIConfiguration)(unsafe.Pointer(in.OCI))
 	out.Namespace = in.Namespace
 	out.TargetSelector = *(*[]corev1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.TargetResolvers = *(*[]config.TargetResolverConfiguration)(unsafe.Pointer(&in.TargetResolvers))
 	if err := Convert_v1alpha1_ContainerSpec_To_container_ContainerSpec(&in.DefaultImage, &out.DefaultImage, s); err != nil {
 		return err
 	}
@@ -158,6 +159,7 @@ func autoConvert_container_Configuration_To_v1alpha1_Configuration(in *container
 	out.Identity = in.Identity
 	out.OCI = (*config.OCIConfiguration)(unsafe.Pointer(in.OCI))
 	out.TargetSelector = *(*[]corev1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.TargetResolvers = *(*[]config.TargetResolverConfiguration)(unsafe.Pointer(&in.TargetResolvers))
 	out.Namespace = in.Namespace
 	if err := Convert_container_ContainerSpec_To_v1alpha1_ContainerSpec(&in.DefaultImage, &out.DefaultImage, s); err != nil {
 		return err
@@ -25,6 +25,9 @@ type Configuration struct {
 	OCI *config.OCIConfiguration `json:"oci,omitempty"`
 	// TargetSelector describes all selectors the deployer should depend on.
 	TargetSelector []lsv1alpha1.TargetSelector `json:"targetSelector,omitempty"`
+	// TargetResolvers configures the resolvers for targets with an external secret reference.
+	// +optional
+	TargetResolvers []config.TargetResolverConfiguration `json:"targetResolvers,omitempty"`
 	// Export defines the export configuration.
 	Export ExportConfiguration `json:"export,omitempty"`
 	// Controller contains configuration concerning the controller framework.
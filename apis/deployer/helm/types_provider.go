@@ -13,6 +13,7 @@ import (
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	cr "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
 
 	lscore "github.com/gardener/landscaper/apis/core"
 	health "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks"
@@ -74,6 +75,11 @@ type ProviderConfiguration struct {
 	// +optional
 	ContinuousReconcile *cr.ContinuousReconcileSpec `json:"continuousReconcile,omitempty"`
 
+	// DriftDetection configures the detection and correction of changes of the deployed resources
+	// that were made directly in the target cluster.
+	// +optional
+	DriftDetection *dd.DriftDetectionSpec `json:"driftDetection,omitempty"`
+
 	// HelmDeployment indicates that helm is used as complete deployment mechanism and not only helm templating.
 	// Default is true.
 	// +optional
@@ -82,6 +88,11 @@ type ProviderConfiguration struct {
 	// HelmDeploymentConfig contains settings for helm operations. Only relevant if HelmDeployment is true.
 	// +optional
 	HelmDeploymentConfig *HelmDeploymentConfiguration `json:"helmDeploymentConfig,omitempty"`
+
+	// PostRenderer defines modifications of the rendered manifests of the chart,
+	// that are applied before the manifests are deployed.
+	// +optional
+	PostRenderer *PostRendererConfiguration `json:"postRenderer,omitempty"`
 }
 
 // UpdateStrategy defines the strategy that is used to apply resources to the cluster.
@@ -95,6 +106,8 @@ const (
 // Chart defines the helm chart to render and apply.
 type Chart struct {
 	// Ref defines the reference to a helm chart in a oci repository.
+	// The reference may have the prefix "oci://" and may be pinned to the digest of the oci manifest,
+	// e.g. "oci://registry.example.com/charts/nginx:1.0.0@sha256:...".
 	// +optional
 	Ref string `json:"ref,omitempty"`
 	// FromResource fetches the chart based on the resource's access method.
@@ -110,11 +123,17 @@ type Chart struct {
 	HelmChartRepo *HelmChartRepo `json:"helmChartRepo,omitempty"`
 }
 
-// HelmChartRepo defines a reference to a chart in a helm chart repo
+// HelmChartRepo defines a reference to a chart in a helm chart repo.
+// Charts in oci registries are referenced by a HelmChartRepoUrl with the scheme "oci://",
+// the chart is then fetched from the oci repository "<HelmChartRepoUrl>/<HelmChartName>:<HelmChartVersion>".
 type HelmChartRepo struct {
 	HelmChartRepoUrl string `json:"helmChartRepoUrl,omitempty"`
 	HelmChartName    string `json:"helmChartName,omitempty"`
 	HelmChartVersion string `json:"helmChartVersion,omitempty"`
+	// HelmChartDigest pins the chart to a digest, e.g. "sha256:...".
+	// For oci registries, it is the digest of the oci manifest, otherwise the digest of the chart archive.
+	// +optional
+	HelmChartDigest string `json:"helmChartDigest,omitempty"`
 }
 
 // RemoteChartReference defines a reference to a remote Helm chart through a Component-Descriptor
@@ -146,6 +165,7 @@ type HelmDeploymentConfiguration struct {
 	Install   map[string]lscore.AnyJSON `json:"install,omitempty"`
 	Upgrade   map[string]lscore.AnyJSON `json:"upgrade,omitempty"`
 	Uninstall map[string]lscore.AnyJSON `json:"uninstall,omitempty"`
+	Test      map[string]lscore.AnyJSON `json:"test,omitempty"`
 }
 
 // HelmInstallConfiguration defines settings for a helm install operation.
@@ -166,6 +186,87 @@ type HelmUninstallConfiguration struct {
 	Timeout *lsv1alpha1.Duration `json:"timeout,omitempty"`
 }
 
+// HelmTestConfiguration defines settings for a helm test operation.
+type HelmTestConfiguration struct {
+	// Enabled defines whether the tests of the chart are executed after the release has been installed or upgraded
+	// and all resources are ready.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// Timeout is the timeout for the operation in minutes.
+	// +optional
+	Timeout *lsv1alpha1.Duration `json:"timeout,omitempty"`
+}
+
+// PostRendererConfiguration defines kustomize-style modifications of the rendered manifests of a chart.
+type PostRendererConfiguration struct {
+	// Labels are added to the metadata of all resources and to the pod templates of workload resources.
+	// Selectors are not modified, as they are immutable for most resources.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations are added to the metadata of all resources and to the pod templates of workload resources.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Images replaces the name, tag or digest of container images.
+	// +optional
+	Images []ImageOverride `json:"images,omitempty"`
+	// Patches are applied to the rendered resources in the given order.
+	// +optional
+	Patches []Patch `json:"patches,omitempty"`
+}
+
+// ImageOverride replaces the name, tag or digest of all container images with a given name.
+type ImageOverride struct {
+	// Name is the name of the image without tag or digest.
+	Name string `json:"name"`
+	// NewName replaces the name of the image.
+	// +optional
+	NewName string `json:"newName,omitempty"`
+	// NewTag replaces the tag of the image.
+	// +optional
+	NewTag string `json:"newTag,omitempty"`
+	// Digest replaces the tag of the image with a digest.
+	// +optional
+	Digest string `json:"digest,omitempty"`
+}
+
+// Patch defines a strategic merge patch or a JSON patch of rendered resources.
+// Exactly one of StrategicMerge and JSON has to be defined.
+type Patch struct {
+	// Target selects the resources the patch is applied to.
+	// It is required for JSON patches. A strategic merge patch without target is applied
+	// to the resource with the kind, name and namespace of the patch.
+	// +optional
+	Target *PatchTarget `json:"target,omitempty"`
+	// StrategicMerge is a strategic merge patch, i.e. a partial resource that is merged into the selected resources.
+	// +optional
+	StrategicMerge *lscore.AnyJSON `json:"strategicMerge,omitempty"`
+	// JSON is a JSON patch (RFC 6902), i.e. a list of operations that are applied to the selected resources.
+	// +optional
+	JSON *lscore.AnyJSON `json:"json,omitempty"`
+}
+
+// PatchTarget selects resources.
+// Group, version, kind, name and namespace are regular expressions that have to match completely.
+// Empty fields match all resources.
+type PatchTarget struct {
+	// +optional
+	Group string `json:"group,omitempty"`
+	// +optional
+	Version string `json:"version,omitempty"`
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// +optional
+	Name string `json:"name,omitempty"`
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// LabelSelector is a label selector in the string representation of kubectl, e.g. "app=nginx,tier!=frontend".
+	// +optional
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// AnnotationSelector is a selector for the annotations of the resources with the same syntax as the LabelSelector.
+	// +optional
+	AnnotationSelector string `json:"annotationSelector,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // ProviderStatus is the helm provider specific status
@@ -174,6 +275,63 @@ type ProviderStatus struct {
 
 	// ManagedResources contains all kubernetes resources that are deployed by the helm deployer.
 	ManagedResources managedresource.ManagedResourceStatusList `json:"managedResources,omitempty"`
+	// Chart describes the chart of the last deployment.
+	// +optional
+	Chart *ChartStatus `json:"chart,omitempty"`
+	// Drift contains the result of the last drift detection.
+	// +optional
+	Drift *dd.DriftStatus `json:"drift,omitempty"`
+	// Hooks contains the results of the last execution of the hooks of the helm release, including the tests.
+	// Only set if helm is used as deployment mechanism.
+	// +optional
+	Hooks []HookStatus `json:"hooks,omitempty"`
+	// AppliedManifestsSecretRef references the secret that contains the manifests of the last successful deployment.
+	// The drift detection compares the managed resources with these manifests.
+	// Only set if drift detection is configured.
+	// +optional
+	AppliedManifestsSecretRef *lsv1alpha1.SecretReference `json:"appliedManifestsSecretRef,omitempty"`
+	// AppliedManifestsHash is the digest of the manifests of the last successful deployment.
+	// +optional
+	AppliedManifestsHash string `json:"appliedManifestsHash,omitempty"`
+}
+
+// ChartStatus describes a deployed chart and the digest it is pinned to.
+type ChartStatus struct {
+	// Name is the name of the chart.
+	Name string `json:"name"`
+	// Version is the version of the chart.
+	Version string `json:"version"`
+	// Ref is the oci reference the chart has been fetched from.
+	// Only set for charts from oci registries.
+	// +optional
+	Ref string `json:"ref,omitempty"`
+	// Digest is the digest of the chart. For charts from oci registries, it is the digest of the oci manifest,
+	// for charts from helm chart repositories the digest of the chart archive.
+	// +optional
+	Digest string `json:"digest,omitempty"`
+}
+
+// HookStatus describes the result of the last execution of a helm hook.
+type HookStatus struct {
+	// Name is the name of the hook resource.
+	Name string `json:"name"`
+	// Kind is the kind of the hook resource.
+	Kind string `json:"kind"`
+	// Events are the events that trigger the hook, e.g. "pre-install" or "test".
+	// +optional
+	Events []string `json:"events,omitempty"`
+	// Phase is the phase of the last execution of the hook.
+	// +optional
+	Phase string `json:"phase,omitempty"`
+	// StartedAt is the time when the last execution of the hook has been started.
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// CompletedAt is the time when the last execution of the hook has been completed.
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+	// Logs contains the end of the logs of a test pod.
+	// +optional
+	Logs string `json:"logs,omitempty"`
 }
 
 // HelmChartRepoCredentials contains the credentials to access hepl chart repos
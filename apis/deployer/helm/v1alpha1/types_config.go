@@ -21,6 +21,9 @@ const ManagedInstanceLabel = "helm.deployer.landscaper.gardener.cloud/instance"
 // to define its source deploy item.
 const ManagedDeployItemLabel = "helm.deployer.landscaper.gardener.cloud/deployitem"
 
+// FieldManager is the name of the field manager that is used by the helm deployer to create and update resources.
+const FieldManager = "landscaper-helm-deployer"
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Configuration is the helm deployer configuration that configures the controller
@@ -33,6 +36,9 @@ type Configuration struct {
 	OCI *config.OCIConfiguration `json:"oci,omitempty"`
 	// TargetSelector describes all selectors the deployer should depend on.
 	TargetSelector []lsv1alpha1.TargetSelector `json:"targetSelector,omitempty"`
+	// TargetResolvers configures the resolvers for targets with an external secret reference.
+	// +optional
+	TargetResolvers []config.TargetResolverConfiguration `json:"targetResolvers,omitempty"`
 	// Export defines the export configuration.
 	Export ExportConfiguration `json:"export,omitempty"`
 	// Controller contains configuration concerning the controller framework.
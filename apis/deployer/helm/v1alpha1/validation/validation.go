@@ -5,20 +5,31 @@
 package validation
 
 import (
+	"encoding/json"
 	"fmt"
+	"regexp"
 
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
 	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
+	ddval "github.com/gardener/landscaper/apis/deployer/utils/driftdetection/validation"
 	health "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks/validation"
 )
 
+// digestRegexp matches the sha256 digests that a chart can be pinned to.
+var digestRegexp = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
 const (
 	helmArgumentAtomic  = "atomic"
 	helmArgumentTimeout = "timeout"
+	helmArgumentEnabled = "enabled"
 )
 
 // ValidateProviderConfiguration validates a helm deployer configuration
@@ -28,8 +39,12 @@ func ValidateProviderConfiguration(config *helmv1alpha1.ProviderConfiguration) e
 	allErrs = append(allErrs, ValidateTimeout(field.NewPath("readinessChecks", "timeout"), config.ReadinessChecks.Timeout)...)
 	allErrs = append(allErrs, health.ValidateReadinessCheckConfiguration(field.NewPath("readinessChecks"), &config.ReadinessChecks)...)
 	allErrs = append(allErrs, ValidateChart(field.NewPath("chart"), config.Chart)...)
-	allErrs = append(allErrs, ValidateHelmDeploymentConfiguration(field.NewPath("helmDeploymentConfig"), config.HelmDeploymentConfig)...)
+	allErrs = append(allErrs, ValidateHelmDeploymentConfiguration(field.NewPath("helmDeploymentConfig"), config.HelmDeploymentConfig,
+		pointer.BoolDeref(config.HelmDeployment, true))...)
 	allErrs = append(allErrs, crval.ValidateContinuousReconcileSpec(field.NewPath("continuousReconcile"), config.ContinuousReconcile)...)
+	allErrs = append(allErrs, ddval.ValidateDriftDetectionSpec(field.NewPath("driftDetection"), config.DriftDetection)...)
+	allErrs = append(allErrs, ValidateDriftCorrection(field.NewPath("driftDetection", "autoCorrect"), config)...)
+	allErrs = append(allErrs, ValidatePostRendererConfiguration(field.NewPath("postRenderer"), config.PostRenderer)...)
 
 	if len(config.Name) == 0 {
 		allErrs = append(allErrs, field.Required(field.NewPath("name"), "must not be empty"))
@@ -75,6 +90,16 @@ func ValidateProviderConfiguration(config *helmv1alpha1.ProviderConfiguration) e
 	return allErrs.ToAggregate()
 }
 
+// ValidateDriftCorrection validates that drifted resources are only corrected if helm is only used for templating.
+// Releases of real helm deployments are not patched outside of helm.
+func ValidateDriftCorrection(fldPath *field.Path, config *helmv1alpha1.ProviderConfiguration) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if config.DriftDetection != nil && config.DriftDetection.AutoCorrect && pointer.BoolDeref(config.HelmDeployment, true) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "auto correction is only supported if helmDeployment is false"))
+	}
+	return allErrs
+}
+
 // ValidateChart validates the access methods for a chart
 func ValidateChart(fldPath *field.Path, chart helmv1alpha1.Chart) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -95,12 +120,13 @@ func ValidateChart(fldPath *field.Path, chart helmv1alpha1.Chart) field.ErrorLis
 	return allErrs
 }
 
-func ValidateHelmDeploymentConfiguration(fldPath *field.Path, deployConfig *helmv1alpha1.HelmDeploymentConfiguration) field.ErrorList {
+func ValidateHelmDeploymentConfiguration(fldPath *field.Path, deployConfig *helmv1alpha1.HelmDeploymentConfiguration, helmDeployment bool) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if deployConfig != nil {
 		allErrs = append(allErrs, ValidateInstallConfiguration(fldPath.Child("install"), deployConfig.Install)...)
 		allErrs = append(allErrs, ValidateUpgradeConfiguration(fldPath.Child("upgrade"), deployConfig.Upgrade)...)
 		allErrs = append(allErrs, ValidateUninstallConfiguration(fldPath.Child("uninstall"), deployConfig.Uninstall)...)
+		allErrs = append(allErrs, ValidateTestConfiguration(fldPath.Child("test"), deployConfig.Test, helmDeployment)...)
 	}
 	return allErrs
 }
@@ -117,6 +143,25 @@ func ValidateUninstallConfiguration(fldPath *field.Path, conf map[string]lsv1alp
 	return validateHelmArguments(fldPath, conf, []string{helmArgumentTimeout})
 }
 
+// ValidateTestConfiguration validates the settings for helm tests.
+// Tests can only be enabled if helm is used as deployment mechanism.
+func ValidateTestConfiguration(fldPath *field.Path, conf map[string]lsv1alpha1.AnyJSON, helmDeployment bool) field.ErrorList {
+	allErrs := validateHelmArguments(fldPath, conf, []string{helmArgumentEnabled, helmArgumentTimeout})
+
+	rawEnabled, ok := conf[helmArgumentEnabled]
+	if !ok {
+		return allErrs
+	}
+	enabled := false
+	if err := json.Unmarshal(rawEnabled.RawMessage, &enabled); err != nil {
+		return append(allErrs, field.Invalid(fldPath.Child(helmArgumentEnabled), string(rawEnabled.RawMessage), "must be a boolean"))
+	}
+	if enabled && !helmDeployment {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child(helmArgumentEnabled), "tests are only supported if helmDeployment is true"))
+	}
+	return allErrs
+}
+
 func validateHelmArguments(fldPath *field.Path, conf map[string]lsv1alpha1.AnyJSON, validArguments []string) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -137,6 +182,81 @@ func validateHelmArguments(fldPath *field.Path, conf map[string]lsv1alpha1.AnyJS
 	return allErrs
 }
 
+// ValidatePostRendererConfiguration validates the modifications of the rendered manifests.
+func ValidatePostRendererConfiguration(fldPath *field.Path, config *helmv1alpha1.PostRendererConfiguration) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if config == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabels(config.Labels, fldPath.Child("labels"))...)
+	allErrs = append(allErrs, apivalidation.ValidateAnnotations(config.Annotations, fldPath.Child("annotations"))...)
+
+	for i, image := range config.Images {
+		imgPath := fldPath.Child("images").Index(i)
+		if len(image.Name) == 0 {
+			allErrs = append(allErrs, field.Required(imgPath.Child("name"), "must not be empty"))
+		}
+		if len(image.NewName) == 0 && len(image.NewTag) == 0 && len(image.Digest) == 0 {
+			allErrs = append(allErrs, field.Required(imgPath.Child("newName", "newTag", "digest"), "at least one replacement has to be defined"))
+		}
+		if len(image.NewTag) != 0 && len(image.Digest) != 0 {
+			allErrs = append(allErrs, field.Forbidden(imgPath.Child("digest"), "must not be defined together with newTag"))
+		}
+	}
+
+	for i, patch := range config.Patches {
+		allErrs = append(allErrs, ValidatePatch(fldPath.Child("patches").Index(i), patch)...)
+	}
+	return allErrs
+}
+
+// ValidatePatch validates a patch of the rendered manifests.
+func ValidatePatch(fldPath *field.Path, patch helmv1alpha1.Patch) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if patch.Target != nil {
+		targetPath := fldPath.Child("target")
+		if _, err := labels.Parse(patch.Target.LabelSelector); err != nil {
+			allErrs = append(allErrs, field.Invalid(targetPath.Child("labelSelector"), patch.Target.LabelSelector, err.Error()))
+		}
+		if _, err := labels.Parse(patch.Target.AnnotationSelector); err != nil {
+			allErrs = append(allErrs, field.Invalid(targetPath.Child("annotationSelector"), patch.Target.AnnotationSelector, err.Error()))
+		}
+	}
+
+	switch {
+	case patch.StrategicMerge != nil && patch.JSON != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("json"), "must not be defined together with strategicMerge"))
+	case patch.StrategicMerge != nil:
+		smPath := fldPath.Child("strategicMerge")
+		obj := map[string]interface{}{}
+		if err := json.Unmarshal(patch.StrategicMerge.RawMessage, &obj); err != nil {
+			allErrs = append(allErrs, field.Invalid(smPath, string(patch.StrategicMerge.RawMessage), "must be an object"))
+			break
+		}
+		if patch.Target == nil {
+			metadata, _ := obj["metadata"].(map[string]interface{})
+			if obj["kind"] == nil || metadata == nil || metadata["name"] == nil {
+				allErrs = append(allErrs, field.Required(smPath, "kind and metadata.name have to be defined if no target is defined"))
+			}
+		}
+	case patch.JSON != nil:
+		jsonPath := fldPath.Child("json")
+		var operations []map[string]interface{}
+		if err := json.Unmarshal(patch.JSON.RawMessage, &operations); err != nil {
+			allErrs = append(allErrs, field.Invalid(jsonPath, string(patch.JSON.RawMessage), "must be a list of operations"))
+		}
+		if patch.Target == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("target"), "must be defined for json patches"))
+		}
+	default:
+		allErrs = append(allErrs, field.Required(fldPath.Child("strategicMerge", "json"), "must not be empty"))
+	}
+
+	return allErrs
+}
+
 // ValidateArchive validates the archive access for a helm chart.
 func ValidateArchive(fldPath *field.Path, archive *helmv1alpha1.ArchiveAccess) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -201,6 +321,11 @@ func ValidateHelmChartRepo(fldPath *field.Path, helmChartRepo *helmv1alpha1.Helm
 		allErrs = append(allErrs, field.Required(fldPath.Child("helmChartVersion"), "must not be empty"))
 	}
 
+	if len(helmChartRepo.HelmChartDigest) != 0 && !digestRegexp.MatchString(helmChartRepo.HelmChartDigest) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("helmChartDigest"), helmChartRepo.HelmChartDigest,
+			"must be a sha256 digest of the form \"sha256:<hex>\""))
+	}
+
 	return allErrs
 }
 
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/helm/v1alpha1/validation"
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+)
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Helm Validation Test Suite")
+}
+
+var _ = Describe("Validation", func() {
+
+	Context("DriftCorrection", func() {
+		var config *helmv1alpha1.ProviderConfiguration
+
+		BeforeEach(func() {
+			config = &helmv1alpha1.ProviderConfiguration{
+				DriftDetection: &dd.DriftDetectionSpec{
+					Interval:    &lsv1alpha1.Duration{Duration: 10 * time.Minute},
+					AutoCorrect: true,
+				},
+			}
+		})
+
+		It("should accept auto correction if helm is only used for templating", func() {
+			config.HelmDeployment = pointer.Bool(false)
+			allErrs := validation.ValidateDriftCorrection(field.NewPath("driftDetection", "autoCorrect"), config)
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should accept a drift detection without auto correction for helm deployments", func() {
+			config.DriftDetection.AutoCorrect = false
+			allErrs := validation.ValidateDriftCorrection(field.NewPath("driftDetection", "autoCorrect"), config)
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should deny auto correction for helm deployments", func() {
+			allErrs := validation.ValidateDriftCorrection(field.NewPath("driftDetection", "autoCorrect"), config)
+			config.HelmDeployment = pointer.Bool(true)
+			allErrs = append(allErrs, validation.ValidateDriftCorrection(field.NewPath("driftDetection", "autoCorrect"), config)...)
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("driftDetection.autoCorrect"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("driftDetection.autoCorrect"),
+				})),
+			))
+		})
+	})
+
+	Context("TestConfiguration", func() {
+		It("should accept enabled tests for helm deployments", func() {
+			conf := map[string]lsv1alpha1.AnyJSON{
+				"enabled": lsv1alpha1.NewAnyJSON([]byte("true")),
+				"timeout": lsv1alpha1.NewAnyJSON([]byte(`"5m"`)),
+			}
+			allErrs := validation.ValidateTestConfiguration(field.NewPath("test"), conf, true)
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should accept disabled tests if helm is only used for templating", func() {
+			conf := map[string]lsv1alpha1.AnyJSON{
+				"enabled": lsv1alpha1.NewAnyJSON([]byte("false")),
+			}
+			allErrs := validation.ValidateTestConfiguration(field.NewPath("test"), conf, false)
+			allErrs = append(allErrs, validation.ValidateTestConfiguration(field.NewPath("test"), nil, false)...)
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should deny enabled tests if helm is only used for templating", func() {
+			conf := map[string]lsv1alpha1.AnyJSON{
+				"enabled": lsv1alpha1.NewAnyJSON([]byte("true")),
+			}
+			allErrs := validation.ValidateTestConfiguration(field.NewPath("test"), conf, false)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("test.enabled"),
+			}))))
+		})
+
+		It("should deny unknown arguments and invalid values", func() {
+			conf := map[string]lsv1alpha1.AnyJSON{
+				"enabled": lsv1alpha1.NewAnyJSON([]byte(`"yes"`)),
+				"atomic":  lsv1alpha1.NewAnyJSON([]byte("true")),
+			}
+			allErrs := validation.ValidateTestConfiguration(field.NewPath("test"), conf, true)
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("test"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("test.enabled"),
+				})),
+			))
+		})
+
+		It("should validate the test configuration of a provider configuration", func() {
+			config := &helmv1alpha1.ProviderConfiguration{
+				HelmDeployment: pointer.Bool(false),
+				HelmDeploymentConfig: &helmv1alpha1.HelmDeploymentConfiguration{
+					Test: map[string]lsv1alpha1.AnyJSON{
+						"enabled": lsv1alpha1.NewAnyJSON([]byte("true")),
+					},
+				},
+			}
+			err := validation.ValidateProviderConfiguration(config)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("helmDeploymentConfig.test.enabled"))
+		})
+	})
+
+})
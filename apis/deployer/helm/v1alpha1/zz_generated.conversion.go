@@ -14,6 +14,7 @@ import (
 	json "encoding/json"
 	unsafe "unsafe"
 
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
@@ -22,6 +23,7 @@ import (
 	corev1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	helm "github.com/gardener/landscaper/apis/deployer/helm"
 	continuousreconcile "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
+	driftdetection "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
 	managedresource "github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 )
 
@@ -62,6 +64,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ChartStatus)(nil), (*helm.ChartStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ChartStatus_To_helm_ChartStatus(a.(*ChartStatus), b.(*helm.ChartStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.ChartStatus)(nil), (*ChartStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_ChartStatus_To_v1alpha1_ChartStatus(a.(*helm.ChartStatus), b.(*ChartStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Configuration)(nil), (*helm.Configuration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Configuration_To_helm_Configuration(a.(*Configuration), b.(*helm.Configuration), scope)
 	}); err != nil {
@@ -132,6 +144,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HelmTestConfiguration)(nil), (*helm.HelmTestConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HelmTestConfiguration_To_helm_HelmTestConfiguration(a.(*HelmTestConfiguration), b.(*helm.HelmTestConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.HelmTestConfiguration)(nil), (*HelmTestConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_HelmTestConfiguration_To_v1alpha1_HelmTestConfiguration(a.(*helm.HelmTestConfiguration), b.(*HelmTestConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HelmUninstallConfiguration)(nil), (*helm.HelmUninstallConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_HelmUninstallConfiguration_To_helm_HelmUninstallConfiguration(a.(*HelmUninstallConfiguration), b.(*helm.HelmUninstallConfiguration), scope)
 	}); err != nil {
@@ -142,6 +164,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HookStatus)(nil), (*helm.HookStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HookStatus_To_helm_HookStatus(a.(*HookStatus), b.(*helm.HookStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.HookStatus)(nil), (*HookStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_HookStatus_To_v1alpha1_HookStatus(a.(*helm.HookStatus), b.(*HookStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ImageOverride)(nil), (*helm.ImageOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImageOverride_To_helm_ImageOverride(a.(*ImageOverride), b.(*helm.ImageOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.ImageOverride)(nil), (*ImageOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_ImageOverride_To_v1alpha1_ImageOverride(a.(*helm.ImageOverride), b.(*ImageOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Patch)(nil), (*helm.Patch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Patch_To_helm_Patch(a.(*Patch), b.(*helm.Patch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.Patch)(nil), (*Patch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_Patch_To_v1alpha1_Patch(a.(*helm.Patch), b.(*Patch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PatchTarget)(nil), (*helm.PatchTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PatchTarget_To_helm_PatchTarget(a.(*PatchTarget), b.(*helm.PatchTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.PatchTarget)(nil), (*PatchTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_PatchTarget_To_v1alpha1_PatchTarget(a.(*helm.PatchTarget), b.(*PatchTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PostRendererConfiguration)(nil), (*helm.PostRendererConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PostRendererConfiguration_To_helm_PostRendererConfiguration(a.(*PostRendererConfiguration), b.(*helm.PostRendererConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.PostRendererConfiguration)(nil), (*PostRendererConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_PostRendererConfiguration_To_v1alpha1_PostRendererConfiguration(a.(*helm.PostRendererConfiguration), b.(*PostRendererConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProviderConfiguration)(nil), (*helm.ProviderConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(a.(*ProviderConfiguration), b.(*helm.ProviderConfiguration), scope)
 	}); err != nil {
@@ -259,10 +331,37 @@ func Convert_helm_Chart_To_v1alpha1_Chart(in *helm.Chart, out *Chart, s conversi
 	return autoConvert_helm_Chart_To_v1alpha1_Chart(in, out, s)
 }
 
+func autoConvert_v1alpha1_ChartStatus_To_helm_ChartStatus(in *ChartStatus, out *helm.ChartStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.Ref = in.Ref
+	out.Digest = in.Digest
+	return nil
+}
+
+// Convert_v1alpha1_ChartStatus_To_helm_ChartStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ChartStatus_To_helm_ChartStatus(in *ChartStatus, out *helm.ChartStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ChartStatus_To_helm_ChartStatus(in, out, s)
+}
+
+func autoConvert_helm_ChartStatus_To_v1alpha1_ChartStatus(in *helm.ChartStatus, out *ChartStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.Ref = in.Ref
+	out.Digest = in.Digest
+	return nil
+}
+
+// Convert_helm_ChartStatus_To_v1alpha1_ChartStatus is an autogenerated conversion function.
+func Convert_helm_ChartStatus_To_v1alpha1_ChartStatus(in *helm.ChartStatus, out *ChartStatus, s conversion.Scope) error {
+	return autoConvert_helm_ChartStatus_To_v1alpha1_ChartStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_Configuration_To_helm_Configuration(in *Configuration, out *helm.Configuration, s conversion.Scope) error {
 	out.Identity = in.Identity
 	out.OCI = (*config.OCIConfiguration)(unsafe.Pointer(in.OCI))
 	out.TargetSelector = *(*[]corev1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.TargetResolvers = *(*[]config.TargetResolverConfiguration)(unsafe.Pointer(&in.TargetResolvers))
 	if err := Convert_v1alpha1_ExportConfiguration_To_helm_ExportConfiguration(&in.Export, &out.Export, s); err != nil {
 		return err
 	}
@@ -281,6 +380,7 @@ func autoConvert_helm_Configuration_To_v1alpha1_Configuration(in *helm.Configura
 	out.Identity = in.Identity
 	out.OCI = (*config.OCIConfiguration)(unsafe.Pointer(in.OCI))
 	out.TargetSelector = *(*[]corev1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.TargetResolvers = *(*[]config.TargetResolverConfiguration)(unsafe.Pointer(&in.TargetResolvers))
 	if err := Convert_helm_ExportConfiguration_To_v1alpha1_ExportConfiguration(&in.Export, &out.Export, s); err != nil {
 		return err
 	}
@@ -339,6 +439,7 @@ func autoConvert_v1alpha1_HelmChartRepo_To_helm_HelmChartRepo(in *HelmChartRepo,
 	out.HelmChartRepoUrl = in.HelmChartRepoUrl
 	out.HelmChartName = in.HelmChartName
 	out.HelmChartVersion = in.HelmChartVersion
+	out.HelmChartDigest = in.HelmChartDigest
 	return nil
 }
 
@@ -351,6 +452,7 @@ func autoConvert_helm_HelmChartRepo_To_v1alpha1_HelmChartRepo(in *helm.HelmChart
 	out.HelmChartRepoUrl = in.HelmChartRepoUrl
 	out.HelmChartName = in.HelmChartName
 	out.HelmChartVersion = in.HelmChartVersion
+	out.HelmChartDigest = in.HelmChartDigest
 	return nil
 }
 
@@ -383,6 +485,7 @@ func autoConvert_v1alpha1_HelmDeploymentConfiguration_To_helm_HelmDeploymentConf
 	out.Install = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Install))
 	out.Upgrade = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Upgrade))
 	out.Uninstall = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Uninstall))
+	out.Test = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Test))
 	return nil
 }
 
@@ -395,6 +498,7 @@ func autoConvert_helm_HelmDeploymentConfiguration_To_v1alpha1_HelmDeploymentConf
 	out.Install = *(*map[string]corev1alpha1.AnyJSON)(unsafe.Pointer(&in.Install))
 	out.Upgrade = *(*map[string]corev1alpha1.AnyJSON)(unsafe.Pointer(&in.Upgrade))
 	out.Uninstall = *(*map[string]corev1alpha1.AnyJSON)(unsafe.Pointer(&in.Uninstall))
+	out.Test = *(*map[string]corev1alpha1.AnyJSON)(unsafe.Pointer(&in.Test))
 	return nil
 }
 
@@ -425,6 +529,28 @@ func Convert_helm_HelmInstallConfiguration_To_v1alpha1_HelmInstallConfiguration(
 	return autoConvert_helm_HelmInstallConfiguration_To_v1alpha1_HelmInstallConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_HelmTestConfiguration_To_helm_HelmTestConfiguration(in *HelmTestConfiguration, out *helm.HelmTestConfiguration, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Timeout = (*corev1alpha1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_HelmTestConfiguration_To_helm_HelmTestConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_HelmTestConfiguration_To_helm_HelmTestConfiguration(in *HelmTestConfiguration, out *helm.HelmTestConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HelmTestConfiguration_To_helm_HelmTestConfiguration(in, out, s)
+}
+
+func autoConvert_helm_HelmTestConfiguration_To_v1alpha1_HelmTestConfiguration(in *helm.HelmTestConfiguration, out *HelmTestConfiguration, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Timeout = (*corev1alpha1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_helm_HelmTestConfiguration_To_v1alpha1_HelmTestConfiguration is an autogenerated conversion function.
+func Convert_helm_HelmTestConfiguration_To_v1alpha1_HelmTestConfiguration(in *helm.HelmTestConfiguration, out *HelmTestConfiguration, s conversion.Scope) error {
+	return autoConvert_helm_HelmTestConfiguration_To_v1alpha1_HelmTestConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_HelmUninstallConfiguration_To_helm_HelmUninstallConfiguration(in *HelmUninstallConfiguration, out *helm.HelmUninstallConfiguration, s conversion.Scope) error {
 	out.Timeout = (*corev1alpha1.Duration)(unsafe.Pointer(in.Timeout))
 	return nil
@@ -445,6 +571,146 @@ func Convert_helm_HelmUninstallConfiguration_To_v1alpha1_HelmUninstallConfigurat
 	return autoConvert_helm_HelmUninstallConfiguration_To_v1alpha1_HelmUninstallConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_HookStatus_To_helm_HookStatus(in *HookStatus, out *helm.HookStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = in.Kind
+	out.Events = *(*[]string)(unsafe.Pointer(&in.Events))
+	out.Phase = in.Phase
+	out.StartedAt = (*v1.Time)(unsafe.Pointer(in.StartedAt))
+	out.CompletedAt = (*v1.Time)(unsafe.Pointer(in.CompletedAt))
+	out.Logs = in.Logs
+	return nil
+}
+
+// Convert_v1alpha1_HookStatus_To_helm_HookStatus is an autogenerated conversion function.
+func Convert_v1alpha1_HookStatus_To_helm_HookStatus(in *HookStatus, out *helm.HookStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HookStatus_To_helm_HookStatus(in, out, s)
+}
+
+func autoConvert_helm_HookStatus_To_v1alpha1_HookStatus(in *helm.HookStatus, out *HookStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = in.Kind
+	out.Events = *(*[]string)(unsafe.Pointer(&in.Events))
+	out.Phase = in.Phase
+	out.StartedAt = (*v1.Time)(unsafe.Pointer(in.StartedAt))
+	out.CompletedAt = (*v1.Time)(unsafe.Pointer(in.CompletedAt))
+	out.Logs = in.Logs
+	return nil
+}
+
+// Convert_helm_HookStatus_To_v1alpha1_HookStatus is an autogenerated conversion function.
+func Convert_helm_HookStatus_To_v1alpha1_HookStatus(in *helm.HookStatus, out *HookStatus, s conversion.Scope) error {
+	return autoConvert_helm_HookStatus_To_v1alpha1_HookStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ImageOverride_To_helm_ImageOverride(in *ImageOverride, out *helm.ImageOverride, s conversion.Scope) error {
+	out.Name = in.Name
+	out.NewName = in.NewName
+	out.NewTag = in.NewTag
+	out.Digest = in.Digest
+	return nil
+}
+
+// Convert_v1alpha1_ImageOverride_To_helm_ImageOverride is an autogenerated conversion function.
+func Convert_v1alpha1_ImageOverride_To_helm_ImageOverride(in *ImageOverride, out *helm.ImageOverride, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImageOverride_To_helm_ImageOverride(in, out, s)
+}
+
+func autoConvert_helm_ImageOverride_To_v1alpha1_ImageOverride(in *helm.ImageOverride, out *ImageOverride, s conversion.Scope) error {
+	out.Name = in.Name
+	out.NewName = in.NewName
+	out.NewTag = in.NewTag
+	out.Digest = in.Digest
+	return nil
+}
+
+// Convert_helm_ImageOverride_To_v1alpha1_ImageOverride is an autogenerated conversion function.
+func Convert_helm_ImageOverride_To_v1alpha1_ImageOverride(in *helm.ImageOverride, out *ImageOverride, s conversion.Scope) error {
+	return autoConvert_helm_ImageOverride_To_v1alpha1_ImageOverride(in, out, s)
+}
+
+func autoConvert_v1alpha1_Patch_To_helm_Patch(in *Patch, out *helm.Patch, s conversion.Scope) error {
+	out.Target = (*helm.PatchTarget)(unsafe.Pointer(in.Target))
+	out.StrategicMerge = (*core.AnyJSON)(unsafe.Pointer(in.StrategicMerge))
+	out.JSON = (*core.AnyJSON)(unsafe.Pointer(in.JSON))
+	return nil
+}
+
+// Convert_v1alpha1_Patch_To_helm_Patch is an autogenerated conversion function.
+func Convert_v1alpha1_Patch_To_helm_Patch(in *Patch, out *helm.Patch, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Patch_To_helm_Patch(in, out, s)
+}
+
+func autoConvert_helm_Patch_To_v1alpha1_Patch(in *helm.Patch, out *Patch, s conversion.Scope) error {
+	out.Target = (*PatchTarget)(unsafe.Pointer(in.Target))
+	out.StrategicMerge = (*corev1alpha1.AnyJSON)(unsafe.Pointer(in.StrategicMerge))
+	out.JSON = (*corev1alpha1.AnyJSON)(unsafe.Pointer(in.JSON))
+	return nil
+}
+
+// Convert_helm_Patch_To_v1alpha1_Patch is an autogenerated conversion function.
+func Convert_helm_Patch_To_v1alpha1_Patch(in *helm.Patch, out *Patch, s conversion.Scope) error {
+	return autoConvert_helm_Patch_To_v1alpha1_Patch(in, out, s)
+}
+
+func autoConvert_v1alpha1_PatchTarget_To_helm_PatchTarget(in *PatchTarget, out *helm.PatchTarget, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Version = in.Version
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.LabelSelector = in.LabelSelector
+	out.AnnotationSelector = in.AnnotationSelector
+	return nil
+}
+
+// Convert_v1alpha1_PatchTarget_To_helm_PatchTarget is an autogenerated conversion function.
+func Convert_v1alpha1_PatchTarget_To_helm_PatchTarget(in *PatchTarget, out *helm.PatchTarget, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PatchTarget_To_helm_PatchTarget(in, out, s)
+}
+
+func autoConvert_helm_PatchTarget_To_v1alpha1_PatchTarget(in *helm.PatchTarget, out *PatchTarget, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Version = in.Version
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.LabelSelector = in.LabelSelector
+	out.AnnotationSelector = in.AnnotationSelector
+	return nil
+}
+
+// Convert_helm_PatchTarget_To_v1alpha1_PatchTarget is an autogenerated conversion function.
+func Convert_helm_PatchTarget_To_v1alpha1_PatchTarget(in *helm.PatchTarget, out *PatchTarget, s conversion.Scope) error {
+	return autoConvert_helm_PatchTarget_To_v1alpha1_PatchTarget(in, out, s)
+}
+
+func autoConvert_v1alpha1_PostRendererConfiguration_To_helm_PostRendererConfiguration(in *PostRendererConfiguration, out *helm.PostRendererConfiguration, s conversion.Scope) error {
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.Images = *(*[]helm.ImageOverride)(unsafe.Pointer(&in.Images))
+	out.Patches = *(*[]helm.Patch)(unsafe.Pointer(&in.Patches))
+	return nil
+}
+
+// Convert_v1alpha1_PostRendererConfiguration_To_helm_PostRendererConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_PostRendererConfiguration_To_helm_PostRendererConfiguration(in *PostRendererConfiguration, out *helm.PostRendererConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PostRendererConfiguration_To_helm_PostRendererConfiguration(in, out, s)
+}
+
+func autoConvert_helm_PostRendererConfiguration_To_v1alpha1_PostRendererConfiguration(in *helm.PostRendererConfiguration, out *PostRendererConfiguration, s conversion.Scope) error {
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.Images = *(*[]ImageOverride)(unsafe.Pointer(&in.Images))
+	out.Patches = *(*[]Patch)(unsafe.Pointer(&in.Patches))
+	return nil
+}
+
+// Convert_helm_PostRendererConfiguration_To_v1alpha1_PostRendererConfiguration is an autogenerated conversion function.
+func Convert_helm_PostRendererConfiguration_To_v1alpha1_PostRendererConfiguration(in *helm.PostRendererConfiguration, out *PostRendererConfiguration, s conversion.Scope) error {
+	return autoConvert_helm_PostRendererConfiguration_To_v1alpha1_PostRendererConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(in *ProviderConfiguration, out *helm.ProviderConfiguration, s conversion.Scope) error {
 	out.Kubeconfig = in.Kubeconfig
 	out.UpdateStrategy = helm.UpdateStrategy(in.UpdateStrategy)
@@ -460,8 +726,10 @@ func autoConvert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(in
 	out.ExportsFromManifests = *(*[]managedresource.Export)(unsafe.Pointer(&in.ExportsFromManifests))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.DriftDetection = (*driftdetection.DriftDetectionSpec)(unsafe.Pointer(in.DriftDetection))
 	out.HelmDeployment = (*bool)(unsafe.Pointer(in.HelmDeployment))
 	out.HelmDeploymentConfig = (*helm.HelmDeploymentConfiguration)(unsafe.Pointer(in.HelmDeploymentConfig))
+	out.PostRenderer = (*helm.PostRendererConfiguration)(unsafe.Pointer(in.PostRenderer))
 	return nil
 }
 
@@ -485,8 +753,10 @@ func autoConvert_helm_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in
 	out.ExportsFromManifests = *(*[]managedresource.Export)(unsafe.Pointer(&in.ExportsFromManifests))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.DriftDetection = (*driftdetection.DriftDetectionSpec)(unsafe.Pointer(in.DriftDetection))
 	out.HelmDeployment = (*bool)(unsafe.Pointer(in.HelmDeployment))
 	out.HelmDeploymentConfig = (*HelmDeploymentConfiguration)(unsafe.Pointer(in.HelmDeploymentConfig))
+	out.PostRenderer = (*PostRendererConfiguration)(unsafe.Pointer(in.PostRenderer))
 	return nil
 }
 
@@ -497,6 +767,11 @@ func Convert_helm_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in *he
 
 func autoConvert_v1alpha1_ProviderStatus_To_helm_ProviderStatus(in *ProviderStatus, out *helm.ProviderStatus, s conversion.Scope) error {
 	out.ManagedResources = *(*managedresource.ManagedResourceStatusList)(unsafe.Pointer(&in.ManagedResources))
+	out.Chart = (*helm.ChartStatus)(unsafe.Pointer(in.Chart))
+	out.Drift = (*driftdetection.DriftStatus)(unsafe.Pointer(in.Drift))
+	out.Hooks = *(*[]helm.HookStatus)(unsafe.Pointer(&in.Hooks))
+	out.AppliedManifestsSecretRef = (*corev1alpha1.SecretReference)(unsafe.Pointer(in.AppliedManifestsSecretRef))
+	out.AppliedManifestsHash = in.AppliedManifestsHash
 	return nil
 }
 
@@ -507,6 +782,11 @@ func Convert_v1alpha1_ProviderStatus_To_helm_ProviderStatus(in *ProviderStatus,
 
 func autoConvert_helm_ProviderStatus_To_v1alpha1_ProviderStatus(in *helm.ProviderStatus, out *ProviderStatus, s conversion.Scope) error {
 	out.ManagedResources = *(*managedresource.ManagedResourceStatusList)(unsafe.Pointer(&in.ManagedResources))
+	out.Chart = (*ChartStatus)(unsafe.Pointer(in.Chart))
+	out.Drift = (*driftdetection.DriftStatus)(unsafe.Pointer(in.Drift))
+	out.Hooks = *(*[]HookStatus)(unsafe.Pointer(&in.Hooks))
+	out.AppliedManifestsSecretRef = (*corev1alpha1.SecretReference)(unsafe.Pointer(in.AppliedManifestsSecretRef))
+	out.AppliedManifestsHash = in.AppliedManifestsHash
 	return nil
 }
 
@@ -11,6 +11,7 @@ import (
 
 	lscore "github.com/gardener/landscaper/apis/core"
 	cr "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
 	health "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks"
 )
 
@@ -41,6 +42,22 @@ type ProviderConfiguration struct {
 	// ContinuousReconcile contains the schedule for continuous reconciliation.
 	// +optional
 	ContinuousReconcile *cr.ContinuousReconcileSpec `json:"continuousReconcile,omitempty"`
+	// DriftDetection configures the detection and correction of changes of the deployed resources
+	// that were made directly in the target cluster.
+	// +optional
+	DriftDetection *dd.DriftDetectionSpec `json:"driftDetection,omitempty"`
+	// ServerSideApply configures the server-side apply of the manifests.
+	// Only relevant if the update strategy is "serverSideApply".
+	// +optional
+	ServerSideApply *ServerSideApplyConfiguration `json:"serverSideApply,omitempty"`
+}
+
+// ServerSideApplyConfiguration configures the server-side apply of manifests.
+type ServerSideApplyConfiguration struct {
+	// ForceConflicts defines whether the ownership of fields that are owned by other field managers is taken over.
+	// Otherwise, applying a manifest fails if one of its fields is owned by another field manager.
+	// +optional
+	ForceConflicts bool `json:"forceConflicts,omitempty"`
 }
 
 // UpdateStrategy defines the strategy that is used to apply resources to the cluster.
@@ -51,6 +68,8 @@ const (
 	UpdateStrategyPatch          UpdateStrategy = "patch"
 	UpdateStrategyMerge          UpdateStrategy = "merge"
 	UpdateStrategyMergeOverwrite UpdateStrategy = "mergeOverwrite"
+	// UpdateStrategyServerSideApply applies the manifests with a server-side apply using the landscaper field manager.
+	UpdateStrategyServerSideApply UpdateStrategy = "serverSideApply"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -66,4 +85,7 @@ type ProviderStatus struct {
 	// AnnotateBeforeDelete defines annotations that are being set before the manifest is being deleted.
 	// +optional
 	AnnotateBeforeDelete map[string]string `json:"annotateBeforeDelete,omitempty"`
+	// Drift contains the result of the last drift detection.
+	// +optional
+	Drift *dd.DriftStatus `json:"drift,omitempty"`
 }
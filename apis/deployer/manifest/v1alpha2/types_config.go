@@ -9,6 +9,7 @@ import (
 
 	lsconfigv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
 
+	"github.com/gardener/landscaper/apis/config"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 )
 
@@ -20,6 +21,9 @@ const ManagedInstanceLabel = "manifest.deployer.landscaper.gardener.cloud/instan
 // to define its source deploy item.
 const ManagedDeployItemLabel = "manifest.deployer.landscaper.gardener.cloud/deployitem"
 
+// FieldManager is the name of the field manager that is used by the manifest deployer for server-side apply.
+const FieldManager = "landscaper-manifest-deployer"
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Configuration is the manifest deployer configuration that configures the controller.
@@ -30,6 +34,9 @@ type Configuration struct {
 	Identity string `json:"identity,omitempty"`
 	// TargetSelector describes all selectors the deployer should depend on.
 	TargetSelector []lsv1alpha1.TargetSelector `json:"targetSelector,omitempty"`
+	// TargetResolvers configures the resolvers for targets with an external secret reference.
+	// +optional
+	TargetResolvers []config.TargetResolverConfiguration `json:"targetResolvers,omitempty"`
 	// Export defines the export configuration.
 	Export ExportConfiguration `json:"export,omitempty"`
 	// Controller contains configuration concerning the controller framework.
@@ -16,10 +16,12 @@ import (
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
+	config "github.com/gardener/landscaper/apis/config"
 	core "github.com/gardener/landscaper/apis/core"
 	v1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	manifest "github.com/gardener/landscaper/apis/deployer/manifest"
 	continuousreconcile "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
+	driftdetection "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
 	managedresource "github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 )
 
@@ -75,6 +77,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ServerSideApplyConfiguration)(nil), (*manifest.ServerSideApplyConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ServerSideApplyConfiguration_To_manifest_ServerSideApplyConfiguration(a.(*ServerSideApplyConfiguration), b.(*manifest.ServerSideApplyConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*manifest.ServerSideApplyConfiguration)(nil), (*ServerSideApplyConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_manifest_ServerSideApplyConfiguration_To_v1alpha2_ServerSideApplyConfiguration(a.(*manifest.ServerSideApplyConfiguration), b.(*ServerSideApplyConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*manifest.ProviderStatus)(nil), (*ProviderStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_manifest_ProviderStatus_To_v1alpha2_ProviderStatus(a.(*manifest.ProviderStatus), b.(*ProviderStatus), scope)
 	}); err != nil {
@@ -86,6 +98,7 @@ func RegisterConversions(s *runtime.Scheme) error {
 func autoConvert_v1alpha2_Configuration_To_manifest_Configuration(in *Configuration, out *manifest.Configuration, s conversion.Scope) error {
 	out.Identity = in.Identity
 	out.TargetSelector = *(*[]v1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.TargetResolvers = *(*[]config.TargetResolverConfiguration)(unsafe.Pointer(&in.TargetResolvers))
 	if err := Convert_v1alpha2_ExportConfiguration_To_manifest_ExportConfiguration(&in.Export, &out.Export, s); err != nil {
 		return err
 	}
@@ -103,6 +116,7 @@ func Convert_v1alpha2_Configuration_To_manifest_Configuration(in *Configuration,
 func autoConvert_manifest_Configuration_To_v1alpha2_Configuration(in *manifest.Configuration, out *Configuration, s conversion.Scope) error {
 	out.Identity = in.Identity
 	out.TargetSelector = *(*[]v1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.TargetResolvers = *(*[]config.TargetResolverConfiguration)(unsafe.Pointer(&in.TargetResolvers))
 	if err := Convert_manifest_ExportConfiguration_To_v1alpha2_ExportConfiguration(&in.Export, &out.Export, s); err != nil {
 		return err
 	}
@@ -165,6 +179,8 @@ func autoConvert_v1alpha2_ProviderConfiguration_To_manifest_ProviderConfiguratio
 	out.Manifests = *(*[]managedresource.Manifest)(unsafe.Pointer(&in.Manifests))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.DriftDetection = (*driftdetection.DriftDetectionSpec)(unsafe.Pointer(in.DriftDetection))
+	out.ServerSideApply = (*manifest.ServerSideApplyConfiguration)(unsafe.Pointer(in.ServerSideApply))
 	return nil
 }
 
@@ -181,6 +197,8 @@ func autoConvert_manifest_ProviderConfiguration_To_v1alpha2_ProviderConfiguratio
 	out.Manifests = *(*[]managedresource.Manifest)(unsafe.Pointer(&in.Manifests))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.DriftDetection = (*driftdetection.DriftDetectionSpec)(unsafe.Pointer(in.DriftDetection))
+	out.ServerSideApply = (*ServerSideApplyConfiguration)(unsafe.Pointer(in.ServerSideApply))
 	return nil
 }
 
@@ -191,6 +209,7 @@ func Convert_manifest_ProviderConfiguration_To_v1alpha2_ProviderConfiguration(in
 
 func autoConvert_v1alpha2_ProviderStatus_To_manifest_ProviderStatus(in *ProviderStatus, out *manifest.ProviderStatus, s conversion.Scope) error {
 	out.ManagedResources = *(*managedresource.ManagedResourceStatusList)(unsafe.Pointer(&in.ManagedResources))
+	out.Drift = (*driftdetection.DriftStatus)(unsafe.Pointer(in.Drift))
 	return nil
 }
 
@@ -203,5 +222,26 @@ func autoConvert_manifest_ProviderStatus_To_v1alpha2_ProviderStatus(in *manifest
 	out.ManagedResources = *(*managedresource.ManagedResourceStatusList)(unsafe.Pointer(&in.ManagedResources))
 	// WARNING: in.AnnotateBeforeCreate requires manual conversion: does not exist in peer-type
 	// WARNING: in.AnnotateBeforeDelete requires manual conversion: does not exist in peer-type
+	out.Drift = (*driftdetection.DriftStatus)(unsafe.Pointer(in.Drift))
+	return nil
+}
+
+func autoConvert_v1alpha2_ServerSideApplyConfiguration_To_manifest_ServerSideApplyConfiguration(in *ServerSideApplyConfiguration, out *manifest.ServerSideApplyConfiguration, s conversion.Scope) error {
+	out.ForceConflicts = in.ForceConflicts
+	return nil
+}
+
+// Convert_v1alpha2_ServerSideApplyConfiguration_To_manifest_ServerSideApplyConfiguration is an autogenerated conversion function.
+func Convert_v1alpha2_ServerSideApplyConfiguration_To_manifest_ServerSideApplyConfiguration(in *ServerSideApplyConfiguration, out *manifest.ServerSideApplyConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ServerSideApplyConfiguration_To_manifest_ServerSideApplyConfiguration(in, out, s)
+}
+
+func autoConvert_manifest_ServerSideApplyConfiguration_To_v1alpha2_ServerSideApplyConfiguration(in *manifest.ServerSideApplyConfiguration, out *ServerSideApplyConfiguration, s conversion.Scope) error {
+	out.ForceConflicts = in.ForceConflicts
 	return nil
 }
+
+// Convert_manifest_ServerSideApplyConfiguration_To_v1alpha2_ServerSideApplyConfiguration is an autogenerated conversion function.
+func Convert_manifest_ServerSideApplyConfiguration_To_v1alpha2_ServerSideApplyConfiguration(in *manifest.ServerSideApplyConfiguration, out *ServerSideApplyConfiguration, s conversion.Scope) error {
+	return autoConvert_manifest_ServerSideApplyConfiguration_To_v1alpha2_ServerSideApplyConfiguration(in, out, s)
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package driftdetection
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// DriftDetectionSpec configures the periodic comparison of the deployed resources with their last applied state.
+type DriftDetectionSpec struct {
+	// Interval specifies the time between two drift detections.
+	// Drift detection is disabled if no interval is specified.
+	// +optional
+	Interval *lsv1alpha1.Duration `json:"interval,omitempty"`
+
+	// AutoCorrect specifies whether detected drift is corrected by re-applying the affected resources.
+	// Otherwise, drift is only reported.
+	// +optional
+	AutoCorrect bool `json:"autoCorrect,omitempty"`
+
+	// FieldManagers are the names of field managers whose changes are considered as drift, e.g. "kubectl-edit".
+	// Changes of fields that are managed by other field managers than these and the deployer,
+	// like the replicas of a deployment that are managed by a horizontal pod autoscaler, are ignored.
+	// +optional
+	FieldManagers []string `json:"fieldManagers,omitempty"`
+}
+
+// DriftReason describes why a resource is considered as drifted.
+type DriftReason string
+
+const (
+	// DriftReasonDeleted means that the resource does not exist anymore in the target cluster.
+	DriftReasonDeleted DriftReason = "Deleted"
+	// DriftReasonModified means that fields of the resource differ from their last applied values.
+	DriftReasonModified DriftReason = "Modified"
+)
+
+// DriftStatus contains the result of the last drift detection.
+type DriftStatus struct {
+	// LastCheckTime is the time of the last drift detection.
+	LastCheckTime metav1.Time `json:"lastCheckTime"`
+
+	// LastCorrectionTime is the time when drift has been corrected the last time.
+	// +optional
+	LastCorrectionTime *metav1.Time `json:"lastCorrectionTime,omitempty"`
+
+	// DriftedResources contains all resources that differ from their last applied state.
+	// +optional
+	DriftedResources []DriftedResource `json:"driftedResources,omitempty"`
+}
+
+// DriftedResource describes a resource that differs from its last applied state.
+type DriftedResource struct {
+	// Resource is the reference to the drifted resource.
+	Resource corev1.ObjectReference `json:"resource"`
+
+	// Reason describes why the resource is considered as drifted.
+	Reason DriftReason `json:"reason"`
+
+	// Fields contains the paths of the fields that differ from their last applied values.
+	// +optional
+	Fields []string `json:"fields,omitempty"`
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+)
+
+// MinInterval is the minimal allowed interval between two drift detections.
+const MinInterval = time.Minute
+
+// ValidateDriftDetectionSpec validates a drift detection spec.
+// A value of nil is considered valid.
+func ValidateDriftDetectionSpec(fldPath *field.Path, spec *dd.DriftDetectionSpec) field.ErrorList {
+	if spec == nil {
+		return nil
+	}
+	allErrs := field.ErrorList{}
+	if spec.Interval == nil {
+		if spec.AutoCorrect {
+			allErrs = append(allErrs, field.Required(fldPath.Child("interval"), "an interval is required if autoCorrect is enabled"))
+		}
+		return allErrs
+	}
+	if spec.Interval.Duration < MinInterval {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("interval"), spec.Interval.Duration.String(),
+			"specified interval has to be at least "+MinInterval.String()))
+	}
+	return allErrs
+}
+
+// DriftDetectionEnabled returns true if the given spec configures a drift detection interval.
+func DriftDetectionEnabled(spec *dd.DriftDetectionSpec) bool {
+	return spec != nil && spec.Interval != nil && spec.Interval.Duration > 0
+}
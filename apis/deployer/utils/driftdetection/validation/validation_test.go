@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+	ddval "github.com/gardener/landscaper/apis/deployer/utils/driftdetection/validation"
+)
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Validation Test Suite")
+}
+
+var _ = Describe("Validation", func() {
+
+	Context("DriftDetectionSpec", func() {
+		It("should accept a nil or empty spec", func() {
+			allErrs := ddval.ValidateDriftDetectionSpec(field.NewPath("driftDetection"), nil)
+			allErrs = append(allErrs, ddval.ValidateDriftDetectionSpec(field.NewPath("driftDetection"), &dd.DriftDetectionSpec{})...)
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should accept a valid interval", func() {
+			spec := &dd.DriftDetectionSpec{
+				Interval:    &lsv1alpha1.Duration{Duration: 10 * time.Minute},
+				AutoCorrect: true,
+			}
+			allErrs := ddval.ValidateDriftDetectionSpec(field.NewPath("driftDetection"), spec)
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should deny an interval that is too short", func() {
+			spec := &dd.DriftDetectionSpec{
+				Interval: &lsv1alpha1.Duration{Duration: 10 * time.Second},
+			}
+			allErrs := ddval.ValidateDriftDetectionSpec(field.NewPath("driftDetection"), spec)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("driftDetection.interval"),
+			}))))
+		})
+
+		It("should deny auto correction without an interval", func() {
+			spec := &dd.DriftDetectionSpec{
+				AutoCorrect: true,
+			}
+			allErrs := ddval.ValidateDriftDetectionSpec(field.NewPath("driftDetection"), spec)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("driftDetection.interval"),
+			}))))
+		})
+	})
+
+})
@@ -79,6 +79,18 @@ type ManagedResourceStatus struct {
 	Policy ManifestPolicy `json:"policy,omitempty"`
 	// Resources describes the managed kubernetes resource.
 	Resource corev1.ObjectReference `json:"resource"`
+	// Conflicts contains the fields of the resource that are owned by other field managers.
+	// Only set if the resource is applied with a server-side apply.
+	// +optional
+	Conflicts []FieldManagerConflict `json:"conflicts,omitempty"`
+}
+
+// FieldManagerConflict describes fields of a resource that are owned by another field manager.
+type FieldManagerConflict struct {
+	// Manager is the name of the conflicting field manager.
+	Manager string `json:"manager"`
+	// Fields contains the paths of the conflicting fields.
+	Fields []string `json:"fields,omitempty"`
 }
 
 // Exports describes one export that is read from a resource.
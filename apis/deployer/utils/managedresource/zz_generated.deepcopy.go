@@ -75,6 +75,27 @@ func (in *Exports) DeepCopy() *Exports {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FieldManagerConflict) DeepCopyInto(out *FieldManagerConflict) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldManagerConflict.
+func (in *FieldManagerConflict) DeepCopy() *FieldManagerConflict {
+	if in == nil {
+		return nil
+	}
+	out := new(FieldManagerConflict)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FromObjectReference) DeepCopyInto(out *FromObjectReference) {
 	*out = *in
@@ -95,6 +116,13 @@ func (in *FromObjectReference) DeepCopy() *FromObjectReference {
 func (in *ManagedResourceStatus) DeepCopyInto(out *ManagedResourceStatus) {
 	*out = *in
 	out.Resource = in.Resource
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = make([]FieldManagerConflict, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -113,7 +141,9 @@ func (in ManagedResourceStatusList) DeepCopyInto(out *ManagedResourceStatusList)
 	{
 		in := &in
 		*out = make(ManagedResourceStatusList, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 		return
 	}
 }
@@ -127,6 +127,10 @@ registry:
 metrics:
   port: {{ .Values.landscaper.metrics.port | default 8080 }}
 {{- end }}
+{{- if .Values.landscaper.tracing }}
+tracing:
+{{ toYaml .Values.landscaper.tracing | indent 2 }}
+{{- end }}
 {{- if .Values.landscaper.crdManagement }}
 crdManagement:
     deployCrd: {{ .Values.landscaper.crdManagement.deployCrd }}
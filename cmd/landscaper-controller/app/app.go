@@ -25,10 +25,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/landscaper/pkg/metrics"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 
 	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	componentoverwritesctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/componentoverwrites"
 	contextctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/context"
 	"github.com/gardener/landscaper/pkg/landscaper/controllers/healthcheck"
 
@@ -44,7 +47,9 @@ import (
 	controllerruntimeMetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	installationsctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/installations"
+	orphansctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/orphans"
 	"github.com/gardener/landscaper/pkg/landscaper/controllers/targetsync"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects/blobstore"
 
 	"github.com/gardener/landscaper/pkg/landscaper/crdmanager"
 )
@@ -127,6 +132,16 @@ func (o *Options) run(ctx context.Context) error {
 
 	metrics.RegisterMetrics(controllerruntimeMetrics.Registry)
 
+	shutdownTracing, err := tracing.Setup(ctx, o.Config.Tracing)
+	if err != nil {
+		return fmt.Errorf("unable to setup tracing: %w", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			setupLogger.Error(err, "unable to shutdown tracing")
+		}
+	}()
+
 	store, err := blueprints.NewStore(o.Log.WithName("blueprintStore"), osfs.New(), o.Config.BlueprintStore)
 	if err != nil {
 		return fmt.Errorf("unable to setup blueprint store: %w", err)
@@ -144,6 +159,13 @@ func (o *Options) run(ctx context.Context) error {
 	}
 
 	install.Install(lsMgr.GetScheme())
+	read_write_layer.SetEventRecorder(lsMgr.GetEventRecorderFor("Landscaper"))
+	// the blob storage is always needed to store sensitive data objects in secrets.
+	blobStorage, err := blobstore.NewFromConfiguration(o.Log, lsMgr.GetClient(), o.Config.DataObjectStorage, o.Config.Registry.OCI)
+	if err != nil {
+		return fmt.Errorf("unable to setup data object storage: %w", err)
+	}
+	read_write_layer.SetBlobStorage(blobStorage)
 
 	ctrlLogger := o.Log.WithName("controllers")
 	if err := installationsctrl.AddControllerToManager(ctrlLogger, lsMgr, o.Config); err != nil {
@@ -202,6 +224,14 @@ func (o *Options) run(ctx context.Context) error {
 		return fmt.Errorf("unable to register target sync controller: %w", err)
 	}
 
+	if err := componentoverwritesctrl.AddControllerToManager(ctrlLogger, lsMgr); err != nil {
+		return fmt.Errorf("unable to register component version overwrites controller: %w", err)
+	}
+
+	if err := orphansctrl.AddControllerToManager(ctrlLogger, lsMgr, o.Config.Controllers.Orphans); err != nil {
+		return fmt.Errorf("unable to register orphans controller: %w", err)
+	}
+
 	setupLogger.Info("starting the controllers")
 	eg, ctx := errgroup.WithContext(ctx)
 
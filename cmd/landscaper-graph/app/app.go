@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/graph"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// NewLandscaperGraphCommand creates a new command that outputs the graph of an installation
+func NewLandscaperGraphCommand(ctx context.Context) *cobra.Command {
+	options := NewOptions()
+
+	cmd := &cobra.Command{
+		Use:   "landscaper-graph [installation name]",
+		Short: "Outputs the graph of an installation, its subinstallations, executions, deploy items, data objects and targets",
+		Args:  cobra.ExactArgs(1),
+
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := options.Complete(args); err != nil {
+				fmt.Print(err)
+				os.Exit(1)
+			}
+			if err := options.run(ctx, os.Stdout); err != nil {
+				fmt.Print(err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	options.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context, out io.Writer) error {
+	restConfig, err := o.getRestConfig()
+	if err != nil {
+		return fmt.Errorf("unable to build landscaper cluster rest client: %w", err)
+	}
+
+	kubeClient, err := client.New(restConfig, client.Options{Scheme: api.LandscaperScheme})
+	if err != nil {
+		return fmt.Errorf("unable to build landscaper cluster client: %w", err)
+	}
+
+	inst := &lsv1alpha1.Installation{}
+	if err := read_write_layer.GetInstallation(ctx, kubeClient, client.ObjectKey{Name: o.installationName, Namespace: o.namespace}, inst); err != nil {
+		return fmt.Errorf("unable to get installation %s/%s: %w", o.namespace, o.installationName, err)
+	}
+
+	g, err := graph.Build(ctx, kubeClient, inst)
+	if err != nil {
+		return fmt.Errorf("unable to build graph of installation %s/%s: %w", o.namespace, o.installationName, err)
+	}
+
+	if o.output == OutputJSON {
+		return g.WriteJSON(out)
+	}
+	return g.WriteDOT(out)
+}
+
+func (o *options) getRestConfig() (*rest.Config, error) {
+	if len(o.kubeconfigPath) == 0 {
+		return ctrl.GetConfig()
+	}
+	return clientcmd.BuildConfigFromFlags("", o.kubeconfigPath)
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	// OutputDOT outputs the graph in the Graphviz DOT language.
+	OutputDOT = "dot"
+	// OutputJSON outputs the graph as json.
+	OutputJSON = "json"
+)
+
+// options holds the options of the landscaper graph command
+type options struct {
+	kubeconfigPath   string
+	namespace        string
+	output           string
+	installationName string
+}
+
+// NewOptions returns a new options instance
+func NewOptions() *options {
+	return &options{}
+}
+
+// AddFlags adds flags passed via command line
+func (o *options) AddFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.kubeconfigPath, "kubeconfig", "", "Specify the path to the kubeconfig of the landscaper cluster. Defaults to the in-cluster config or the KUBECONFIG environment variable.")
+	fs.StringVarP(&o.namespace, "namespace", "n", "default", "Namespace of the installation")
+	fs.StringVarP(&o.output, "output", "o", OutputDOT, fmt.Sprintf("Output format of the graph, one of %q or %q", OutputDOT, OutputJSON))
+}
+
+// Complete initializes the options instance with the arguments and validates them
+func (o *options) Complete(args []string) error {
+	o.installationName = args[0]
+	return o.validate()
+}
+
+func (o *options) validate() error {
+	if o.output != OutputDOT && o.output != OutputJSON {
+		return fmt.Errorf("unsupported output format %q, must be %q or %q", o.output, OutputDOT, OutputJSON)
+	}
+	return nil
+}
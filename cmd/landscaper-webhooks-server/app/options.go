@@ -38,6 +38,11 @@ func defaultWebhookedResources() map[string]webhook.WebhookedResourceDefinition
 			APIVersions:  []string{"v1alpha1"},
 			ResourceName: "targets",
 		},
+		"exportgrants": {
+			APIGroup:     "landscaper.gardener.cloud",
+			APIVersions:  []string{"v1alpha1"},
+			ResourceName: "exportgrants",
+		},
 	}
 }
 
@@ -20,6 +20,7 @@ import (
 	lsutils "github.com/gardener/landscaper/pkg/utils"
 
 	"github.com/gardener/landscaper/pkg/landscaper/controllers/targetsync"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 
 	"github.com/spf13/cobra"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -93,6 +94,7 @@ func (o *options) run(ctx context.Context) error {
 	}
 
 	lsinstall.Install(lsMgr.GetScheme())
+	read_write_layer.SetEventRecorder(lsMgr.GetEventRecorderFor("Landscaper"))
 
 	if err := targetsync.AddControllerToManagerForTargetSyncs(o.Log, lsMgr); err != nil {
 		return fmt.Errorf("unable to setup landscaper deployments controller for target sync controller: %w", err)
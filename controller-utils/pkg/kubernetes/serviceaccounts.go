@@ -5,9 +5,12 @@ import (
 	"sort"
 	"time"
 
+	authenticationv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -71,3 +74,29 @@ func WaitForServiceAccountToken(ctx context.Context, kubeClient client.Client, s
 		return ok && len(token) > 0, nil
 	})
 }
+
+// RequestServiceAccountToken requests a token for the given service account using the TokenRequest api.
+// If expirationSeconds or audiences are not set, the defaults of the api server are used.
+func RequestServiceAccountToken(ctx context.Context, clientset kubernetes.Interface, serviceAccountKey client.ObjectKey,
+	expirationSeconds *int64, audiences []string) (*authenticationv1.TokenRequestStatus, error) {
+	treq := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			ExpirationSeconds: expirationSeconds,
+			Audiences:         audiences,
+		},
+	}
+
+	treq, err := clientset.CoreV1().ServiceAccounts(serviceAccountKey.Namespace).CreateToken(ctx, serviceAccountKey.Name, treq, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &treq.Status, nil
+}
+
+// GenerateServiceAccountKubeconfigBytes generates a kubeconfig that authenticates with the given service account token
+// at the cluster of the given rest config. The credentials of the rest config are not part of the kubeconfig.
+func GenerateServiceAccountKubeconfigBytes(restConfig *rest.Config, token string) ([]byte, error) {
+	tokenConfig := rest.AnonymousClientConfig(restConfig)
+	tokenConfig.BearerToken = token
+	return GenerateKubeconfigBytes(tokenConfig)
+}
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+)
+
+var _ = Describe("ServiceAccounts", func() {
+
+	Context("RequestServiceAccountToken", func() {
+
+		It("should request a token for the service account", func() {
+			clientset := fake.NewSimpleClientset()
+			expirationSeconds := int64(600)
+			var requested *authenticationv1.TokenRequest
+			clientset.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				createAction := action.(k8stesting.CreateAction)
+				Expect(createAction.GetSubresource()).To(Equal("token"))
+				Expect(createAction.GetNamespace()).To(Equal("ns"))
+				requested = createAction.GetObject().(*authenticationv1.TokenRequest)
+				res := requested.DeepCopy()
+				res.Status.Token = "my-token"
+				return true, res, nil
+			})
+
+			status, err := kubernetes.RequestServiceAccountToken(context.Background(), clientset,
+				client.ObjectKey{Name: "sa", Namespace: "ns"}, &expirationSeconds, []string{"aud"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Token).To(Equal("my-token"))
+			Expect(requested.Spec.ExpirationSeconds).To(Equal(&expirationSeconds))
+			Expect(requested.Spec.Audiences).To(ConsistOf("aud"))
+		})
+
+	})
+
+	Context("GenerateServiceAccountKubeconfigBytes", func() {
+
+		It("should generate a kubeconfig that only contains the token as credentials", func() {
+			restConfig := &rest.Config{
+				Host:     "https://example.com",
+				Username: "admin",
+				Password: "secret",
+				TLSClientConfig: rest.TLSClientConfig{
+					CAData: []byte("ca"),
+				},
+			}
+
+			data, err := kubernetes.GenerateServiceAccountKubeconfigBytes(restConfig, "my-token")
+			Expect(err).ToNot(HaveOccurred())
+
+			kubeconfig, err := clientcmd.Load(data)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kubeconfig.Clusters).To(HaveLen(1))
+			for _, cluster := range kubeconfig.Clusters {
+				Expect(cluster.Server).To(Equal("https://example.com"))
+				Expect(cluster.CertificateAuthorityData).To(Equal([]byte("ca")))
+			}
+			Expect(kubeconfig.AuthInfos).To(HaveLen(1))
+			for _, authInfo := range kubeconfig.AuthInfos {
+				Expect(authInfo.Token).To(Equal("my-token"))
+				Expect(authInfo.Username).To(BeEmpty())
+				Expect(authInfo.Password).To(BeEmpty())
+			}
+		})
+
+	})
+
+})
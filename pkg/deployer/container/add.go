@@ -56,6 +56,11 @@ func AddControllerToManager(logger logging.Logger, hostMgr, lsMgr manager.Manage
 		config,
 		deployer)
 
+	targetResolvers, err := deployerlib.NewTargetResolvers(config.TargetResolvers)
+	if err != nil {
+		return err
+	}
+
 	options := controller.Options{
 		MaxConcurrentReconciles: config.Controller.Workers,
 	}
@@ -70,6 +75,7 @@ func AddControllerToManager(logger logging.Logger, hostMgr, lsMgr manager.Manage
 		Type:            Type,
 		Deployer:        deployer,
 		TargetSelectors: config.TargetSelector,
+		TargetResolvers: targetResolvers,
 		Options:         options,
 	})
 	if err != nil {
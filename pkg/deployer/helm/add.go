@@ -28,6 +28,11 @@ func AddDeployerToManager(logger logging.Logger, lsMgr, hostMgr manager.Manager,
 		return err
 	}
 
+	targetResolvers, err := deployerlib.NewTargetResolvers(config.TargetResolvers)
+	if err != nil {
+		return err
+	}
+
 	options := controller.Options{
 		MaxConcurrentReconciles: config.Controller.Workers,
 	}
@@ -42,6 +47,7 @@ func AddDeployerToManager(logger logging.Logger, lsMgr, hostMgr manager.Manager,
 		Type:            Type,
 		Deployer:        d,
 		TargetSelectors: config.TargetSelector,
+		TargetResolvers: targetResolvers,
 		Options:         options,
 	})
 }
@@ -10,7 +10,9 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
@@ -19,12 +21,16 @@ import (
 	"github.com/gardener/component-cli/ociclient"
 	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
 	"github.com/gardener/component-spec/bindings-go/ctf"
+	"github.com/opencontainers/go-digest"
 	"helm.sh/helm/v3/pkg/chart"
 	chartloader "helm.sh/helm/v3/pkg/chart/loader"
 
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	componentsregistry "github.com/gardener/landscaper/pkg/landscaper/registry/components"
+	"github.com/gardener/landscaper/pkg/landscaper/registry/components/verification"
 )
 
 // NoChartDefinedError is the error that is returned if no Helm chart was provided
@@ -33,25 +39,42 @@ var NoChartDefinedError = errors.New("no chart was provided")
 // GetChart resolves the chart based on a chart access configuration.
 func GetChart(ctx context.Context, ociClient ociclient.Client,
 	helmChartRepoClient *helmchartrepo.HelmChartRepoClient, chartConfig *helmv1alpha1.Chart) (*chart.Chart, error) {
+	ch, _, err := ResolveChart(ctx, ociClient, helmChartRepoClient, chartConfig, false)
+	return ch, err
+}
 
-	if chartConfig.Archive != nil {
-		return getChartFromArchive(chartConfig.Archive)
-	}
+// ResolveChart resolves the chart based on a chart access configuration.
+// In addition to the chart, it returns the chart status, which contains the oci reference and the digest of the chart.
+// If verifyDigest is set, the digest of a chart from a component resource is verified against the digest of the resource.
+func ResolveChart(ctx context.Context, ociClient ociclient.Client,
+	helmChartRepoClient *helmchartrepo.HelmChartRepoClient, chartConfig *helmv1alpha1.Chart, verifyDigest bool) (*chart.Chart, *helmv1alpha1.ChartStatus, error) {
 
-	if len(chartConfig.Ref) != 0 {
-		return getChartFromOCIRef(ctx, ociClient, chartConfig.Ref)
+	var (
+		ch     *chart.Chart
+		status = &helmv1alpha1.ChartStatus{}
+		err    error
+	)
+	if chartConfig.Archive != nil {
+		ch, err = getChartFromArchive(chartConfig.Archive)
+	} else if len(chartConfig.Ref) != 0 {
+		ch, err = getChartFromOCIRef(ctx, ociClient, chartConfig.Ref, status)
+	} else if chartConfig.FromResource != nil {
+		// fetch the chart from a component descriptor defined resource
+		ch, err = getChartFromResource(ctx, ociClient, helmChartRepoClient, chartConfig.FromResource, verifyDigest)
+	} else if chartConfig.HelmChartRepo != nil {
+		ch, err = getChartFromHelmChartRepo(ctx, ociClient, helmChartRepoClient, chartConfig.HelmChartRepo, status)
+	} else {
+		return nil, nil, NoChartDefinedError
 	}
-
-	// fetch the chart from a component descriptor defined resource
-	if chartConfig.FromResource != nil {
-		return getChartFromResource(ctx, ociClient, helmChartRepoClient, chartConfig.FromResource)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	if chartConfig.HelmChartRepo != nil {
-		return getChartFromHelmChartRepo(ctx, helmChartRepoClient, chartConfig.HelmChartRepo)
+	if ch.Metadata != nil {
+		status.Name = ch.Metadata.Name
+		status.Version = ch.Metadata.Version
 	}
-
-	return nil, NoChartDefinedError
+	return ch, status, nil
 }
 
 func getChartFromArchive(archiveConfig *helmv1alpha1.ArchiveAccess) (*chart.Chart, error) {
@@ -86,8 +109,25 @@ func getChartFromArchive(archiveConfig *helmv1alpha1.ArchiveAccess) (*chart.Char
 	return nil, NoChartDefinedError
 }
 
-func getChartFromOCIRef(ctx context.Context, ociClient ociclient.Client, ref string) (*chart.Chart, error) {
-	ociAccess := cdv2.NewOCIRegistryAccess(ref)
+// getChartFromOCIRef fetches a chart from an oci registry.
+// The chart is fetched by the digest of its manifest, so that the chart matches the digest recorded in the status,
+// even if the tag has been moved in the meantime.
+func getChartFromOCIRef(ctx context.Context, ociClient ociclient.Client, ref string, status *helmv1alpha1.ChartStatus) (*chart.Chart, error) {
+	ref = strings.TrimPrefix(ref, OCIScheme)
+
+	desc, _, err := ociClient.GetRawManifest(ctx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get manifest of chart %q: %w", ref, err)
+	}
+	repository, pinnedDigest := parseOCIRef(ref)
+	if len(pinnedDigest) != 0 && pinnedDigest != desc.Digest.String() {
+		return nil, lserrors.NewError("getChartFromOCIRef", "VerifyDigest",
+			fmt.Sprintf("digest %q of chart %q does not match the pinned digest", desc.Digest.String(), ref),
+			lsv1alpha1.ErrorVerificationFailed)
+	}
+	digestRef := repository + "@" + desc.Digest.String()
+
+	ociAccess := cdv2.NewOCIRegistryAccess(digestRef)
 	access, err := cdv2.NewUnstructured(ociAccess)
 	if err != nil {
 		return nil, fmt.Errorf("unable to construct ociClient registry access for %q: %w", ref, err)
@@ -110,11 +150,39 @@ func getChartFromOCIRef(ctx context.Context, ociClient ociclient.Client, ref str
 	if err != nil {
 		return nil, fmt.Errorf("unable to load chart from archive: %w", err)
 	}
+
+	status.Ref = ref
+	status.Digest = desc.Digest.String()
 	return ch, err
 }
 
+// parseOCIRef splits an oci reference into the repository and the digest the reference is pinned to.
+// The digest is empty if the reference is not pinned.
+func parseOCIRef(ref string) (repository, digest string) {
+	repository = ref
+	if i := strings.LastIndex(repository, "@"); i != -1 {
+		repository, digest = repository[:i], repository[i+1:]
+	}
+	// remove the tag, the port of the registry host is followed by a slash.
+	if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+		repository = repository[:i]
+	}
+	return repository, digest
+}
+
+// ociRefFromHelmChartRepo returns the oci reference of a chart in an oci helm chart repository.
+// As oci tags must not contain a "+", it is replaced by "_" like helm does for the build metadata of versions.
+func ociRefFromHelmChartRepo(ref *helmv1alpha1.HelmChartRepo) string {
+	repoURL := strings.TrimSuffix(strings.TrimPrefix(ref.HelmChartRepoUrl, OCIScheme), "/")
+	ociRef := fmt.Sprintf("%s/%s:%s", repoURL, ref.HelmChartName, strings.ReplaceAll(ref.HelmChartVersion, "+", "_"))
+	if len(ref.HelmChartDigest) != 0 {
+		ociRef = ociRef + "@" + ref.HelmChartDigest
+	}
+	return ociRef
+}
+
 func getChartFromResource(ctx context.Context, ociClient ociclient.Client,
-	helmChartRepoClient *helmchartrepo.HelmChartRepoClient, ref *helmv1alpha1.RemoteChartReference) (*chart.Chart, error) {
+	helmChartRepoClient *helmchartrepo.HelmChartRepoClient, ref *helmv1alpha1.RemoteChartReference, verifyDigest bool) (*chart.Chart, error) {
 
 	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "getChartFromResource"})
 
@@ -157,9 +225,16 @@ func getChartFromResource(ctx context.Context, ociClient ociclient.Client,
 	res := resources[0]
 
 	var buf bytes.Buffer
-	if _, err := blobResolver.Resolve(ctx, res, &buf); err != nil {
+	digester := verification.NewBlobDigester(res)
+	if _, err := blobResolver.Resolve(ctx, res, io.MultiWriter(&buf, digester)); err != nil {
 		return nil, fmt.Errorf("unable to resolve chart from resource %q: %w", ref.ResourceName, err)
 	}
+	if verifyDigest {
+		if err := digester.Verify(ctx, ociClient); err != nil {
+			return nil, lserrors.NewWrappedError(err, "getChartFromResource", "VerifyDigest",
+				fmt.Sprintf("unable to verify chart from resource %q: %s", ref.ResourceName, err.Error()), lsv1alpha1.ErrorVerificationFailed)
+		}
+	}
 
 	ch, err := chartloader.LoadArchive(&buf)
 	if err != nil {
@@ -168,8 +243,14 @@ func getChartFromResource(ctx context.Context, ociClient ociclient.Client,
 	return ch, err
 }
 
-func getChartFromHelmChartRepo(ctx context.Context, helmChartRepoClient *helmchartrepo.HelmChartRepoClient,
-	ref *helmv1alpha1.HelmChartRepo) (*chart.Chart, error) {
+func getChartFromHelmChartRepo(ctx context.Context, ociClient ociclient.Client,
+	helmChartRepoClient *helmchartrepo.HelmChartRepoClient, ref *helmv1alpha1.HelmChartRepo,
+	status *helmv1alpha1.ChartStatus) (*chart.Chart, error) {
+
+	if strings.HasPrefix(ref.HelmChartRepoUrl, OCIScheme) {
+		return getChartFromOCIRef(ctx, ociClient, ociRefFromHelmChartRepo(ref), status)
+	}
+
 	resolver := helmchartrepo.NewHelmChartRepoResolverAsHelmChartRepoResolver(helmChartRepoClient)
 	var buf bytes.Buffer
 
@@ -178,9 +259,19 @@ func getChartFromHelmChartRepo(ctx context.Context, helmChartRepoClient *helmcha
 			ref.HelmChartName, ref.HelmChartVersion, ref.HelmChartRepoUrl, err)
 	}
 
+	chartDigest := digest.FromBytes(buf.Bytes()).String()
+	if len(ref.HelmChartDigest) != 0 && ref.HelmChartDigest != chartDigest {
+		return nil, lserrors.NewError("getChartFromHelmChartRepo", "VerifyDigest",
+			fmt.Sprintf("digest %q of chart %q with version %q does not match the pinned digest",
+				chartDigest, ref.HelmChartName, ref.HelmChartVersion),
+			lsv1alpha1.ErrorVerificationFailed)
+	}
+
 	ch, err := chartloader.LoadArchive(&buf)
 	if err != nil {
 		return nil, fmt.Errorf("unable to load chart from archive: %w", err)
 	}
+
+	status.Digest = chartDigest
 	return ch, err
 }
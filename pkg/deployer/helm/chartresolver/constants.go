@@ -26,4 +26,7 @@ const (
 	OldHelmResourceType = shared.OldHelmResourceType
 	// HelmChartResourceType describes the helm resource type of a component descrptor defined resource.
 	HelmChartResourceType = shared.HelmChartResourceType
+
+	// OCIScheme is the scheme of references to charts in oci registries.
+	OCIScheme = "oci://"
 )
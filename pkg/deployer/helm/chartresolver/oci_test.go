@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chartresolver_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	mock_oci "github.com/gardener/component-cli/ociclient/mock"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencontainers/go-digest"
+	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/deployer/helm/chartresolver"
+	utils "github.com/gardener/landscaper/test/utils"
+)
+
+var _ = Describe("OCI charts", func() {
+
+	var (
+		ctx        context.Context
+		ctrl       *gomock.Controller
+		ociClient  *mock_oci.MockClient
+		chartBytes []byte
+		closer     func()
+
+		manifestDigest = digest.FromString("manifest")
+	)
+
+	BeforeEach(func() {
+		ctx = logging.NewContext(context.Background(), logging.Discard())
+		ctrl = gomock.NewController(GinkgoT())
+		ociClient = mock_oci.NewMockClient(ctrl)
+		chartBytes, closer = utils.ReadChartFrom("./testdata/testchart")
+	})
+
+	AfterEach(func() {
+		closer()
+		ctrl.Finish()
+	})
+
+	// expectChart mocks the oci registry to return the test chart for the given reference.
+	// The chart is expected to be fetched by the digest of its manifest.
+	expectChart := func(ref, digestRef string) {
+		chartLayer := ocispecv1.Descriptor{
+			MediaType: chartresolver.ChartLayerMediaType,
+			Digest:    digest.FromBytes(chartBytes),
+			Size:      int64(len(chartBytes)),
+		}
+		manifest := &ocispecv1.Manifest{
+			Config: ocispecv1.Descriptor{
+				MediaType: chartresolver.HelmChartConfigMediaType,
+			},
+			Layers: []ocispecv1.Descriptor{chartLayer},
+		}
+
+		ociClient.EXPECT().GetRawManifest(gomock.Any(), ref).Return(ocispecv1.Descriptor{Digest: manifestDigest}, nil, nil)
+		ociClient.EXPECT().GetManifest(gomock.Any(), digestRef).Return(manifest, nil)
+		ociClient.EXPECT().Fetch(gomock.Any(), digestRef, chartLayer, gomock.Any()).Return(nil).
+			Do(func(_ context.Context, _ string, _ ocispecv1.Descriptor, writer io.Writer) {
+				_, err := io.Copy(writer, bytes.NewBuffer(chartBytes))
+				Expect(err).ToNot(HaveOccurred())
+			})
+	}
+
+	It("should resolve a chart from an oci reference and record its digest", func() {
+		expectChart("example.com/charts/testchart:0.1.0", "example.com/charts/testchart@"+manifestDigest.String())
+
+		ch, status, err := chartresolver.ResolveChart(ctx, ociClient, nil, &helmv1alpha1.Chart{
+			Ref: "oci://example.com/charts/testchart:0.1.0",
+		}, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ch.Metadata.Name).To(Equal("testchart"))
+		Expect(status.Name).To(Equal("testchart"))
+		Expect(status.Ref).To(Equal("example.com/charts/testchart:0.1.0"))
+		Expect(status.Digest).To(Equal(manifestDigest.String()))
+	})
+
+	It("should resolve a chart from an oci helm chart repository", func() {
+		expectChart("example.com:5000/charts/testchart:0.1.0_build.1@"+manifestDigest.String(),
+			"example.com:5000/charts/testchart@"+manifestDigest.String())
+
+		ch, status, err := chartresolver.ResolveChart(ctx, ociClient, nil, &helmv1alpha1.Chart{
+			HelmChartRepo: &helmv1alpha1.HelmChartRepo{
+				HelmChartRepoUrl: "oci://example.com:5000/charts/",
+				HelmChartName:    "testchart",
+				HelmChartVersion: "0.1.0+build.1",
+				HelmChartDigest:  manifestDigest.String(),
+			},
+		}, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ch.Metadata.Name).To(Equal("testchart"))
+		Expect(status.Digest).To(Equal(manifestDigest.String()))
+	})
+
+	It("should fail if the digest of the chart does not match the pinned digest", func() {
+		ref := "example.com/charts/testchart:0.1.0@" + digest.FromString("other").String()
+		ociClient.EXPECT().GetRawManifest(gomock.Any(), ref).Return(ocispecv1.Descriptor{Digest: manifestDigest}, nil, nil)
+
+		_, _, err := chartresolver.ResolveChart(ctx, ociClient, nil, &helmv1alpha1.Chart{
+			Ref: ref,
+		}, false)
+		Expect(err).To(HaveOccurred())
+		lsErr, ok := lserrors.IsError(err)
+		Expect(ok).To(BeTrue())
+		Expect(lsErr.LandscaperError().Codes).To(ContainElement(lsv1alpha1.ErrorVerificationFailed))
+	})
+
+})
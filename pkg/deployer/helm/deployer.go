@@ -9,11 +9,13 @@ import (
 	"time"
 
 	"github.com/gardener/component-cli/ociclient/cache"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
 	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
+	ddval "github.com/gardener/landscaper/apis/deployer/utils/driftdetection/validation"
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	deployerlib "github.com/gardener/landscaper/pkg/deployer/lib"
@@ -119,3 +121,27 @@ func (d *deployer) NextReconcile(ctx context.Context, last time.Time, di *lsv1al
 	next := schedule.Next(last)
 	return &next, nil
 }
+
+func (d *deployer) DriftDetectionSchedule(ctx context.Context, di *lsv1alpha1.DeployItem) (time.Duration, *metav1.Time, error) {
+	helm, err := New(d.config, d.lsClient, d.hostClient, di, nil, nil, d.sharedCache)
+	if err != nil {
+		return 0, nil, err
+	}
+	if !ddval.DriftDetectionEnabled(helm.ProviderConfiguration.DriftDetection) {
+		// no drift detection configured
+		return 0, nil, nil
+	}
+	var lastCheck *metav1.Time
+	if helm.ProviderStatus != nil && helm.ProviderStatus.Drift != nil {
+		lastCheck = &helm.ProviderStatus.Drift.LastCheckTime
+	}
+	return helm.ProviderConfiguration.DriftDetection.Interval.Duration, lastCheck, nil
+}
+
+func (d *deployer) DetectDrift(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
+	helm, err := New(d.config, d.lsClient, d.hostClient, di, rt, lsCtx, d.sharedCache)
+	if err != nil {
+		return err
+	}
+	return helm.DetectDrift(ctx)
+}
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/opencontainers/go-digest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/api"
+	deployerlib "github.com/gardener/landscaper/pkg/deployer/lib"
+	"github.com/gardener/landscaper/pkg/deployer/lib/resourcemanager"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// DetectDrift compares the managed resources with the manifests of the last successful deployment.
+// The drifted resources are reported in the provider status and the drift condition of the deploy item.
+// Drifted resources are re-applied if auto correction is enabled.
+func (h *Helm) DetectDrift(ctx context.Context) error {
+	currOp := "DetectDrift"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	if h.ProviderStatus == nil || h.ProviderConfiguration.DriftDetection == nil {
+		return nil
+	}
+
+	manifests, err := h.getAppliedManifests(ctx)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "GetAppliedManifests", err.Error())
+	}
+	if manifests == nil {
+		logger.Info("Manifests of the last deployment have not been recorded, drift is detected after the next reconcile")
+		return nil
+	}
+
+	_, targetClient, targetClientSet, err := h.TargetClient(ctx)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "TargetClusterClient", err.Error())
+	}
+
+	detector := resourcemanager.NewDriftDetector(resourcemanager.DriftDetectorOptions{
+		Decoder:          serializer.NewCodecFactory(scheme.Scheme).UniversalDecoder(),
+		KubeClient:       targetClient,
+		Clientset:        targetClientSet,
+		DefaultNamespace: h.ProviderConfiguration.Namespace,
+		DeployItemName:   h.DeployItem.Name,
+		Manifests:        manifests,
+		ManagedResources: h.ProviderStatus.ManagedResources,
+		Labels: map[string]string{
+			helmv1alpha1.ManagedDeployItemLabel: h.DeployItem.Name,
+		},
+		UpdateStrategy:     manifestv1alpha2.UpdateStrategy(h.ProviderConfiguration.UpdateStrategy),
+		FieldManager:       helmv1alpha1.FieldManager,
+		DriftFieldManagers: h.ProviderConfiguration.DriftDetection.FieldManagers,
+	})
+
+	drifted, err := detector.Detect(ctx)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "Detect", err.Error())
+	}
+
+	status := &dd.DriftStatus{
+		LastCheckTime:    metav1.Now(),
+		DriftedResources: drifted,
+	}
+	if h.ProviderStatus.Drift != nil {
+		status.LastCorrectionTime = h.ProviderStatus.Drift.LastCorrectionTime
+	}
+
+	corrected := false
+	if len(drifted) != 0 && h.ProviderConfiguration.DriftDetection.AutoCorrect {
+		if err := detector.Correct(ctx); err != nil {
+			logger.Error(err, "unable to correct drifted resources")
+		} else {
+			now := metav1.Now()
+			status.LastCorrectionTime = &now
+			corrected = true
+		}
+	}
+
+	h.ProviderStatus.Drift = status
+	h.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(h.ProviderStatus, HelmScheme)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ProviderStatus", err.Error())
+	}
+	deployerlib.UpdateDriftCondition(h.DeployItem, status, corrected)
+
+	if err := h.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000170, h.DeployItem); err != nil {
+		return lserrors.NewWrappedError(err, currOp, "UpdateStatus", err.Error())
+	}
+	return nil
+}
+
+// storeAppliedManifests records the manifests of a successful deployment in a secret owned by the deploy item,
+// so that the drift detection compares the managed resources with the manifests that have actually been applied,
+// independent of later changes of the chart or its values.
+func (h *Helm) storeAppliedManifests(ctx context.Context, manifests []managedresource.Manifest) error {
+	data, err := json.Marshal(manifests)
+	if err != nil {
+		return fmt.Errorf("unable to marshal applied manifests: %w", err)
+	}
+
+	secret := &corev1.Secret{}
+	secret.Name = fmt.Sprintf("%s-applied-manifests", h.DeployItem.Name)
+	secret.Namespace = h.DeployItem.Namespace
+	if _, err := controllerutil.CreateOrUpdate(ctx, h.lsKubeClient, secret, func() error {
+		secret.Data = map[string][]byte{
+			lsv1alpha1.DataObjectSecretDataKey: data,
+		}
+		return controllerutil.SetOwnerReference(h.DeployItem, secret, api.LandscaperScheme)
+	}); err != nil {
+		return fmt.Errorf("unable to store applied manifests: %w", err)
+	}
+
+	h.ProviderStatus.AppliedManifestsSecretRef = &lsv1alpha1.SecretReference{
+		ObjectReference: lsv1alpha1.ObjectReference{
+			Name:      secret.Name,
+			Namespace: secret.Namespace,
+		},
+		Key: lsv1alpha1.DataObjectSecretDataKey,
+	}
+	h.ProviderStatus.AppliedManifestsHash = digest.FromBytes(data).String()
+	return nil
+}
+
+// getAppliedManifests returns the recorded manifests of the last successful deployment.
+// It returns nil if no manifests have been recorded.
+func (h *Helm) getAppliedManifests(ctx context.Context) ([]managedresource.Manifest, error) {
+	ref := h.ProviderStatus.AppliedManifestsSecretRef
+	if ref == nil {
+		return nil, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := h.lsKubeClient.Get(ctx, ref.NamespacedName(), secret); err != nil {
+		return nil, fmt.Errorf("unable to get applied manifests: %w", err)
+	}
+	data := secret.Data[ref.Key]
+	if dig := digest.FromBytes(data).String(); dig != h.ProviderStatus.AppliedManifestsHash {
+		return nil, fmt.Errorf("digest %q of the applied manifests does not match the expected digest %q", dig, h.ProviderStatus.AppliedManifestsHash)
+	}
+
+	manifests := []managedresource.Manifest{}
+	if err := json.Unmarshal(data, &manifests); err != nil {
+		return nil, fmt.Errorf("unable to decode applied manifests: %w", err)
+	}
+	return manifests, nil
+}
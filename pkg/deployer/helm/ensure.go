@@ -59,6 +59,7 @@ func (h *Helm) ApplyFiles(ctx context.Context, files, crds map[string]string, ex
 			ManagedResources: make(managedresource.ManagedResourceStatusList, 0),
 		}
 	}
+	h.ProviderStatus.Chart = h.chartStatus
 
 	manifests, err := h.createManifests(ctx, currOp, files, crds)
 	if err != nil {
@@ -68,6 +69,7 @@ func (h *Helm) ApplyFiles(ctx context.Context, files, crds map[string]string, ex
 	var (
 		managedResourceStatusList managedresource.ManagedResourceStatusList
 		deployErr                 error
+		realHelmDeployer          *realhelmdeployer.RealHelmDeployer
 	)
 
 	shouldUseRealHelmDeployer := pointer.BoolDeref(h.ProviderConfiguration.HelmDeployment, true)
@@ -75,9 +77,10 @@ func (h *Helm) ApplyFiles(ctx context.Context, files, crds map[string]string, ex
 	if shouldUseRealHelmDeployer {
 		// apply helm
 		// convert manifests in ManagedResourceStatusList
-		realHelmDeployer := realhelmdeployer.NewRealHelmDeployer(ch, h.ProviderConfiguration,
+		realHelmDeployer = realhelmdeployer.NewRealHelmDeployer(ch, h.ProviderConfiguration,
 			h.TargetRestConfig, targetClientSet)
 		deployErr = realHelmDeployer.Deploy(ctx)
+		h.ProviderStatus.Hooks = realHelmDeployer.GetHookStatus(ctx)
 		if deployErr == nil {
 			managedResourceStatusList, err = realHelmDeployer.GetManagedResourcesStatus(ctx, manifests)
 			if err != nil {
@@ -101,6 +104,12 @@ func (h *Helm) ApplyFiles(ctx context.Context, files, crds map[string]string, ex
 		return deployErr
 	}
 
+	if h.ProviderConfiguration.DriftDetection != nil {
+		if err := h.storeAppliedManifests(ctx, manifests); err != nil {
+			return lserrors.NewWrappedError(err, currOp, "StoreAppliedManifests", err.Error())
+		}
+	}
+
 	h.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(h.ProviderStatus, HelmScheme)
 	if err != nil {
 		return lserrors.NewWrappedError(err, currOp, "ProviderStatus", err.Error())
@@ -114,6 +123,12 @@ func (h *Helm) ApplyFiles(ctx context.Context, files, crds map[string]string, ex
 		return err
 	}
 
+	if realHelmDeployer != nil {
+		if err := h.runTests(ctx, realHelmDeployer); err != nil {
+			return err
+		}
+	}
+
 	if err := h.readExportValues(ctx, currOp, targetClient, managedResourceStatusList, exports); err != nil {
 		return err
 	}
@@ -123,6 +138,33 @@ func (h *Helm) ApplyFiles(ctx context.Context, files, crds map[string]string, ex
 	return nil
 }
 
+// runTests executes the tests of the helm release if they are enabled, and reports the results in the provider status.
+func (h *Helm) runTests(ctx context.Context, realHelmDeployer *realhelmdeployer.RealHelmDeployer) error {
+	currOp := "RunHelmTests"
+
+	testsEnabled, err := realHelmDeployer.TestsEnabled()
+	if err != nil {
+		return err
+	}
+	if !testsEnabled {
+		return nil
+	}
+
+	testErr := realHelmDeployer.Test(ctx)
+	h.ProviderStatus.Hooks = realHelmDeployer.GetHookStatus(ctx)
+
+	h.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(h.ProviderStatus, HelmScheme)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ProviderStatus", err.Error())
+	}
+
+	// the status is also written if a test has failed, so that the results of the failed tests are reported
+	if err := h.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000171, h.DeployItem); err != nil {
+		return lserrors.NewWrappedError(err, currOp, "UpdateStatus", err.Error())
+	}
+	return testErr
+}
+
 func (h *Helm) applyManifests(ctx context.Context, targetClient client.Client, targetClientSet kubernetes.Interface,
 	manifests []managedresource.Manifest) (*resourcemanager.ManifestApplier, error) {
 	applier := resourcemanager.NewManifestApplier(resourcemanager.ManifestApplierOptions{
@@ -133,6 +175,7 @@ func (h *Helm) applyManifests(ctx context.Context, targetClient client.Client, t
 		DeployItemName:   h.DeployItem.Name,
 		DeleteTimeout:    h.ProviderConfiguration.DeleteTimeout.Duration,
 		UpdateStrategy:   manifestv1alpha2.UpdateStrategy(h.ProviderConfiguration.UpdateStrategy),
+		FieldManager:     helmv1alpha1.FieldManager,
 		Manifests:        manifests,
 		ManagedResources: h.ProviderStatus.ManagedResources,
 		Labels: map[string]string{
@@ -36,8 +36,10 @@ import (
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/deployer/helm/chartresolver"
 	"github.com/gardener/landscaper/pkg/deployer/helm/helmchartrepo"
+	"github.com/gardener/landscaper/pkg/deployer/helm/postrenderer"
 	"github.com/gardener/landscaper/pkg/deployer/lib"
 	"github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 const (
@@ -72,6 +74,9 @@ type Helm struct {
 	TargetKubeClient client.Client
 	TargetRestConfig *rest.Config
 	TargetClientSet  kubernetes.Interface
+
+	// chartStatus describes the chart of the last templating.
+	chartStatus *helmv1alpha1.ChartStatus
 }
 
 // New creates a new internal helm item
@@ -121,6 +126,9 @@ func New(helmconfig helmv1alpha1.Configuration,
 // Template loads the specified helm chart
 // and templates it with the given values.
 func (h *Helm) Template(ctx context.Context, lsClient client.Client) (map[string]string, map[string]string, map[string]interface{}, *chart.Chart, lserrors.LsError) {
+	ctx, span := tracing.StartSpan(ctx, "RenderTemplates")
+	defer span.End()
+
 	currOp := "TemplateChart"
 
 	restConfig, _, _, err := h.TargetClient(ctx)
@@ -145,10 +153,14 @@ func (h *Helm) Template(ctx context.Context, lsClient client.Client) (map[string
 		return nil, nil, nil, nil, lsError
 	}
 
-	ch, err := chartresolver.GetChart(ctx, ociClient, helmChartRepoClient, &h.ProviderConfiguration.Chart)
+	// the digest of a chart from a component resource can only be trusted if the component descriptors are verified
+	verifyDigest := h.Context != nil && h.Context.Verification != nil
+	ch, chartStatus, err := chartresolver.ResolveChart(ctx, ociClient, helmChartRepoClient, &h.ProviderConfiguration.Chart, verifyDigest)
 	if err != nil {
-		return nil, nil, nil, nil, lserrors.NewWrappedError(err, currOp, "GetHelmChart", err.Error())
+		// keep the error codes of a failed digest verification
+		return nil, nil, nil, nil, lserrors.BuildLsError(err, currOp, "GetHelmChart", err.Error())
 	}
+	h.chartStatus = chartStatus
 
 	//template chart
 	options := chartutil.ReleaseOptions{
@@ -175,6 +187,15 @@ func (h *Helm) Template(ctx context.Context, lsClient client.Client) (map[string
 			err, currOp, "RenderHelmValues", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
 	}
 
+	// the crds of the chart are not post-rendered, as helm only post-renders the templated manifests.
+	if h.ProviderConfiguration.PostRenderer != nil {
+		files, err = postrenderer.New(h.ProviderConfiguration.PostRenderer).RenderFiles(files)
+		if err != nil {
+			return nil, nil, nil, nil, lserrors.NewWrappedError(
+				err, currOp, "PostRenderManifests", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+		}
+	}
+
 	crds := map[string]string{}
 	for _, crd := range ch.CRDObjects() {
 		crds[crd.Filename] = string(crd.File.Data[:])
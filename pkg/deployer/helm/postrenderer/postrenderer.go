@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postrenderer
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/postrender"
+	"sigs.k8s.io/kustomize/api/krusty"
+	kustypes "sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+	"sigs.k8s.io/yaml"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+)
+
+const (
+	// RenderedFileName is the name of the file that contains all post-rendered manifests of a chart.
+	RenderedFileName = "post-rendered.yaml"
+
+	kustomizationFile = "/kustomization.yaml"
+	resourcesFile     = "/resources.yaml"
+)
+
+// PostRenderer applies kustomize-style modifications to the rendered manifests of a chart.
+// It implements the post-renderer interface of helm, so that it can be used for helm install and upgrade operations.
+type PostRenderer struct {
+	config *helmv1alpha1.PostRendererConfiguration
+}
+
+var _ postrender.PostRenderer = &PostRenderer{}
+
+// New creates a new post-renderer for the given configuration.
+func New(config *helmv1alpha1.PostRendererConfiguration) *PostRenderer {
+	return &PostRenderer{
+		config: config,
+	}
+}
+
+// Run applies the modifications to the given multi-document yaml manifests.
+func (r *PostRenderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
+	kustomization, err := r.kustomization()
+	if err != nil {
+		return nil, err
+	}
+
+	fs := filesys.MakeFsInMemory()
+	if err := fs.WriteFile(kustomizationFile, kustomization); err != nil {
+		return nil, err
+	}
+	if err := fs.WriteFile(resourcesFile, renderedManifests.Bytes()); err != nil {
+		return nil, err
+	}
+
+	resMap, err := krusty.MakeKustomizer(krusty.MakeDefaultOptions()).Run(fs, filepath.Dir(kustomizationFile))
+	if err != nil {
+		return nil, fmt.Errorf("unable to post-render manifests: %w", err)
+	}
+	modifiedManifests, err := resMap.AsYaml()
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode post-rendered manifests: %w", err)
+	}
+	return bytes.NewBuffer(modifiedManifests), nil
+}
+
+// RenderFiles applies the modifications to the templated files of a chart.
+// As patches may apply to resources of any file, all manifests are post-rendered together
+// and returned as one file with the name RenderedFileName. The notes of the chart are kept unchanged.
+func (r *PostRenderer) RenderFiles(files map[string]string) (map[string]string, error) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := map[string]string{}
+	renderedManifests := &bytes.Buffer{}
+	for _, name := range names {
+		content := files[name]
+		if filepath.Base(name) == "NOTES.txt" {
+			result[name] = content
+			continue
+		}
+		if len(strings.TrimSpace(content)) == 0 {
+			continue
+		}
+		fmt.Fprintf(renderedManifests, "---\n# Source: %s\n%s\n", name, content)
+	}
+
+	modifiedManifests, err := r.Run(renderedManifests)
+	if err != nil {
+		return nil, err
+	}
+	result[RenderedFileName] = modifiedManifests.String()
+	return result, nil
+}
+
+// kustomization creates the kustomization file for the post-renderer configuration.
+func (r *PostRenderer) kustomization() ([]byte, error) {
+	kustomization := kustypes.Kustomization{
+		TypeMeta: kustypes.TypeMeta{
+			APIVersion: kustypes.KustomizationVersion,
+			Kind:       kustypes.KustomizationKind,
+		},
+		Resources:         []string{filepath.Base(resourcesFile)},
+		CommonAnnotations: r.config.Annotations,
+	}
+
+	if len(r.config.Labels) != 0 {
+		kustomization.Labels = []kustypes.Label{
+			{
+				Pairs:            r.config.Labels,
+				IncludeTemplates: true,
+			},
+		}
+	}
+
+	for _, image := range r.config.Images {
+		kustomization.Images = append(kustomization.Images, kustypes.Image{
+			Name:    image.Name,
+			NewName: image.NewName,
+			NewTag:  image.NewTag,
+			Digest:  image.Digest,
+		})
+	}
+
+	for i, patch := range r.config.Patches {
+		var content []byte
+		switch {
+		case patch.StrategicMerge != nil:
+			content = patch.StrategicMerge.RawMessage
+		case patch.JSON != nil:
+			content = patch.JSON.RawMessage
+		default:
+			return nil, fmt.Errorf("patch %d defines neither a strategic merge patch nor a json patch", i)
+		}
+
+		kusPatch := kustypes.Patch{
+			Patch: string(content),
+		}
+		if patch.Target != nil {
+			kusPatch.Target = &kustypes.Selector{
+				ResId: resid.ResId{
+					Gvk: resid.Gvk{
+						Group:   patch.Target.Group,
+						Version: patch.Target.Version,
+						Kind:    patch.Target.Kind,
+					},
+					Name:      patch.Target.Name,
+					Namespace: patch.Target.Namespace,
+				},
+				LabelSelector:      patch.Target.LabelSelector,
+				AnnotationSelector: patch.Target.AnnotationSelector,
+			}
+		}
+		kustomization.Patches = append(kustomization.Patches, kusPatch)
+	}
+
+	return yaml.Marshal(kustomization)
+}
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postrenderer_test
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/pkg/deployer/helm/postrenderer"
+)
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "PostRenderer Test Suite")
+}
+
+const deployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: nginx
+  namespace: default
+spec:
+  selector:
+    matchLabels:
+      app: nginx
+  template:
+    metadata:
+      labels:
+        app: nginx
+    spec:
+      containers:
+      - name: nginx
+        image: nginx:1.21
+`
+
+const configMap = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+  namespace: default
+data:
+  key: val
+`
+
+var _ = Describe("PostRenderer", func() {
+
+	render := func(config *helmv1alpha1.PostRendererConfiguration) (*appsv1.Deployment, *corev1.ConfigMap) {
+		files, err := postrenderer.New(config).RenderFiles(map[string]string{
+			"chart/templates/deployment.yaml": deployment,
+			"chart/templates/configmap.yaml":  configMap,
+			"chart/templates/empty.yaml":      "\n",
+			"chart/templates/NOTES.txt":       "some notes",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(2))
+		Expect(files).To(HaveKeyWithValue("chart/templates/NOTES.txt", "some notes"))
+		Expect(files).To(HaveKey(postrenderer.RenderedFileName))
+
+		docs := bytes.Split([]byte(files[postrenderer.RenderedFileName]), []byte("\n---\n"))
+		Expect(docs).To(HaveLen(2))
+		cm := &corev1.ConfigMap{}
+		Expect(yaml.Unmarshal(docs[0], cm)).To(Succeed())
+		deploy := &appsv1.Deployment{}
+		Expect(yaml.Unmarshal(docs[1], deploy)).To(Succeed())
+		return deploy, cm
+	}
+
+	It("should add labels and annotations to all resources and pod templates but not to selectors", func() {
+		deploy, cm := render(&helmv1alpha1.PostRendererConfiguration{
+			Labels:      map[string]string{"team": "a"},
+			Annotations: map[string]string{"owner": "b"},
+		})
+
+		Expect(cm.Labels).To(HaveKeyWithValue("team", "a"))
+		Expect(cm.Annotations).To(HaveKeyWithValue("owner", "b"))
+		Expect(deploy.Labels).To(HaveKeyWithValue("team", "a"))
+		Expect(deploy.Annotations).To(HaveKeyWithValue("owner", "b"))
+		Expect(deploy.Spec.Template.Labels).To(HaveKeyWithValue("team", "a"))
+		Expect(deploy.Spec.Template.Annotations).To(HaveKeyWithValue("owner", "b"))
+		Expect(deploy.Spec.Selector.MatchLabels).To(Equal(map[string]string{"app": "nginx"}))
+	})
+
+	It("should replace images", func() {
+		deploy, _ := render(&helmv1alpha1.PostRendererConfiguration{
+			Images: []helmv1alpha1.ImageOverride{
+				{
+					Name:    "nginx",
+					NewName: "registry.example.com/nginx",
+					NewTag:  "1.23",
+				},
+			},
+		})
+
+		Expect(deploy.Spec.Template.Spec.Containers[0].Image).To(Equal("registry.example.com/nginx:1.23"))
+	})
+
+	It("should apply strategic merge patches", func() {
+		deploy, _ := render(&helmv1alpha1.PostRendererConfiguration{
+			Patches: []helmv1alpha1.Patch{
+				{
+					StrategicMerge: &lsv1alpha1.AnyJSON{RawMessage: []byte(`{
+						"apiVersion": "apps/v1",
+						"kind": "Deployment",
+						"metadata": {"name": "nginx", "namespace": "default"},
+						"spec": {"template": {"spec": {"containers": [{"name": "sidecar", "image": "busybox"}]}}}
+					}`)},
+				},
+			},
+		})
+
+		Expect(deploy.Spec.Template.Spec.Containers).To(HaveLen(2))
+		Expect(deploy.Spec.Template.Spec.Containers[0].Name).To(Equal("sidecar"))
+		Expect(deploy.Spec.Template.Spec.Containers[1].Name).To(Equal("nginx"))
+	})
+
+	It("should apply json patches to the selected resources", func() {
+		deploy, cm := render(&helmv1alpha1.PostRendererConfiguration{
+			Patches: []helmv1alpha1.Patch{
+				{
+					Target: &helmv1alpha1.PatchTarget{
+						Kind: "ConfigMap",
+					},
+					JSON: &lsv1alpha1.AnyJSON{RawMessage: []byte(`[{"op": "replace", "path": "/data/key", "value": "patched"}]`)},
+				},
+			},
+		})
+
+		Expect(cm.Data).To(HaveKeyWithValue("key", "patched"))
+		Expect(deploy.Spec.Template.Spec.Containers[0].Image).To(Equal("nginx:1.21"))
+	})
+
+	It("should return an error if the resource of a strategic merge patch does not exist", func() {
+		_, err := postrenderer.New(&helmv1alpha1.PostRendererConfiguration{
+			Patches: []helmv1alpha1.Patch{
+				{
+					StrategicMerge: &lsv1alpha1.AnyJSON{RawMessage: []byte(`{
+						"apiVersion": "v1",
+						"kind": "ConfigMap",
+						"metadata": {"name": "other", "namespace": "default"},
+						"data": {"key": "patched"}
+					}`)},
+				},
+			},
+		}).Run(bytes.NewBufferString(configMap))
+		Expect(err).To(HaveOccurred())
+	})
+
+})
@@ -101,3 +101,33 @@ func newUninstallConfiguration(conf *helmv1alpha1.HelmDeploymentConfiguration) (
 
 	return uninstallConf, nil
 }
+
+// testConfiguration defines settings for a helm test operation.
+type testConfiguration struct {
+	Enabled bool                 `json:"enabled,omitempty"`
+	Timeout *lsv1alpha1.Duration `json:"timeout,omitempty"`
+}
+
+func newTestConfiguration(conf *helmv1alpha1.HelmDeploymentConfiguration) (*testConfiguration, error) {
+	currOp := "NewTestConfiguration"
+
+	testConf := &testConfiguration{}
+
+	if conf != nil && len(conf.Test) > 0 {
+		rawConf, err := json.Marshal(conf.Test)
+		if err != nil {
+			return nil, lserror.NewWrappedError(err, currOp, "MarshalConfig", err.Error())
+		}
+
+		if err := json.Unmarshal(rawConf, testConf); err != nil {
+			return nil, lserror.NewWrappedError(err, currOp, "UnmarshalConfig", err.Error())
+		}
+	}
+
+	// set defaults
+	if testConf.Timeout == nil {
+		testConf.Timeout = &lsv1alpha1.Duration{Duration: defaultTimeout}
+	}
+
+	return testConf, nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
@@ -19,10 +20,13 @@ import (
 	"helm.sh/helm/v3/pkg/chart"
 
 	"helm.sh/helm/v3/pkg/kube"
+	"helm.sh/helm/v3/pkg/postrender"
 	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/storage"
 	"helm.sh/helm/v3/pkg/storage/driver"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/pointer"
 
 	"helm.sh/helm/v3/pkg/action"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -37,6 +41,7 @@ import (
 	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/deployer/helm/postrenderer"
 	"github.com/gardener/landscaper/pkg/deployer/lib/resourcemanager"
 )
 
@@ -49,12 +54,35 @@ type RealHelmDeployer struct {
 	helmConfig         *helmv1alpha1.HelmDeploymentConfiguration
 	createNamespace    bool
 	targetRestConfig   *rest.Config
+	clientset          kubernetes.Interface
 	apiResourceHandler *resourcemanager.ApiResourceHandler
+	postRenderer       postrender.PostRenderer
+
+	// release is the helm release of the last operation.
+	release *release.Release
+}
+
+const (
+	// testLogTailLines is the number of lines of the logs of a test pod that are reported in the hook status.
+	testLogTailLines = 50
+	// maxTestLogSize is the maximal size of the logs of a test pod that are reported in the hook status.
+	maxTestLogSize = 2048
+)
+
+func init() {
+	// the resources of helm releases are created and updated with the field manager of the helm deployer,
+	// so that the drift detection can distinguish them from changes of other field managers
+	kube.ManagedFieldsManager = helmv1alpha1.FieldManager
 }
 
 func NewRealHelmDeployer(ch *chart.Chart, providerConfig *helmv1alpha1.ProviderConfiguration, targetRestConfig *rest.Config,
 	clientset kubernetes.Interface) *RealHelmDeployer {
 
+	var postRenderer postrender.PostRenderer
+	if providerConfig.PostRenderer != nil {
+		postRenderer = postrenderer.New(providerConfig.PostRenderer)
+	}
+
 	return &RealHelmDeployer{
 		chart:              ch,
 		decoder:            serializer.NewCodecFactory(scheme.Scheme).UniversalDecoder(),
@@ -64,7 +92,9 @@ func NewRealHelmDeployer(ch *chart.Chart, providerConfig *helmv1alpha1.ProviderC
 		helmConfig:         providerConfig.HelmDeploymentConfig,
 		createNamespace:    providerConfig.CreateNamespace,
 		targetRestConfig:   targetRestConfig,
+		clientset:          clientset,
 		apiResourceHandler: resourcemanager.CreateApiResourceHandler(clientset),
+		postRenderer:       postRenderer,
 	}
 }
 
@@ -77,16 +107,132 @@ func (c *RealHelmDeployer) Deploy(ctx context.Context) error {
 
 	_, err := c.getRelease(ctx)
 	if err != nil && c.isReleaseNotFoundErr(err) {
-		_, err = c.installRelease(ctx, values)
+		c.release, err = c.installRelease(ctx, values)
 		return err
 	} else if err != nil {
 		return err
 	} else {
-		_, err = c.upgradeRelease(ctx, values)
+		c.release, err = c.upgradeRelease(ctx, values)
 		return err
 	}
 }
 
+// TestsEnabled returns whether the tests of the chart are executed after the deployment.
+func (c *RealHelmDeployer) TestsEnabled() (bool, error) {
+	testConfig, err := newTestConfiguration(c.helmConfig)
+	if err != nil {
+		return false, err
+	}
+	return testConfig.Enabled, nil
+}
+
+// Test executes the tests of the helm release.
+// A failed test results in an error with the error code ErrorTestFailed.
+func (c *RealHelmDeployer) Test(ctx context.Context) error {
+	currOp := "TestHelmRelease"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	logger.Info(fmt.Sprintf("testing release %s in namespace %s", c.releaseName, c.defaultNamespace))
+
+	actionConfig, err := c.initActionConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	testConfig, err := newTestConfiguration(c.helmConfig)
+	if err != nil {
+		return err
+	}
+
+	test := action.NewReleaseTesting(actionConfig)
+	test.Namespace = c.defaultNamespace
+	test.Timeout = testConfig.Timeout.Duration
+
+	rel, err := test.Run(c.releaseName)
+	if rel != nil {
+		c.release = rel
+	}
+	if err != nil {
+		message := fmt.Sprintf("helm test of release %s failed: %s", c.releaseName, err.Error())
+		logger.Info(message)
+		return lserror.NewWrappedError(err, currOp, "Test", message, lsv1alpha1.ErrorTestFailed)
+	}
+
+	logger.Info(fmt.Sprintf("%s successfully tested in %s", c.releaseName, c.defaultNamespace))
+
+	return nil
+}
+
+// GetHookStatus returns the results of the last execution of the hooks of the release.
+// The status contains the end of the logs of the test pods.
+func (c *RealHelmDeployer) GetHookStatus(ctx context.Context) []helmv1alpha1.HookStatus {
+	if c.release == nil {
+		return nil
+	}
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "GetHookStatus"})
+
+	result := make([]helmv1alpha1.HookStatus, 0)
+	for _, hook := range c.release.Hooks {
+		if hook.LastRun.StartedAt.IsZero() {
+			// the hook has never been executed
+			continue
+		}
+
+		status := helmv1alpha1.HookStatus{
+			Name:  hook.Name,
+			Kind:  hook.Kind,
+			Phase: string(hook.LastRun.Phase),
+		}
+		isTest := false
+		for _, event := range hook.Events {
+			status.Events = append(status.Events, string(event))
+			isTest = isTest || event == release.HookTest
+		}
+		startedAt := metav1.NewTime(hook.LastRun.StartedAt.Time)
+		status.StartedAt = &startedAt
+		if !hook.LastRun.CompletedAt.IsZero() {
+			completedAt := metav1.NewTime(hook.LastRun.CompletedAt.Time)
+			status.CompletedAt = &completedAt
+		}
+
+		if isTest && hook.Kind == "Pod" {
+			logs, err := c.getPodLogs(ctx, hook.Name)
+			if err != nil {
+				logger.Info("unable to read logs of test pod", lc.KeyResource, hook.Name, lc.KeyError, err.Error())
+			} else {
+				status.Logs = logs
+			}
+		}
+
+		result = append(result, status)
+	}
+	return result
+}
+
+// getPodLogs returns the end of the logs of a pod in the release namespace.
+func (c *RealHelmDeployer) getPodLogs(ctx context.Context, name string) (string, error) {
+	raw, err := c.clientset.CoreV1().Pods(c.defaultNamespace).GetLogs(name, &corev1.PodLogOptions{
+		TailLines: pointer.Int64(testLogTailLines),
+	}).DoRaw(ctx)
+	if err != nil {
+		return "", err
+	}
+	return truncateLogs(raw, maxTestLogSize), nil
+}
+
+// truncateLogs returns the end of the given logs with at most maxSize bytes.
+// The logs are truncated at the start of a character, so that no multi-byte UTF-8 character is split.
+func truncateLogs(raw []byte, maxSize int) string {
+	if len(raw) <= maxSize {
+		return string(raw)
+	}
+	start := len(raw) - maxSize
+	for start < len(raw) && !utf8.RuneStart(raw[start]) {
+		start++
+	}
+	return string(raw[start:])
+}
+
 func (c *RealHelmDeployer) Undeploy(ctx context.Context) error {
 	return c.deleteRelease(ctx)
 }
@@ -139,6 +285,7 @@ func (c *RealHelmDeployer) installRelease(ctx context.Context, values map[string
 	install.CreateNamespace = c.createNamespace
 	install.Atomic = installConfig.Atomic
 	install.Timeout = installConfig.Timeout.Duration
+	install.PostRenderer = c.postRenderer
 
 	logger.Info(fmt.Sprintf("installing helm chart release %s", c.releaseName))
 
@@ -176,6 +323,7 @@ func (c *RealHelmDeployer) upgradeRelease(ctx context.Context, values map[string
 	upgrade.MaxHistory = 10
 	upgrade.Atomic = upgradeConfig.Atomic
 	upgrade.Timeout = upgradeConfig.Timeout.Duration
+	upgrade.PostRenderer = c.postRenderer
 
 	logger.Info(fmt.Sprintf("upgrading helm chart release %s", c.releaseName))
 
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package realhelmdeployer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"helm.sh/helm/v3/pkg/release"
+	helmtime "helm.sh/helm/v3/pkg/time"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+var _ = Describe("Real Helm Deployer", func() {
+
+	Context("GetHookStatus", func() {
+		var (
+			ctx      context.Context
+			server   *httptest.Server
+			deployer *RealHelmDeployer
+			start    time.Time
+		)
+
+		BeforeEach(func() {
+			ctx = logging.NewContext(context.Background(), logging.Discard())
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v1/namespaces/test/pods/my-release-test/log" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write([]byte("test succeeded\n"))
+			}))
+			clientset, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+			Expect(err).ToNot(HaveOccurred())
+
+			deployer = &RealHelmDeployer{
+				defaultNamespace: "test",
+				clientset:        clientset,
+			}
+			start = time.Date(2022, time.June, 1, 8, 0, 0, 0, time.UTC)
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should return no status if there is no release", func() {
+			Expect(deployer.GetHookStatus(ctx)).To(BeNil())
+		})
+
+		It("should map the executed hooks of the release and read the logs of test pods", func() {
+			deployer.release = &release.Release{
+				Hooks: []*release.Hook{
+					{
+						Name:   "my-release-migration",
+						Kind:   "Job",
+						Events: []release.HookEvent{release.HookPreInstall, release.HookPreUpgrade},
+						LastRun: release.HookExecution{
+							StartedAt:   helmtime.Time{Time: start},
+							CompletedAt: helmtime.Time{Time: start.Add(time.Minute)},
+							Phase:       release.HookPhaseSucceeded,
+						},
+					},
+					{
+						Name:   "my-release-test",
+						Kind:   "Pod",
+						Events: []release.HookEvent{release.HookTest},
+						LastRun: release.HookExecution{
+							StartedAt: helmtime.Time{Time: start.Add(2 * time.Minute)},
+							Phase:     release.HookPhaseRunning,
+						},
+					},
+					{
+						Name:   "my-release-cleanup",
+						Kind:   "Job",
+						Events: []release.HookEvent{release.HookPreDelete},
+					},
+				},
+			}
+
+			hooks := deployer.GetHookStatus(ctx)
+			Expect(hooks).To(HaveLen(2))
+
+			Expect(hooks[0].Name).To(Equal("my-release-migration"))
+			Expect(hooks[0].Kind).To(Equal("Job"))
+			Expect(hooks[0].Events).To(ConsistOf("pre-install", "pre-upgrade"))
+			Expect(hooks[0].Phase).To(Equal("Succeeded"))
+			Expect(hooks[0].StartedAt.Time).To(BeTemporally("==", start))
+			Expect(hooks[0].CompletedAt.Time).To(BeTemporally("==", start.Add(time.Minute)))
+			Expect(hooks[0].Logs).To(BeEmpty())
+
+			Expect(hooks[1].Name).To(Equal("my-release-test"))
+			Expect(hooks[1].Events).To(ConsistOf("test"))
+			Expect(hooks[1].Phase).To(Equal("Running"))
+			Expect(hooks[1].CompletedAt).To(BeNil())
+			Expect(hooks[1].Logs).To(Equal("test succeeded\n"))
+		})
+
+		It("should report the status of a test pod whose logs cannot be read", func() {
+			deployer.release = &release.Release{
+				Hooks: []*release.Hook{
+					{
+						Name:   "other-test",
+						Kind:   "Pod",
+						Events: []release.HookEvent{release.HookTest},
+						LastRun: release.HookExecution{
+							StartedAt: helmtime.Time{Time: start},
+							Phase:     release.HookPhaseFailed,
+						},
+					},
+				},
+			}
+
+			hooks := deployer.GetHookStatus(ctx)
+			Expect(hooks).To(HaveLen(1))
+			Expect(hooks[0].Phase).To(Equal("Failed"))
+			Expect(hooks[0].Logs).To(BeEmpty())
+		})
+	})
+
+	Context("truncateLogs", func() {
+		It("should not truncate logs that fit into the maximal size", func() {
+			Expect(truncateLogs([]byte("line 1\nline 2\n"), 14)).To(Equal("line 1\nline 2\n"))
+		})
+
+		It("should keep the end of the logs", func() {
+			Expect(truncateLogs([]byte("line 1\nline 2\n"), 7)).To(Equal("line 2\n"))
+		})
+
+		It("should not split multi-byte characters", func() {
+			// "ä" and "€" are encoded with two and three bytes
+			logs := truncateLogs([]byte("aä€b"), 5)
+			Expect(logs).To(Equal("€b"))
+			Expect(utf8.ValidString(logs)).To(BeTrue())
+
+			logs = truncateLogs([]byte(strings.Repeat("€", 100)), 200)
+			Expect(utf8.ValidString(logs)).To(BeTrue())
+			Expect(logs).To(Equal(strings.Repeat("€", 66)))
+		})
+	})
+
+})
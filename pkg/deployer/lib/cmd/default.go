@@ -22,15 +22,20 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/yaml"
 
+	"github.com/gardener/landscaper/apis/config"
 	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 
 	lsinstall "github.com/gardener/landscaper/apis/core/install"
 )
 
 // DefaultOptions defines all default deployer options.
 type DefaultOptions struct {
-	configPath   string
-	LsKubeconfig string
+	configPath         string
+	LsKubeconfig       string
+	metricsBindAddress string
+	tracing            config.TracingConfiguration
 
 	Log     logging.Logger
 	LsMgr   manager.Manager
@@ -49,6 +54,10 @@ func NewDefaultOptions(deployerScheme *runtime.Scheme) *DefaultOptions {
 func (o *DefaultOptions) AddFlags(fs *flag.FlagSet) {
 	fs.StringVar(&o.configPath, "config", "", "Specify the path to the configuration file")
 	fs.StringVar(&o.LsKubeconfig, "landscaper-kubeconfig", "", "Specify the path to the landscaper kubeconfig cluster")
+	fs.StringVar(&o.metricsBindAddress, "metrics-bind-address", "0", "Specify the address the metrics endpoint binds to. The metrics are not served if set to \"0\"")
+	fs.StringVar(&o.tracing.Endpoint, "tracing-endpoint", "", "Specify the address of the OTLP gRPC collector to which traces are exported. Tracing is disabled if not set")
+	fs.BoolVar(&o.tracing.Insecure, "tracing-insecure", false, "Disable the transport security of the connection to the OTLP collector")
+	fs.StringVar(&o.tracing.ServiceName, "tracing-service-name", "landscaper-deployer", "Specify the service name that is reported with the traces")
 	logging.InitFlags(fs)
 
 	flag.CommandLine.AddGoFlagSet(goflag.CommandLine)
@@ -66,7 +75,7 @@ func (o *DefaultOptions) Complete() error {
 
 	opts := manager.Options{
 		LeaderElection:     false,
-		MetricsBindAddress: "0", // disable the metrics serving by default
+		MetricsBindAddress: o.metricsBindAddress,
 		NewClient:          lsutils.NewUncachedClient,
 	}
 
@@ -94,6 +103,8 @@ func (o *DefaultOptions) Complete() error {
 			return fmt.Errorf("unable to build landscaper cluster rest client from %s: %w", o.LsKubeconfig, err)
 		}
 
+		// the metrics are served by the host manager
+		opts.MetricsBindAddress = "0"
 		o.LsMgr, err = ctrl.NewManager(restConfig, opts)
 		if err != nil {
 			return fmt.Errorf("unable to setup manager")
@@ -101,6 +112,7 @@ func (o *DefaultOptions) Complete() error {
 	}
 
 	lsinstall.Install(o.LsMgr.GetScheme())
+	read_write_layer.SetEventRecorder(o.LsMgr.GetEventRecorderFor("Landscaper"))
 
 	return nil
 }
@@ -108,6 +120,20 @@ func (o *DefaultOptions) Complete() error {
 // StartManagers starts the host and landscaper managers.
 func (o *DefaultOptions) StartManagers(ctx context.Context) error {
 	o.Log.Info("Starting the controllers")
+	var tracingConfig *config.TracingConfiguration
+	if len(o.tracing.Endpoint) != 0 {
+		tracingConfig = &o.tracing
+	}
+	shutdownTracing, err := tracing.Setup(ctx, tracingConfig)
+	if err != nil {
+		return fmt.Errorf("unable to setup tracing: %w", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			o.Log.Error(err, "unable to shutdown tracing")
+		}
+	}()
+
 	eg, ctx := errgroup.WithContext(ctx)
 
 	if o.LsMgr != o.HostMgr {
@@ -7,6 +7,7 @@ package lib
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -16,12 +17,14 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrl "sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -34,9 +37,13 @@ import (
 	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
 	"github.com/gardener/landscaper/pkg/deployer/lib/targetresolver"
 	secretresolver "github.com/gardener/landscaper/pkg/deployer/lib/targetresolver/secret"
+	typedresolver "github.com/gardener/landscaper/pkg/deployer/lib/targetresolver/typed"
 	"github.com/gardener/landscaper/pkg/deployer/lib/targetselector"
+	"github.com/gardener/landscaper/pkg/metrics"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/targettypes"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 	"github.com/gardener/landscaper/pkg/version"
 )
 
@@ -60,6 +67,9 @@ type DeployerArgs struct {
 	Type            lsv1alpha1.DeployItemType
 	Deployer        Deployer
 	TargetSelectors []lsv1alpha1.TargetSelector
+	// TargetResolvers are the resolvers for targets with an external secret reference, keyed by their name.
+	// A target selects its resolver by the field spec.externalSecretRef.resolver.
+	TargetResolvers map[string]targetresolver.TargetResolver
 	Options         ctrl.Options
 }
 
@@ -88,6 +98,10 @@ func (args DeployerArgs) Validate() error {
 	return errors.NewAggregate(allErrs)
 }
 
+// registerMetricsOnce ensures that the deployer metrics are registered only once,
+// even if multiple deployers are added in the same process.
+var registerMetricsOnce sync.Once
+
 // Add adds a deployer to the given managers using the given args.
 func Add(log logging.Logger, lsMgr, hostMgr manager.Manager, args DeployerArgs) error {
 	args.Default()
@@ -101,6 +115,10 @@ func Add(log logging.Logger, lsMgr, hostMgr manager.Manager, args DeployerArgs)
 		hostMgr.GetScheme(),
 		args)
 
+	registerMetricsOnce.Do(func() {
+		metrics.RegisterDeployerMetrics(ctrlmetrics.Registry)
+	})
+
 	log = log.Reconciles("", "DeployItem").WithValues(lc.KeyDeployItemType, string(args.Type))
 
 	return builder.ControllerManagedBy(lsMgr).
@@ -117,12 +135,14 @@ type controller struct {
 	// deployerType defines the deployer type the deployer is responsible for.
 	deployerType    lsv1alpha1.DeployItemType
 	targetSelectors []lsv1alpha1.TargetSelector
+	targetResolvers map[string]targetresolver.TargetResolver
 
 	lsClient        client.Client
 	lsScheme        *runtime.Scheme
 	lsEventRecorder record.EventRecorder
 	hostClient      client.Client
 	hostScheme      *runtime.Scheme
+	clock           clock.PassiveClock
 }
 
 // NewController creates a new generic deployitem controller.
@@ -141,11 +161,13 @@ func NewController(lsClient client.Client,
 			Version:  args.Version,
 		},
 		targetSelectors: args.TargetSelectors,
+		targetResolvers: args.TargetResolvers,
 		lsClient:        lsClient,
 		lsScheme:        lsScheme,
 		lsEventRecorder: lsEventRecorder,
 		hostClient:      hostClient,
 		hostScheme:      hostScheme,
+		clock:           clock.RealClock{},
 	}
 }
 
@@ -199,7 +221,7 @@ func (c *controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 
 	if di.Status.GetJobID() == di.Status.JobIDFinished {
 		logger.Info("deploy item not reconciled because no new job ID")
-		return reconcile.Result{}, nil
+		return c.detectDrift(ctx, lsCtx, di, rt)
 	}
 
 	if di.Status.DeployItemPhase == lsv1alpha1.DeployItemPhaseSucceeded ||
@@ -238,17 +260,21 @@ func (c *controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 
 	// Deployitem has been initialized, proceed with reconcile/delete
 
+	ctx, span := tracing.StartJobSpan(ctx, "DeployItem", di.Status.GetJobID(), di,
+		tracing.KeyKind.String("DeployItem"), tracing.KeyDeployItemType.String(string(c.deployerType)))
+	start := time.Now()
 	var lsError lserrors.LsError
 	if di.DeletionTimestamp.IsZero() {
 		lsError = c.reconcile(ctx, lsCtx, di, rt)
-		err = c.handleReconcileResult(ctx, lsError, old, di)
-		return c.buildResult(di.Status.DeployItemPhase, err)
-
+		metrics.ObserveDeployerOperation(c.deployerType, metrics.ReconcileOperation, start, lsError)
 	} else {
 		lsError = c.delete(ctx, lsCtx, di, rt)
-		err = c.handleReconcileResult(ctx, lsError, old, di)
-		return c.buildResult(di.Status.DeployItemPhase, err)
+		metrics.ObserveDeployerOperation(c.deployerType, metrics.DeleteOperation, start, lsError)
 	}
+	tracing.EndSpan(span, lsError)
+
+	err = c.handleReconcileResult(ctx, lsError, old, di)
+	return c.buildResult(di.Status.DeployItemPhase, err)
 }
 
 func (c *controller) handleReconcileResult(ctx context.Context, err lserrors.LsError, oldDeployItem, deployItem *lsv1alpha1.DeployItem) error {
@@ -281,15 +307,31 @@ func (c *controller) checkTargetResponsibilityAndResolve(ctx context.Context, lo
 	// resolve Target reference, if any
 	var rt *lsv1alpha1.ResolvedTarget
 	if target != nil {
-		if target.Spec.SecretRef != nil {
-			sr := secretresolver.New(c.lsClient)
+		if target.Spec.ExternalSecretRef != nil {
+			resolver, ok := c.targetResolvers[target.Spec.ExternalSecretRef.Resolver]
+			if !ok {
+				return nil, false, fmt.Errorf("target resolver %q of target '%s/%s' is not registered at the deployer",
+					target.Spec.ExternalSecretRef.Resolver, target.Namespace, target.Name)
+			}
+			er := typedresolver.New(resolver, targettypes.DefaultRegistry())
+			rt, err = er.Resolve(ctx, target)
+			if err != nil {
+				return nil, false, fmt.Errorf("error resolving external secret reference (%s:%s) in target '%s/%s': %w",
+					target.Spec.ExternalSecretRef.Resolver, target.Spec.ExternalSecretRef.Path, target.Namespace, target.Name, err)
+			}
+		} else if target.Spec.SecretRef != nil {
+			sr := typedresolver.New(secretresolver.New(c.lsClient), targettypes.DefaultRegistry())
 			rt, err = sr.Resolve(ctx, target)
 			if err != nil {
 				return nil, false, fmt.Errorf("error resolving secret reference (%s/%s#%s) in target '%s/%s': %w",
 					target.Namespace, target.Spec.SecretRef.Name, target.Spec.SecretRef.Key, target.Namespace, target.Name, err)
 			}
 		} else {
-			rt = targetresolver.NewResolvedTarget(target)
+			ir := typedresolver.New(targetresolver.InlineResolver{}, targettypes.DefaultRegistry())
+			rt, err = ir.Resolve(ctx, target)
+			if err != nil {
+				return nil, false, err
+			}
 		}
 	}
 
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+const (
+	// DriftDetectedReason is the condition reason if drifted resources have been detected.
+	DriftDetectedReason = "DriftDetected"
+	// DriftCorrectedReason is the condition reason if drifted resources have been detected and corrected.
+	DriftCorrectedReason = "DriftCorrected"
+	// NoDriftReason is the condition reason if all resources match their last applied state.
+	NoDriftReason = "NoDrift"
+)
+
+// DriftDetector is an optional interface of a Deployer.
+// It is implemented by deployers that are able to detect changes of their deployed resources
+// that were made directly in the target cluster.
+type DriftDetector interface {
+	// DriftDetectionSchedule returns the interval of the drift detection and the time of the last drift detection
+	// of the deploy item. An interval of zero means that drift detection is not configured for the deploy item.
+	DriftDetectionSchedule(ctx context.Context, di *lsv1alpha1.DeployItem) (time.Duration, *metav1.Time, error)
+	// DetectDrift compares the deployed resources with their last applied state and records the result
+	// in the status of the deploy item. Depending on its configuration, detected drift is corrected.
+	DetectDrift(ctx context.Context, lsContext *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, target *lsv1alpha1.ResolvedTarget) error
+}
+
+// detectDrift runs the drift detection for a deploy item that has no new job,
+// if the deployer supports it and the drift detection is due.
+func (c *controller) detectDrift(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem,
+	rt *lsv1alpha1.ResolvedTarget) (reconcile.Result, error) {
+
+	detector, ok := c.deployer.(DriftDetector)
+	if !ok || !di.DeletionTimestamp.IsZero() || di.Status.DeployItemPhase != lsv1alpha1.DeployItemPhaseSucceeded {
+		return reconcile.Result{}, nil
+	}
+
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	interval, lastCheck, err := detector.DriftDetectionSchedule(ctx, di)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if interval == 0 {
+		return reconcile.Result{}, nil
+	}
+
+	if remaining := timeUntilDriftDetection(c.clock.Now(), interval, lastCheck, di.Status.LastReconcileTime); remaining > 0 {
+		return reconcile.Result{RequeueAfter: remaining}, nil
+	}
+
+	logger.Info("detecting drift of deployed resources")
+	if err := detector.DetectDrift(ctx, lsCtx, di, rt); err != nil {
+		logger.Error(err, "drift detection failed")
+	}
+	return reconcile.Result{RequeueAfter: interval}, nil
+}
+
+// timeUntilDriftDetection returns the duration from now until the next drift detection is due.
+// The drift detection is due one interval after the last drift detection or the last reconcile, whichever is later.
+func timeUntilDriftDetection(now time.Time, interval time.Duration, lastCheck, lastReconcile *metav1.Time) time.Duration {
+	last := lastCheck
+	if last == nil || (lastReconcile != nil && lastReconcile.After(last.Time)) {
+		last = lastReconcile
+	}
+	if last == nil {
+		return 0
+	}
+	return last.Add(interval).Sub(now)
+}
+
+// UpdateDriftCondition sets the drift condition of a deploy item according to the given drift status.
+func UpdateDriftCondition(di *lsv1alpha1.DeployItem, status *dd.DriftStatus, corrected bool) {
+	var (
+		condStatus = lsv1alpha1.ConditionFalse
+		reason     = NoDriftReason
+		message    = "All resources match their last applied state"
+	)
+	if len(status.DriftedResources) != 0 {
+		resources := make([]string, len(status.DriftedResources))
+		for i, res := range status.DriftedResources {
+			resources[i] = fmt.Sprintf("%s %s (%s)", res.Resource.Kind, driftedResourceName(res), res.Reason)
+		}
+		if corrected {
+			reason = DriftCorrectedReason
+			message = fmt.Sprintf("Corrected drifted resources: %s", strings.Join(resources, ", "))
+		} else {
+			condStatus = lsv1alpha1.ConditionTrue
+			reason = DriftDetectedReason
+			message = fmt.Sprintf("Resources differ from their last applied state: %s", strings.Join(resources, ", "))
+		}
+	}
+	di.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(di.Status.Conditions,
+		lsv1alpha1.DeployItemDriftCondition, condStatus, reason, message)
+}
+
+func driftedResourceName(res dd.DriftedResource) string {
+	if len(res.Resource.Namespace) == 0 {
+		return res.Resource.Name
+	}
+	return res.Resource.Namespace + "/" + res.Resource.Name
+}
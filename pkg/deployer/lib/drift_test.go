@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock/testing"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
+)
+
+// fakeDriftDetector is a deployer that counts its drift detections.
+type fakeDriftDetector struct {
+	interval   time.Duration
+	lastCheck  *metav1.Time
+	detections int
+}
+
+func (d *fakeDriftDetector) Reconcile(_ context.Context, _ *lsv1alpha1.Context, _ *lsv1alpha1.DeployItem, _ *lsv1alpha1.ResolvedTarget) error {
+	return nil
+}
+
+func (d *fakeDriftDetector) Delete(_ context.Context, _ *lsv1alpha1.Context, _ *lsv1alpha1.DeployItem, _ *lsv1alpha1.ResolvedTarget) error {
+	return nil
+}
+
+func (d *fakeDriftDetector) Abort(_ context.Context, _ *lsv1alpha1.Context, _ *lsv1alpha1.DeployItem, _ *lsv1alpha1.ResolvedTarget) error {
+	return nil
+}
+
+func (d *fakeDriftDetector) ExtensionHooks() extension.ReconcileExtensionHooks {
+	return nil
+}
+
+func (d *fakeDriftDetector) DriftDetectionSchedule(_ context.Context, _ *lsv1alpha1.DeployItem) (time.Duration, *metav1.Time, error) {
+	return d.interval, d.lastCheck, nil
+}
+
+func (d *fakeDriftDetector) DetectDrift(_ context.Context, _ *lsv1alpha1.Context, _ *lsv1alpha1.DeployItem, _ *lsv1alpha1.ResolvedTarget) error {
+	d.detections++
+	return nil
+}
+
+var _ = Describe("Drift Detection", func() {
+
+	Context("timeUntilDriftDetection", func() {
+		now := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+
+		It("should be due immediately if neither a drift detection nor a reconcile has happened", func() {
+			Expect(timeUntilDriftDetection(now, 10*time.Minute, nil, nil)).To(Equal(time.Duration(0)))
+		})
+
+		It("should be due one interval after the last drift detection", func() {
+			lastCheck := metav1.NewTime(now.Add(-4 * time.Minute))
+			lastReconcile := metav1.NewTime(now.Add(-time.Hour))
+			Expect(timeUntilDriftDetection(now, 10*time.Minute, &lastCheck, &lastReconcile)).To(Equal(6 * time.Minute))
+		})
+
+		It("should be due one interval after the last reconcile if it is later than the last drift detection", func() {
+			lastCheck := metav1.NewTime(now.Add(-time.Hour))
+			lastReconcile := metav1.NewTime(now.Add(-2 * time.Minute))
+			Expect(timeUntilDriftDetection(now, 10*time.Minute, &lastCheck, &lastReconcile)).To(Equal(8 * time.Minute))
+			Expect(timeUntilDriftDetection(now, 10*time.Minute, nil, &lastReconcile)).To(Equal(8 * time.Minute))
+		})
+
+		It("should be overdue if the interval has passed", func() {
+			lastCheck := metav1.NewTime(now.Add(-time.Hour))
+			Expect(timeUntilDriftDetection(now, 10*time.Minute, &lastCheck, nil)).To(Equal(-50 * time.Minute))
+		})
+	})
+
+	Context("detectDrift", func() {
+		var (
+			ctx      context.Context
+			detector *fakeDriftDetector
+			c        *controller
+			di       *lsv1alpha1.DeployItem
+			now      time.Time
+		)
+
+		BeforeEach(func() {
+			ctx = logging.NewContext(context.Background(), logging.Discard())
+			detector = &fakeDriftDetector{interval: 10 * time.Minute}
+			now = time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+			c = &controller{deployer: detector, clock: testing.NewFakePassiveClock(now)}
+			di = &lsv1alpha1.DeployItem{}
+			di.Status.DeployItemPhase = lsv1alpha1.DeployItemPhaseSucceeded
+		})
+
+		It("should detect drift if it is due and requeue after the interval", func() {
+			result, err := c.detectDrift(ctx, nil, di, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(detector.detections).To(Equal(1))
+			Expect(result.RequeueAfter).To(Equal(10 * time.Minute))
+		})
+
+		It("should requeue until the drift detection is due", func() {
+			lastCheck := metav1.NewTime(now.Add(-4 * time.Minute))
+			detector.lastCheck = &lastCheck
+
+			result, err := c.detectDrift(ctx, nil, di, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(detector.detections).To(Equal(0))
+			Expect(result.RequeueAfter).To(Equal(6 * time.Minute))
+		})
+
+		It("should not detect drift if no interval is configured", func() {
+			detector.interval = 0
+
+			result, err := c.detectDrift(ctx, nil, di, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(detector.detections).To(Equal(0))
+			Expect(result.RequeueAfter).To(Equal(time.Duration(0)))
+		})
+
+		It("should not detect drift of deploy items that have not succeeded or are deleted", func() {
+			di.Status.DeployItemPhase = lsv1alpha1.DeployItemPhaseFailed
+			result, err := c.detectDrift(ctx, nil, di, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(time.Duration(0)))
+
+			di.Status.DeployItemPhase = lsv1alpha1.DeployItemPhaseSucceeded
+			now := metav1.Now()
+			di.DeletionTimestamp = &now
+			result, err = c.detectDrift(ctx, nil, di, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(time.Duration(0)))
+			Expect(detector.detections).To(Equal(0))
+		})
+	})
+
+})
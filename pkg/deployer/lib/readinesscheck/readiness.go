@@ -19,6 +19,7 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/deployer/lib"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 const (
@@ -36,7 +37,10 @@ type checkObjectFunc func(*unstructured.Unstructured) error
 // WaitForObjectsReady waits for objects to be heatlhy and
 // returns an error if all the objects are not ready after the timeout.
 func WaitForObjectsReady(ctx context.Context, timeout time.Duration, kubeClient client.Client,
-	objects []*unstructured.Unstructured, fn checkObjectFunc, interruptionChecker *lib.InterruptionChecker) error {
+	objects []*unstructured.Unstructured, fn checkObjectFunc, interruptionChecker *lib.InterruptionChecker) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "CheckReadiness")
+	defer func() { tracing.EndSpan(span, err) }()
+
 	var (
 		wg  sync.WaitGroup
 		try int32 = 1
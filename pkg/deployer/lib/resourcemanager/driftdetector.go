@@ -0,0 +1,453 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcemanager
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+)
+
+// DriftDetectorOptions describes options for the drift detector.
+type DriftDetectorOptions struct {
+	Decoder          runtime.Decoder
+	KubeClient       client.Client
+	Clientset        kubernetes.Interface
+	DefaultNamespace string
+
+	DeployItemName string
+	// Manifests are the manifests the managed resources have been applied from.
+	Manifests []managedresource.Manifest
+	// ManagedResources are the resources that have been applied by the deployer.
+	ManagedResources managedresource.ManagedResourceStatusList
+	// Labels defines additional labels that are automatically injected into all resources.
+	Labels map[string]string
+
+	// UpdateStrategy is the strategy that is used to re-apply drifted resources.
+	// Defaults to mergeOverwrite.
+	UpdateStrategy manifestv1alpha2.UpdateStrategy
+	// FieldManager is the name of the field manager that is used to re-apply drifted resources.
+	// Defaults to the field manager of the manifest deployer.
+	FieldManager string
+	// ForceConflicts defines whether the ownership of conflicting fields is taken over on a server-side apply.
+	ForceConflicts bool
+	// DriftFieldManagers are the field managers whose changes are considered as drift in addition to the
+	// changes of the field manager of the deployer.
+	DriftFieldManagers []string
+}
+
+// DriftDetector compares the managed resources in the target cluster with the manifests they have been applied from.
+// A resource has drifted if it has been deleted or if one of the fields defined in its manifest has been changed.
+// Fields that are not defined in the manifest, like defaulted fields or the status, are not considered.
+type DriftDetector struct {
+	opts DriftDetectorOptions
+
+	// driftedManifests contains the manifests of the drifted resources of the last detection.
+	driftedManifests []managedresource.Manifest
+	driftedResources managedresource.ManagedResourceStatusList
+}
+
+// NewDriftDetector creates a new drift detector.
+func NewDriftDetector(opts DriftDetectorOptions) *DriftDetector {
+	return &DriftDetector{
+		opts: opts,
+	}
+}
+
+// Detect compares the managed resources with their manifests and returns all drifted resources.
+func (d *DriftDetector) Detect(ctx context.Context) ([]dd.DriftedResource, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil, lc.KeyMethod, "DetectDrift")
+
+	d.driftedManifests = nil
+	d.driftedResources = nil
+	drifted := make([]dd.DriftedResource, 0)
+	for _, manifest := range d.opts.Manifests {
+		if manifest.Policy == managedresource.IgnorePolicy || manifest.Manifest == nil {
+			continue
+		}
+
+		desired := &unstructured.Unstructured{}
+		if _, _, err := d.opts.Decoder.Decode(manifest.Manifest.Raw, nil, desired); err != nil {
+			return nil, fmt.Errorf("error while decoding manifest: %w", err)
+		}
+
+		mr := d.findManagedResource(desired)
+		if mr == nil {
+			// the resource has not been applied by the deployer, e.g. because it was already managed by someone else.
+			continue
+		}
+
+		live := kutil.ObjectFromCoreObjectReference(&mr.Resource)
+		if err := d.opts.KubeClient.Get(ctx, kutil.ObjectKey(mr.Resource.Name, mr.Resource.Namespace), live); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("unable to get object %s %s: %w", mr.Resource.Kind, mr.Resource.Name, err)
+			}
+			logger.Debug("Managed resource has been deleted", lc.KeyResource, kutil.ObjectKey(mr.Resource.Name, mr.Resource.Namespace).String())
+			d.addDrift(manifest, *mr)
+			drifted = append(drifted, dd.DriftedResource{
+				Resource: mr.Resource,
+				Reason:   dd.DriftReasonDeleted,
+			})
+			continue
+		}
+
+		if mr.Policy == managedresource.ImmutablePolicy {
+			// immutable resources are never updated, so only their deletion is considered as drift.
+			continue
+		}
+		if mr.Policy == managedresource.FallbackPolicy && !kutil.HasLabelWithValue(live, manifestv1alpha2.ManagedDeployItemLabel, d.opts.DeployItemName) {
+			// the resource is managed by another deployer
+			continue
+		}
+
+		fields := DriftedFields(desired.Object, live.Object, d.driftFieldManagers())
+		if len(fields) == 0 {
+			continue
+		}
+		logger.Debug("Managed resource has been modified", lc.KeyResource, kutil.ObjectKey(mr.Resource.Name, mr.Resource.Namespace).String())
+		d.addDrift(manifest, *mr)
+		drifted = append(drifted, dd.DriftedResource{
+			Resource: mr.Resource,
+			Reason:   dd.DriftReasonModified,
+			Fields:   fields,
+		})
+	}
+	return drifted, nil
+}
+
+// Correct re-applies the drifted resources of the last detection with the configured update strategy,
+// field manager and conflict handling, i.e. in the same way they have been applied by the deployer.
+// Without an update strategy, the manifests are merged into the current state of the resources,
+// so that fields that are not defined in the manifests are kept.
+func (d *DriftDetector) Correct(ctx context.Context) error {
+	if len(d.driftedManifests) == 0 {
+		return nil
+	}
+	updateStrategy := d.opts.UpdateStrategy
+	if len(updateStrategy) == 0 {
+		updateStrategy = manifestv1alpha2.UpdateStrategyMergeOverwrite
+	}
+	applier := NewManifestApplier(ManifestApplierOptions{
+		Decoder:          d.opts.Decoder,
+		KubeClient:       d.opts.KubeClient,
+		Clientset:        d.opts.Clientset,
+		DefaultNamespace: d.opts.DefaultNamespace,
+		DeployItemName:   d.opts.DeployItemName,
+		UpdateStrategy:   updateStrategy,
+		FieldManager:     d.opts.FieldManager,
+		ForceConflicts:   d.opts.ForceConflicts,
+		Manifests:        d.driftedManifests,
+		ManagedResources: d.driftedResources,
+		Labels:           d.opts.Labels,
+	})
+	return applier.Apply(ctx)
+}
+
+// driftFieldManagers returns the field managers whose changes are considered as drift.
+func (d *DriftDetector) driftFieldManagers() sets.String {
+	fieldManager := d.opts.FieldManager
+	if len(fieldManager) == 0 {
+		fieldManager = manifestv1alpha2.FieldManager
+	}
+	return sets.NewString(d.opts.DriftFieldManagers...).Insert(fieldManager)
+}
+
+func (d *DriftDetector) addDrift(manifest managedresource.Manifest, mr managedresource.ManagedResourceStatus) {
+	d.driftedManifests = append(d.driftedManifests, manifest)
+	d.driftedResources = append(d.driftedResources, mr)
+}
+
+// findManagedResource returns the managed resource that has been applied from the given object.
+// Objects without a namespace match managed resources in the default namespace or cluster-scoped resources.
+func (d *DriftDetector) findManagedResource(obj *unstructured.Unstructured) *managedresource.ManagedResourceStatus {
+	gk := obj.GroupVersionKind().GroupKind()
+	namespaces := []string{obj.GetNamespace()}
+	if len(obj.GetNamespace()) == 0 && len(d.opts.DefaultNamespace) != 0 {
+		namespaces = []string{d.opts.DefaultNamespace, ""}
+	}
+	for _, namespace := range namespaces {
+		for i, mr := range d.opts.ManagedResources {
+			gv, err := schema.ParseGroupVersion(mr.Resource.APIVersion)
+			if err != nil {
+				continue
+			}
+			if gv.WithKind(mr.Resource.Kind).GroupKind() == gk &&
+				mr.Resource.Name == obj.GetName() &&
+				mr.Resource.Namespace == namespace {
+				return &d.opts.ManagedResources[i]
+			}
+		}
+	}
+	return nil
+}
+
+// DriftedFields returns the paths of all fields of the desired object that are missing or differ in the live object.
+// Only labels and annotations are compared of the metadata, and the status is ignored completely.
+// Fields of the live object that are only managed by other field managers than the given ones are ignored,
+// e.g. the replicas of a deployment that are managed by a horizontal pod autoscaler.
+func DriftedFields(desired, live map[string]interface{}, fieldManagers sets.String) []string {
+	desired = runtime.DeepCopyJSON(desired)
+	delete(desired, "status")
+	if metadata, ok := desired["metadata"].(map[string]interface{}); ok {
+		desired["metadata"] = map[string]interface{}{
+			"labels":      metadata["labels"],
+			"annotations": metadata["annotations"],
+		}
+	}
+	if desired["kind"] == "Secret" {
+		normalizeSecretStringData(desired)
+	}
+
+	fields := removeForeignFields(diffFields("", desired, live), live, fieldManagers)
+	sort.Strings(fields)
+	return fields
+}
+
+// removeForeignFields removes all fields that are managed by other field managers than the given ones,
+// but not by one of the given field managers.
+func removeForeignFields(fields []string, live map[string]interface{}, fieldManagers sets.String) []string {
+	own, foreign := managedFieldPaths(live, fieldManagers)
+	if foreign.Len() == 0 {
+		return fields
+	}
+	res := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if isManagedField(foreign, field) && !isManagedField(own, field) {
+			continue
+		}
+		res = append(res, field)
+	}
+	return res
+}
+
+// managedFieldPaths returns the paths of the fields of the live object that are managed by the given field managers
+// and the paths of the fields that are managed by other field managers.
+func managedFieldPaths(live map[string]interface{}, fieldManagers sets.String) (own, foreign sets.String) {
+	own, foreign = sets.NewString(), sets.NewString()
+	metadata, _ := live["metadata"].(map[string]interface{})
+	entries, _ := metadata["managedFields"].([]interface{})
+	for _, entry := range entries {
+		managedFields, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		fieldsV1, ok := managedFields["fieldsV1"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		manager, _ := managedFields["manager"].(string)
+		if fieldManagers.Has(manager) {
+			collectManagedFieldPaths("", fieldsV1, live, own)
+		} else {
+			collectManagedFieldPaths("", fieldsV1, live, foreign)
+		}
+	}
+	return own, foreign
+}
+
+// collectManagedFieldPaths adds the paths of all fields of the given field set in the FieldsV1 format to paths.
+// List elements are identified by their index in the live object.
+func collectManagedFieldPaths(path string, fields map[string]interface{}, live interface{}, paths sets.String) {
+	if len(fields) == 0 {
+		if len(path) != 0 {
+			paths.Insert(path)
+		}
+		return
+	}
+	for key, value := range fields {
+		subFields, _ := value.(map[string]interface{})
+		if strings.HasPrefix(key, "f:") {
+			name := strings.TrimPrefix(key, "f:")
+			liveMap, _ := live.(map[string]interface{})
+			collectManagedFieldPaths(joinFieldPath(path, name), subFields, liveMap[name], paths)
+			continue
+		}
+		liveList, _ := live.([]interface{})
+		for i, elem := range liveList {
+			if matchesListElement(key, i, elem) {
+				collectManagedFieldPaths(fmt.Sprintf("%s[%d]", path, i), subFields, elem, paths)
+			}
+		}
+	}
+}
+
+// matchesListElement checks if the given key of a field set in the FieldsV1 format identifies the given list element.
+// Elements are identified by their index ("i:"), by the values of their key fields ("k:") or by their value ("v:").
+func matchesListElement(key string, index int, elem interface{}) bool {
+	switch {
+	case strings.HasPrefix(key, "i:"):
+		i, err := strconv.Atoi(strings.TrimPrefix(key, "i:"))
+		return err == nil && i == index
+	case strings.HasPrefix(key, "k:"):
+		keyFields := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(key, "k:")), &keyFields); err != nil {
+			return false
+		}
+		elemMap, ok := elem.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for name, value := range keyFields {
+			if !scalarEqual(value, elemMap[name]) {
+				return false
+			}
+		}
+		return true
+	case strings.HasPrefix(key, "v:"):
+		var value interface{}
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(key, "v:")), &value); err != nil {
+			return false
+		}
+		return scalarEqual(value, elem)
+	default:
+		return false
+	}
+}
+
+// isManagedField checks if the field with the given path or one of its parents is contained in the given paths.
+func isManagedField(paths sets.String, field string) bool {
+	for i := range field {
+		if (field[i] == '.' || field[i] == '[') && paths.Has(field[:i]) {
+			return true
+		}
+	}
+	return paths.Has(field)
+}
+
+// normalizeSecretStringData moves the write-only stringData of a secret into its data field.
+func normalizeSecretStringData(secret map[string]interface{}) {
+	stringData, ok := secret["stringData"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	data, ok := secret["data"].(map[string]interface{})
+	if !ok {
+		data = map[string]interface{}{}
+	}
+	for key, value := range stringData {
+		if s, ok := value.(string); ok {
+			data[key] = base64.StdEncoding.EncodeToString([]byte(s))
+		}
+	}
+	secret["data"] = data
+	delete(secret, "stringData")
+}
+
+func diffFields(path string, desired, live interface{}) []string {
+	switch desiredValue := desired.(type) {
+	case map[string]interface{}:
+		liveValue, ok := live.(map[string]interface{})
+		if !ok {
+			if len(desiredValue) == 0 && live == nil {
+				return nil
+			}
+			return []string{path}
+		}
+		var fields []string
+		for key, value := range desiredValue {
+			fields = append(fields, diffFields(joinFieldPath(path, key), value, liveValue[key])...)
+		}
+		return fields
+	case []interface{}:
+		liveValue, ok := live.([]interface{})
+		if !ok {
+			if len(desiredValue) == 0 && live == nil {
+				return nil
+			}
+			return []string{path}
+		}
+		if len(desiredValue) != len(liveValue) {
+			return []string{path}
+		}
+		var fields []string
+		for i := range desiredValue {
+			fields = append(fields, diffFields(fmt.Sprintf("%s[%d]", path, i), desiredValue[i], liveValue[i])...)
+		}
+		return fields
+	case nil:
+		// unset fields are removed by the api server
+		return nil
+	default:
+		if !scalarEqual(desired, live) {
+			return []string{path}
+		}
+		return nil
+	}
+}
+
+// scalarEqual compares two scalar values.
+// Numbers are compared by their value and strings that represent the same quantity are considered as equal,
+// as the api server normalizes both.
+func scalarEqual(a, b interface{}) bool {
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+	if af, ok := toFloat(a); ok {
+		bf, ok := toFloat(b)
+		return ok && af == bf
+	}
+	as, ok := a.(string)
+	if !ok {
+		return false
+	}
+	bs, ok := b.(string)
+	if !ok {
+		return false
+	}
+	aq, err := resource.ParseQuantity(as)
+	if err != nil {
+		return false
+	}
+	bq, err := resource.ParseQuantity(bs)
+	if err != nil {
+		return false
+	}
+	return aq.Cmp(bq) == 0
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
+
+func joinFieldPath(path, key string) string {
+	if len(path) == 0 {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,341 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcemanager_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/deployer/lib/resourcemanager"
+	"github.com/gardener/landscaper/test/utils/envtest"
+)
+
+var _ = Describe("DriftDetector", func() {
+
+	var (
+		state *envtest.State
+		ctx   context.Context
+	)
+
+	BeforeEach(func() {
+		var err error
+		ctx = logging.NewContextWithDiscard(context.TODO())
+		state, err = testenv.InitState(ctx)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(state.CleanupState(ctx))
+	})
+
+	applyConfigMap := func() (*corev1.ConfigMap, resourcemanager.DriftDetectorOptions) {
+		cm := &corev1.ConfigMap{}
+		cm.Name = "my-cm"
+		cm.Namespace = state.Namespace
+		cm.Data = map[string]string{
+			"key": "val",
+		}
+		cmRaw, err := kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+		manifests := []managedresource.Manifest{
+			{
+				Policy:   managedresource.ManagePolicy,
+				Manifest: cmRaw,
+			},
+		}
+
+		managedResources, err := resourcemanager.ApplyManifests(ctx, resourcemanager.ManifestApplierOptions{
+			Decoder:          api.NewDecoder(scheme.Scheme),
+			KubeClient:       testenv.Client,
+			Clientset:        clientset,
+			DeployItemName:   "my-di",
+			DeleteTimeout:    10 * time.Second,
+			UpdateStrategy:   manifestv1alpha2.UpdateStrategyUpdate,
+			Manifests:        manifests,
+			ManagedResources: managedresource.ManagedResourceStatusList{},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		return cm, resourcemanager.DriftDetectorOptions{
+			Decoder:          api.NewDecoder(scheme.Scheme),
+			KubeClient:       testenv.Client,
+			Clientset:        clientset,
+			DeployItemName:   "my-di",
+			Manifests:        manifests,
+			ManagedResources: managedResources,
+			// the resources are modified with kubectl in the tests
+			DriftFieldManagers: []string{"kubectl-edit"},
+		}
+	}
+
+	It("should not report drift of unchanged resources", func() {
+		cm, opts := applyConfigMap()
+
+		// fields that are not part of the manifest are not considered as drift
+		res := &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+		res.Annotations = map[string]string{"foo": "bar"}
+		Expect(testenv.Client.Update(ctx, res, client.FieldOwner("kubectl-edit"))).To(Succeed())
+
+		drifted, err := resourcemanager.NewDriftDetector(opts).Detect(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(drifted).To(BeEmpty())
+	})
+
+	It("should detect and correct a modified resource", func() {
+		cm, opts := applyConfigMap()
+
+		res := &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+		res.Data["key"] = "changed"
+		res.Annotations = map[string]string{"foo": "bar"}
+		Expect(testenv.Client.Update(ctx, res, client.FieldOwner("kubectl-edit"))).To(Succeed())
+
+		detector := resourcemanager.NewDriftDetector(opts)
+		drifted, err := detector.Detect(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(drifted).To(HaveLen(1))
+		Expect(drifted[0].Reason).To(Equal(dd.DriftReasonModified))
+		Expect(drifted[0].Resource.Name).To(Equal("my-cm"))
+		Expect(drifted[0].Fields).To(ConsistOf("data.key"))
+
+		Expect(detector.Correct(ctx)).To(Succeed())
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+		Expect(res.Data).To(HaveKeyWithValue("key", "val"))
+		Expect(res.Annotations).To(HaveKeyWithValue("foo", "bar"))
+	})
+
+	It("should correct a modified resource with the configured update strategy and field manager", func() {
+		cm, opts := applyConfigMap()
+		opts.UpdateStrategy = manifestv1alpha2.UpdateStrategyServerSideApply
+		opts.FieldManager = "test-manager"
+		opts.ForceConflicts = true
+
+		res := &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+		res.Data["key"] = "changed"
+		Expect(testenv.Client.Update(ctx, res, client.FieldOwner("kubectl-edit"))).To(Succeed())
+
+		detector := resourcemanager.NewDriftDetector(opts)
+		drifted, err := detector.Detect(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(drifted).To(HaveLen(1))
+
+		Expect(detector.Correct(ctx)).To(Succeed())
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+		Expect(res.Data).To(HaveKeyWithValue("key", "val"))
+		Expect(res.ManagedFields).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+			"Manager":   Equal("test-manager"),
+			"Operation": Equal(metav1.ManagedFieldsOperationApply),
+		})))
+	})
+
+	It("should detect and correct a deleted resource", func() {
+		cm, opts := applyConfigMap()
+		Expect(testenv.Client.Delete(ctx, cm)).To(Succeed())
+
+		detector := resourcemanager.NewDriftDetector(opts)
+		drifted, err := detector.Detect(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(drifted).To(HaveLen(1))
+		Expect(drifted[0].Reason).To(Equal(dd.DriftReasonDeleted))
+
+		Expect(detector.Correct(ctx)).To(Succeed())
+		res := &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+		Expect(res.Data).To(HaveKeyWithValue("key", "val"))
+	})
+
+	Context("DriftedFields", func() {
+		It("should ignore the status and unmanaged metadata", func() {
+			desired := map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]interface{}{
+					"name":      "test",
+					"namespace": "default",
+					"labels":    map[string]interface{}{"app": "test"},
+				},
+				"spec": map[string]interface{}{
+					"replicas": int64(1),
+				},
+				"status": map[string]interface{}{
+					"replicas": int64(3),
+				},
+			}
+			live := map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]interface{}{
+					"name":            "test",
+					"namespace":       "default",
+					"resourceVersion": "123",
+					"labels":          map[string]interface{}{"app": "test", "other": "label"},
+				},
+				"spec": map[string]interface{}{
+					"replicas": float64(1),
+					"paused":   false,
+				},
+			}
+			Expect(resourcemanager.DriftedFields(desired, live, sets.NewString(manifestv1alpha2.FieldManager))).To(BeEmpty())
+		})
+
+		It("should report changed, missing and resized fields", func() {
+			desired := map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{"app": "test"},
+				},
+				"spec": map[string]interface{}{
+					"replicas": int64(1),
+					"ports":    []interface{}{int64(80), int64(443)},
+					"selector": map[string]interface{}{"app": "test"},
+				},
+			}
+			live := map[string]interface{}{
+				"metadata": map[string]interface{}{},
+				"spec": map[string]interface{}{
+					"replicas": int64(2),
+					"ports":    []interface{}{int64(80)},
+					"selector": map[string]interface{}{"app": "test"},
+				},
+			}
+			Expect(resourcemanager.DriftedFields(desired, live, sets.NewString(manifestv1alpha2.FieldManager))).To(Equal([]string{
+				"metadata.labels",
+				"spec.ports",
+				"spec.replicas",
+			}))
+		})
+
+		It("should compare quantities and secret string data by their value", func() {
+			desired := map[string]interface{}{
+				"kind": "Secret",
+				"stringData": map[string]interface{}{
+					"key": "val",
+				},
+				"spec": map[string]interface{}{
+					"cpu": "1000m",
+				},
+			}
+			live := map[string]interface{}{
+				"kind": "Secret",
+				"data": map[string]interface{}{
+					"key": "dmFs",
+				},
+				"spec": map[string]interface{}{
+					"cpu": "1",
+				},
+			}
+			Expect(resourcemanager.DriftedFields(desired, live, sets.NewString(manifestv1alpha2.FieldManager))).To(BeEmpty())
+		})
+
+		It("should ignore fields that are only managed by other field managers", func() {
+			desired := map[string]interface{}{
+				"spec": map[string]interface{}{
+					"replicas": int64(1),
+					"template": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"containers": []interface{}{
+								map[string]interface{}{"name": "app", "image": "app:1.0.0"},
+								map[string]interface{}{"name": "proxy", "image": "proxy:1.0.0"},
+							},
+						},
+					},
+				},
+			}
+			live := map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"managedFields": []interface{}{
+						map[string]interface{}{
+							"manager":   manifestv1alpha2.FieldManager,
+							"operation": "Update",
+							"fieldsV1": map[string]interface{}{
+								"f:spec": map[string]interface{}{
+									"f:template": map[string]interface{}{
+										"f:spec": map[string]interface{}{
+											"f:containers": map[string]interface{}{
+												`k:{"name":"app"}`: map[string]interface{}{
+													".":       map[string]interface{}{},
+													"f:image": map[string]interface{}{},
+													"f:name":  map[string]interface{}{},
+												},
+												`k:{"name":"proxy"}`: map[string]interface{}{
+													".":      map[string]interface{}{},
+													"f:name": map[string]interface{}{},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						map[string]interface{}{
+							"manager":     "kube-controller-manager",
+							"operation":   "Update",
+							"subresource": "scale",
+							"fieldsV1": map[string]interface{}{
+								"f:spec": map[string]interface{}{
+									"f:replicas": map[string]interface{}{},
+								},
+							},
+						},
+						map[string]interface{}{
+							"manager":   "kubectl-edit",
+							"operation": "Update",
+							"fieldsV1": map[string]interface{}{
+								"f:spec": map[string]interface{}{
+									"f:template": map[string]interface{}{
+										"f:spec": map[string]interface{}{
+											"f:containers": map[string]interface{}{
+												`k:{"name":"proxy"}`: map[string]interface{}{
+													"f:image": map[string]interface{}{},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"spec": map[string]interface{}{
+					"replicas": int64(3),
+					"template": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"containers": []interface{}{
+								map[string]interface{}{"name": "app", "image": "app:2.0.0"},
+								map[string]interface{}{"name": "proxy", "image": "proxy:2.0.0"},
+							},
+						},
+					},
+				},
+			}
+
+			// the replicas are managed by the autoscaler and the image of the proxy has been changed with kubectl
+			Expect(resourcemanager.DriftedFields(desired, live, sets.NewString(manifestv1alpha2.FieldManager))).To(Equal([]string{
+				"spec.template.spec.containers[0].image",
+			}))
+			Expect(resourcemanager.DriftedFields(desired, live, sets.NewString(manifestv1alpha2.FieldManager, "kubectl-edit"))).To(Equal([]string{
+				"spec.template.spec.containers[0].image",
+				"spec.template.spec.containers[1].image",
+			}))
+		})
+	})
+
+})
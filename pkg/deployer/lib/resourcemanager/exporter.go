@@ -23,6 +23,7 @@ import (
 	"github.com/gardener/landscaper/pkg/deployer/lib"
 	"github.com/gardener/landscaper/pkg/landscaper/dataobjects/jsonpath"
 	"github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 // ExporterOptions defines the options for the exporter.
@@ -61,7 +62,10 @@ func NewExporter(opts ExporterOptions) *Exporter {
 }
 
 // Export exports all keys that are defined in the exports definition.
-func (e *Exporter) Export(ctx context.Context, exports *managedresource.Exports) (map[string]interface{}, error) {
+func (e *Exporter) Export(ctx context.Context, exports *managedresource.Exports) (_ map[string]interface{}, err error) {
+	ctx, span := tracing.StartSpan(ctx, "CollectExports")
+	defer func() { tracing.EndSpan(span, err) }()
+
 	log, _ := logging.FromContextOrNew(ctx, nil)
 	log = log.WithName("export")
 	ctx = logging.NewContext(ctx, log)
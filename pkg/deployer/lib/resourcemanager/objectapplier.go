@@ -7,7 +7,9 @@ package resourcemanager
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"regexp"
 	"sort"
 	"sync"
 	"time"
@@ -33,6 +35,7 @@ import (
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 // ApplyManifests creates or updates all configured manifests.
@@ -51,9 +54,14 @@ type ManifestApplierOptions struct {
 	Clientset        kubernetes.Interface
 	DefaultNamespace string
 
-	DeployItemName   string
-	DeleteTimeout    time.Duration
-	UpdateStrategy   manifestv1alpha2.UpdateStrategy
+	DeployItemName string
+	DeleteTimeout  time.Duration
+	UpdateStrategy manifestv1alpha2.UpdateStrategy
+	// FieldManager is the name of the field manager that is used to create, update and apply resources.
+	// Defaults to the field manager of the manifest deployer.
+	FieldManager string
+	// ForceConflicts defines whether the ownership of conflicting fields is taken over on a server-side apply.
+	ForceConflicts   bool
 	Manifests        []managedresource.Manifest
 	ManagedResources managedresource.ManagedResourceStatusList
 	// Labels defines additional labels that are automatically injected into all resources.
@@ -69,6 +77,8 @@ type ManifestApplier struct {
 	deployItemName   string
 	deleteTimeout    time.Duration
 	updateStrategy   manifestv1alpha2.UpdateStrategy
+	fieldManager     string
+	forceConflicts   bool
 	manifests        []managedresource.Manifest
 	managedResources managedresource.ManagedResourceStatusList
 	labels           map[string]string
@@ -103,6 +113,10 @@ type Manifest struct {
 
 // NewManifestApplier creates a new manifest deployer
 func NewManifestApplier(opts ManifestApplierOptions) *ManifestApplier {
+	fieldManager := opts.FieldManager
+	if len(fieldManager) == 0 {
+		fieldManager = manifestv1alpha2.FieldManager
+	}
 	return &ManifestApplier{
 		decoder:            opts.Decoder,
 		kubeClient:         opts.KubeClient,
@@ -110,6 +124,8 @@ func NewManifestApplier(opts ManifestApplierOptions) *ManifestApplier {
 		deployItemName:     opts.DeployItemName,
 		deleteTimeout:      opts.DeleteTimeout,
 		updateStrategy:     opts.UpdateStrategy,
+		fieldManager:       fieldManager,
+		forceConflicts:     opts.ForceConflicts,
 		manifests:          opts.Manifests,
 		managedResources:   opts.ManagedResources,
 		labels:             opts.Labels,
@@ -123,7 +139,10 @@ func (a *ManifestApplier) GetManagedResourcesStatus() managedresource.ManagedRes
 }
 
 // Apply creates or updates all configured manifests.
-func (a *ManifestApplier) Apply(ctx context.Context) error {
+func (a *ManifestApplier) Apply(ctx context.Context) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "ApplyManifests")
+	defer func() { tracing.EndSpan(span, err) }()
+
 	if err := a.prepareManifests(); err != nil {
 		return err
 	}
@@ -231,7 +250,14 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 			obj.SetAnnotations(objAnnotations)
 		}
 
-		if err := a.kubeClient.Create(ctx, obj); err != nil {
+		if a.updateStrategy == manifestv1alpha2.UpdateStrategyServerSideApply {
+			return a.serverSideApply(ctx, obj, &managedresource.ManagedResourceStatus{
+				Policy:   manifest.Policy,
+				Resource: *kutil.CoreObjectReferenceFromUnstructuredObject(obj),
+			})
+		}
+
+		if err := a.kubeClient.Create(ctx, obj, client.FieldOwner(a.fieldManager)); err != nil {
 			return nil, fmt.Errorf("unable to create resource %s: %w", key.String(), err)
 		}
 		return &managedresource.ManagedResourceStatus{
@@ -272,11 +298,11 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 		}
 
 		if a.updateStrategy == manifestv1alpha2.UpdateStrategyUpdate {
-			if err := a.kubeClient.Update(ctx, obj); err != nil {
+			if err := a.kubeClient.Update(ctx, obj, client.FieldOwner(a.fieldManager)); err != nil {
 				return mr, fmt.Errorf("unable to update resource %s: %w", key.String(), err)
 			}
 		} else {
-			if err := a.kubeClient.Patch(ctx, obj, client.MergeFrom(&currObj)); err != nil {
+			if err := a.kubeClient.Patch(ctx, obj, client.MergeFrom(&currObj), client.FieldOwner(a.fieldManager)); err != nil {
 				return mr, fmt.Errorf("unable to patch resource %s: %w", key.String(), err)
 			}
 		}
@@ -300,15 +326,83 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 		a.injectLabels(&currObj)
 		kutil.SetMetaDataLabel(&currObj, manifestv1alpha2.ManagedDeployItemLabel, a.deployItemName)
 
-		if err := a.kubeClient.Update(ctx, &currObj); err != nil {
+		if err := a.kubeClient.Update(ctx, &currObj, client.FieldOwner(a.fieldManager)); err != nil {
 			return mr, fmt.Errorf("unable to update resource %s: %w", key.String(), err)
 		}
+	case manifestv1alpha2.UpdateStrategyServerSideApply:
+		// inject manifest specific labels
+		a.injectLabels(obj)
+		kutil.SetMetaDataLabel(obj, manifestv1alpha2.ManagedDeployItemLabel, a.deployItemName)
+
+		return a.serverSideApply(ctx, obj, mr)
 	default:
 		return mr, fmt.Errorf("%s is not a valid update strategy", a.updateStrategy)
 	}
 	return mr, nil
 }
 
+// serverSideApply applies the object with a server-side apply using the configured field manager.
+// Fields of the object that are owned by other field managers are recorded as conflicts in the managed resource status.
+// If conflicts are not forced, the apply fails in case of conflicts.
+func (a *ManifestApplier) serverSideApply(ctx context.Context, obj *unstructured.Unstructured,
+	mr *managedresource.ManagedResourceStatus) (*managedresource.ManagedResourceStatus, error) {
+	key := kutil.ObjectKeyFromObject(obj)
+	obj.SetManagedFields(nil)
+	obj.SetResourceVersion("")
+
+	if !a.forceConflicts {
+		if err := a.kubeClient.Patch(ctx, obj, client.Apply, client.FieldOwner(a.fieldManager)); err != nil {
+			if apierrors.IsConflict(err) {
+				mr.Conflicts = fieldManagerConflicts(err)
+			}
+			return mr, fmt.Errorf("unable to apply resource %s: %w", key.String(), err)
+		}
+		return mr, nil
+	}
+
+	// detect the fields that are taken over from other field managers before the apply is forced
+	if err := a.kubeClient.Patch(ctx, obj.DeepCopy(), client.Apply, client.FieldOwner(a.fieldManager), client.DryRunAll); err != nil {
+		if !apierrors.IsConflict(err) {
+			return mr, fmt.Errorf("unable to apply resource %s: %w", key.String(), err)
+		}
+		mr.Conflicts = fieldManagerConflicts(err)
+	}
+	if err := a.kubeClient.Patch(ctx, obj, client.Apply, client.FieldOwner(a.fieldManager), client.ForceOwnership); err != nil {
+		return mr, fmt.Errorf("unable to apply resource %s: %w", key.String(), err)
+	}
+	return mr, nil
+}
+
+var fieldManagerConflictRegexp = regexp.MustCompile(`conflict with "([^"]*)"`)
+
+// fieldManagerConflicts returns the conflicting field managers and their fields of a server-side apply conflict error.
+func fieldManagerConflicts(err error) []managedresource.FieldManagerConflict {
+	var statusErr apierrors.APIStatus
+	if !errors.As(err, &statusErr) || statusErr.Status().Details == nil {
+		return nil
+	}
+
+	conflicts := make([]managedresource.FieldManagerConflict, 0)
+	managerIndex := map[string]int{}
+	for _, cause := range statusErr.Status().Details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+		manager := ""
+		if match := fieldManagerConflictRegexp.FindStringSubmatch(cause.Message); match != nil {
+			manager = match[1]
+		}
+		i, ok := managerIndex[manager]
+		if !ok {
+			i = len(conflicts)
+			managerIndex[manager] = i
+			conflicts = append(conflicts, managedresource.FieldManagerConflict{Manager: manager})
+		}
+		conflicts[i].Fields = append(conflicts[i].Fields, cause.Field)
+	}
+	return conflicts
+}
+
 func (a *ManifestApplier) injectLabels(obj client.Object) {
 	if len(a.labels) == 0 {
 		return
@@ -711,4 +711,76 @@ var _ = Describe("ObjectApplier", func() {
 		Expect(cmRead.Data).To(HaveKeyWithValue("addedKey", "val1"))
 		Expect(cmRead.Annotations).To(HaveKeyWithValue("modified", "True"))
 	})
+
+	It("should apply objects server-side and record conflicting field managers", func() {
+		cm := &corev1.ConfigMap{}
+		cm.Name = "my-cm"
+		cm.Namespace = state.Namespace
+		cm.Data = map[string]string{
+			"key": "val",
+		}
+		cmRaw, err := kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+
+		opts := resourcemanager.ManifestApplierOptions{
+			DeployItemName:   "test-di",
+			Decoder:          api.NewDecoder(scheme.Scheme),
+			KubeClient:       testenv.Client,
+			Clientset:        clientset,
+			DefaultNamespace: state.Namespace,
+			DeleteTimeout:    10 * time.Second,
+			UpdateStrategy:   manifestv1alpha2.UpdateStrategyServerSideApply,
+			Manifests: []managedresource.Manifest{
+				{
+					Manifest: cmRaw,
+					Policy:   managedresource.ManagePolicy,
+				},
+			},
+			ManagedResources: managedresource.ManagedResourceStatusList{},
+		}
+		managedResources, err := resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(managedResources).To(HaveLen(1))
+		Expect(managedResources[0].Conflicts).To(BeEmpty())
+
+		cmRead := &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, client.ObjectKeyFromObject(cm), cmRead)).ToNot(HaveOccurred())
+		Expect(cmRead.Labels).To(HaveKeyWithValue(manifestv1alpha2.ManagedDeployItemLabel, opts.DeployItemName))
+		Expect(cmRead.Data).To(HaveKeyWithValue("key", "val"))
+		Expect(cmRead.ManagedFields).To(ContainElement(HaveField("Manager", manifestv1alpha2.FieldManager)))
+
+		// another field manager takes over the ownership of the field
+		otherCm := &corev1.ConfigMap{}
+		otherCm.APIVersion = "v1"
+		otherCm.Kind = "ConfigMap"
+		otherCm.Name = cm.Name
+		otherCm.Namespace = cm.Namespace
+		otherCm.Data = map[string]string{
+			"key": "other",
+		}
+		Expect(testenv.Client.Patch(ctx, otherCm, client.Apply, client.FieldOwner("other-manager"), client.ForceOwnership)).To(Succeed())
+
+		// without forcing conflicts the apply fails
+		applier := resourcemanager.NewManifestApplier(opts)
+		Expect(applier.Apply(ctx)).ToNot(Succeed())
+		managedResources = applier.GetManagedResourcesStatus()
+		Expect(managedResources).To(HaveLen(1))
+		Expect(managedResources[0].Conflicts).To(ConsistOf(managedresource.FieldManagerConflict{
+			Manager: "other-manager",
+			Fields:  []string{".data.key"},
+		}))
+		Expect(testenv.Client.Get(ctx, client.ObjectKeyFromObject(cm), cmRead)).ToNot(HaveOccurred())
+		Expect(cmRead.Data).To(HaveKeyWithValue("key", "other"))
+
+		// with forcing conflicts the ownership is taken over
+		opts.ForceConflicts = true
+		applier = resourcemanager.NewManifestApplier(opts)
+		Expect(applier.Apply(ctx)).To(Succeed())
+		managedResources = applier.GetManagedResourcesStatus()
+		Expect(managedResources).To(HaveLen(1))
+		Expect(managedResources[0].Conflicts).To(HaveLen(1))
+		Expect(managedResources[0].Conflicts[0].Manager).To(Equal("other-manager"))
+		Expect(testenv.Client.Get(ctx, client.ObjectKeyFromObject(cm), cmRead)).ToNot(HaveOccurred())
+		Expect(cmRead.Data).To(HaveKeyWithValue("key", "val"))
+	})
 })
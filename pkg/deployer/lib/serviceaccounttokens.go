@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/golang/groupcache/lru"
+	"k8s.io/utils/clock"
+
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+)
+
+const (
+	// maxCachedServiceAccountKubeconfigs is the maximal number of service account kubeconfigs that are cached.
+	maxCachedServiceAccountKubeconfigs = 256
+	// serviceAccountTokenRefreshRatio is the ratio of the validity duration of a token after which a new token is requested.
+	// The remaining validity ensures that a deploy item that is reconciled with a cached token does not run into its expiration.
+	serviceAccountTokenRefreshRatio = 0.8
+)
+
+// serviceAccountKubeconfigs caches the kubeconfigs of the service accounts of kubernetes cluster targets.
+var serviceAccountKubeconfigs = newServiceAccountKubeconfigCache(clock.RealClock{})
+
+// serviceAccountKubeconfigCache caches kubeconfigs with service account tokens until shortly before the tokens expire,
+// so that not every reconcile of a deploy item requests a new token.
+type serviceAccountKubeconfigCache struct {
+	clock clock.PassiveClock
+	mux   sync.Mutex
+	cache *lru.Cache
+}
+
+// cachedServiceAccountKubeconfig is a cached kubeconfig together with the time at which its token is refreshed.
+type cachedServiceAccountKubeconfig struct {
+	kubeconfig  []byte
+	refreshTime time.Time
+}
+
+func newServiceAccountKubeconfigCache(passiveClock clock.PassiveClock) *serviceAccountKubeconfigCache {
+	return &serviceAccountKubeconfigCache{
+		clock: passiveClock,
+		cache: lru.New(maxCachedServiceAccountKubeconfigs),
+	}
+}
+
+// Get returns the cached kubeconfig for the service account of the given kubeconfig and configuration.
+// If no kubeconfig is cached or its token is about to expire, a new kubeconfig is generated
+// with the given function, which returns the kubeconfig and the expiration time of its token.
+func (c *serviceAccountKubeconfigCache) Get(kubeconfig []byte, config *targettypes.ServiceAccountTokenConfig,
+	generate func() ([]byte, time.Time, error)) ([]byte, error) {
+	key, err := serviceAccountKubeconfigKey(kubeconfig, config)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mux.Lock()
+	entry, ok := c.cache.Get(key)
+	c.mux.Unlock()
+	if ok {
+		cached := entry.(*cachedServiceAccountKubeconfig)
+		if c.clock.Now().Before(cached.refreshTime) {
+			return cached.kubeconfig, nil
+		}
+	}
+
+	requestTime := c.clock.Now()
+	saKubeconfig, expirationTime, err := generate()
+	if err != nil {
+		return nil, err
+	}
+	validity := time.Duration(float64(expirationTime.Sub(requestTime)) * serviceAccountTokenRefreshRatio)
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.cache.Add(key, &cachedServiceAccountKubeconfig{
+		kubeconfig:  saKubeconfig,
+		refreshTime: requestTime.Add(validity),
+	})
+	return saKubeconfig, nil
+}
+
+// serviceAccountKubeconfigKey calculates the cache key of a service account kubeconfig.
+// The key is a hash of the kubeconfig that is used to request the token and of the service account configuration,
+// so that the credentials of the kubeconfig are not kept as part of the key.
+func serviceAccountKubeconfigKey(kubeconfig []byte, config *targettypes.ServiceAccountTokenConfig) (string, error) {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	h.Write(kubeconfig)
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/clock/testing"
+
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+)
+
+var _ = Describe("ServiceAccountKubeconfigCache", func() {
+
+	var (
+		fakeClock *testing.FakePassiveClock
+		cache     *serviceAccountKubeconfigCache
+		config    *targettypes.ServiceAccountTokenConfig
+		requests  int
+		generate  func() ([]byte, time.Time, error)
+	)
+
+	BeforeEach(func() {
+		fakeClock = testing.NewFakePassiveClock(time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC))
+		cache = newServiceAccountKubeconfigCache(fakeClock)
+		config = &targettypes.ServiceAccountTokenConfig{Name: "deployer", Namespace: "default"}
+		requests = 0
+		generate = func() ([]byte, time.Time, error) {
+			requests++
+			return []byte(fmt.Sprintf("kubeconfig-%d", requests)), fakeClock.Now().Add(time.Hour), nil
+		}
+	})
+
+	It("should reuse the kubeconfig until shortly before its token expires", func() {
+		kubeconfig, err := cache.Get([]byte("admin"), config, generate)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(kubeconfig)).To(Equal("kubeconfig-1"))
+
+		fakeClock.SetTime(fakeClock.Now().Add(40 * time.Minute))
+		kubeconfig, err = cache.Get([]byte("admin"), config, generate)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(kubeconfig)).To(Equal("kubeconfig-1"))
+
+		fakeClock.SetTime(fakeClock.Now().Add(10 * time.Minute))
+		kubeconfig, err = cache.Get([]byte("admin"), config, generate)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(kubeconfig)).To(Equal("kubeconfig-2"))
+	})
+
+	It("should not share kubeconfigs between different targets", func() {
+		_, err := cache.Get([]byte("admin"), config, generate)
+		Expect(err).ToNot(HaveOccurred())
+
+		kubeconfig, err := cache.Get([]byte("other-admin"), config, generate)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(kubeconfig)).To(Equal("kubeconfig-2"))
+
+		other := &targettypes.ServiceAccountTokenConfig{Name: "other", Namespace: "default"}
+		kubeconfig, err = cache.Get([]byte("admin"), other, generate)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(kubeconfig)).To(Equal("kubeconfig-3"))
+	})
+
+	It("should not cache failed token requests", func() {
+		_, err := cache.Get([]byte("admin"), config, func() ([]byte, time.Time, error) {
+			return nil, time.Time{}, fmt.Errorf("forbidden")
+		})
+		Expect(err).To(HaveOccurred())
+
+		kubeconfig, err := cache.Get([]byte("admin"), config, generate)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(kubeconfig)).To(Equal("kubeconfig-1"))
+	})
+
+})
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package file_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	mockv1alpha1 "github.com/gardener/landscaper/apis/deployer/mock/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/deployer/lib/targetresolver/file"
+	"github.com/gardener/landscaper/pkg/deployer/mock"
+)
+
+var _ = Describe("E2E", func() {
+
+	var (
+		ctx        context.Context
+		dir        string
+		kubeClient client.Client
+		ctrl       reconcile.Reconciler
+	)
+
+	BeforeEach(func() {
+		ctx = logging.NewContext(context.Background(), logging.Discard())
+		dir = GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(dir, "valid.json"),
+			[]byte(`{"host": "eu.gcr.io", "username": "user", "password": "pw"}`), os.ModePerm)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "invalid.json"), []byte(`{"host": "eu.gcr.io", "username": "user"}`), os.ModePerm)).To(Succeed())
+
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+		lsCtx := &lsv1alpha1.Context{}
+		lsCtx.Name = lsv1alpha1.DefaultContextName
+		lsCtx.Namespace = "test"
+		Expect(kubeClient.Create(ctx, lsCtx)).To(Succeed())
+
+		var err error
+		ctrl, err = mock.NewController(logging.Discard(), kubeClient, api.LandscaperScheme, record.NewFakeRecorder(1024), mockv1alpha1.Configuration{
+			TargetResolvers: []config.TargetResolverConfiguration{
+				{
+					Name: file.ResolverName,
+					File: &config.FileTargetResolverConfiguration{
+						Directory: dir,
+					},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	// createDeployItem creates a mock deploy item whose target references the given path of the given resolver.
+	createDeployItem := func(resolver, path string) *lsv1alpha1.DeployItem {
+		target := &lsv1alpha1.Target{}
+		target.Name = "my-target"
+		target.Namespace = "test"
+		target.Spec.Type = targettypes.OCIRegistryTargetType
+		target.Spec.ExternalSecretRef = &lsv1alpha1.ExternalSecretReference{
+			Resolver: resolver,
+			Path:     path,
+		}
+		Expect(kubeClient.Create(ctx, target)).To(Succeed())
+
+		phase := lsv1alpha1.ExecutionPhaseSucceeded
+		di, err := mock.NewDeployItemBuilder().
+			Key("test", "my-di").
+			ProviderConfig(&mockv1alpha1.ProviderConfiguration{Phase: &phase}).
+			TargetFromObjectKey(kutil.ObjectKeyFromObject(target)).
+			GenerateJobID().
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kubeClient.Create(ctx, di)).To(Succeed())
+		return di
+	}
+
+	It("should reconcile a deploy item whose target is resolved by a configured resolver", func() {
+		di := createDeployItem(file.ResolverName, "valid.json")
+
+		_, err := ctrl.Reconcile(ctx, kutil.ReconcileRequestFromObject(di))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(kubeClient.Get(ctx, kutil.ObjectKeyFromObject(di), di)).To(Succeed())
+		Expect(di.Status.Phase).To(Equal(lsv1alpha1.ExecutionPhaseSucceeded))
+	})
+
+	It("should fail if the resolved target does not match the schema of the target type", func() {
+		di := createDeployItem(file.ResolverName, "invalid.json")
+
+		_, err := ctrl.Reconcile(ctx, kutil.ReconcileRequestFromObject(di))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail if the resolver of the target is not configured", func() {
+		di := createDeployItem("vault", "valid.json")
+
+		_, err := ctrl.Reconcile(ctx, kutil.ReconcileRequestFromObject(di))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("is not registered"))
+	})
+
+})
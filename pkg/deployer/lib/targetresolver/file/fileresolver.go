@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package file
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	. "github.com/gardener/landscaper/pkg/deployer/lib/targetresolver"
+)
+
+// ResolverName is the name under which the file resolver is usually registered.
+const ResolverName = "file"
+
+var _ TargetResolver = FileResolver{}
+
+// FileResolver resolves the external secret reference of a target from a directory with mounted secrets.
+// The path of the reference is the path of a file relative to the directory.
+// If the reference defines a key, the path is a directory that contains a file for every key,
+// which is the layout of a mounted kubernetes secret.
+type FileResolver struct {
+	// Directory is the directory which contains the secrets.
+	Directory string
+}
+
+// New creates a new file resolver for the given directory.
+func New(directory string) *FileResolver {
+	return &FileResolver{
+		Directory: directory,
+	}
+}
+
+// Resolve reads the referenced file and uses its content as content of the resolved target.
+func (fr FileResolver) Resolve(_ context.Context, target *lsv1alpha1.Target) (*lsv1alpha1.ResolvedTarget, error) {
+	ref := target.Spec.ExternalSecretRef
+	if ref == nil {
+		return nil, fmt.Errorf("target %s/%s has no external secret reference", target.Namespace, target.Name)
+	}
+
+	file, err := fr.secretFile(ref.Path, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read secret %q: %w", ref.Path, err)
+	}
+
+	rt := NewResolvedTarget(target)
+	rt.Content = string(content)
+	return rt, nil
+}
+
+// secretFile returns the file of the referenced secret.
+// References to files outside the directory are rejected.
+func (fr FileResolver) secretFile(path, key string) (string, error) {
+	directory, err := filepath.Abs(fr.Directory)
+	if err != nil {
+		return "", err
+	}
+
+	file := filepath.Join(directory, path, key)
+	if file != directory && !strings.HasPrefix(file, directory+string(filepath.Separator)) {
+		return "", fmt.Errorf("secret %q is outside of the secret directory", filepath.Join(path, key))
+	}
+	return file, nil
+}
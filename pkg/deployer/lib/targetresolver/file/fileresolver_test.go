@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package file_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/deployer/lib/targetresolver/file"
+)
+
+var _ = Describe("File Target Resolver", func() {
+
+	var (
+		dir      string
+		resolver *file.FileResolver
+	)
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		Expect(os.MkdirAll(filepath.Join(dir, "my-secret"), os.ModePerm)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "my-secret", "kubeconfig"), []byte("apiVersion: v1"), os.ModePerm)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "config.json"), []byte(`{"host": "example.com"}`), os.ModePerm)).To(Succeed())
+		resolver = file.New(dir)
+	})
+
+	newTarget := func(path, key string) *lsv1alpha1.Target {
+		target := &lsv1alpha1.Target{}
+		target.Spec.ExternalSecretRef = &lsv1alpha1.ExternalSecretReference{
+			Resolver: file.ResolverName,
+			Path:     path,
+			Key:      key,
+		}
+		return target
+	}
+
+	It("should resolve a key of a mounted secret", func() {
+		rt, err := resolver.Resolve(context.Background(), newTarget("my-secret", "kubeconfig"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rt.Content).To(Equal("apiVersion: v1"))
+	})
+
+	It("should resolve a file", func() {
+		rt, err := resolver.Resolve(context.Background(), newTarget("config.json", ""))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rt.Content).To(MatchJSON(`{"host": "example.com"}`))
+	})
+
+	It("should reject paths outside of the directory", func() {
+		_, err := resolver.Resolve(context.Background(), newTarget("../other", "kubeconfig"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail for missing files", func() {
+		_, err := resolver.Resolve(context.Background(), newTarget("my-secret", "other"))
+		Expect(err).To(HaveOccurred())
+	})
+
+})
@@ -0,0 +1,20 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targetresolver
+
+import (
+	"context"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+var _ TargetResolver = InlineResolver{}
+
+// InlineResolver resolves targets with an inline configuration.
+type InlineResolver struct{}
+
+func (InlineResolver) Resolve(_ context.Context, target *lsv1alpha1.Target) (*lsv1alpha1.ResolvedTarget, error) {
+	return NewResolvedTarget(target), nil
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package typed
+
+import (
+	"context"
+	"fmt"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	. "github.com/gardener/landscaper/pkg/deployer/lib/targetresolver"
+	"github.com/gardener/landscaper/pkg/utils/targettypes"
+)
+
+var _ TargetResolver = TypedResolver{}
+
+// TypedResolver resolves a target with another resolver and validates the resolved content
+// against the schema of the target type.
+type TypedResolver struct {
+	Resolver TargetResolver
+	Registry *targettypes.Registry
+}
+
+// New creates a new typed resolver which validates the targets resolved by the given resolver
+// against the target types of the given registry.
+func New(resolver TargetResolver, registry *targettypes.Registry) *TypedResolver {
+	return &TypedResolver{
+		Resolver: resolver,
+		Registry: registry,
+	}
+}
+
+func (tr TypedResolver) Resolve(ctx context.Context, target *lsv1alpha1.Target) (*lsv1alpha1.ResolvedTarget, error) {
+	rt, err := tr.Resolver.Resolve(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tr.Registry.ValidateConfiguration(target.Spec.Type, []byte(rt.Content)); err != nil {
+		return nil, fmt.Errorf("target %s/%s is invalid: %w", target.Namespace, target.Name, err)
+	}
+	return rt, nil
+}
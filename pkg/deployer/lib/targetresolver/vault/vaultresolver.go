@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	. "github.com/gardener/landscaper/pkg/deployer/lib/targetresolver"
+)
+
+// ResolverName is the name under which the vault resolver is usually registered.
+const ResolverName = "vault"
+
+var _ TargetResolver = VaultResolver{}
+
+// VaultResolver resolves the external secret reference of a target from a key-value store (version 2)
+// that is compatible to the HTTP API of HashiCorp Vault.
+type VaultResolver struct {
+	// Address is the base url of the store, e.g. "https://vault.example.com:8200".
+	Address string
+	// MountPath is the path where the key-value secrets engine is mounted, e.g. "secret".
+	MountPath string
+	// Token is the token that is used to authenticate against the store.
+	Token string
+	// Client is the http client that is used to access the store.
+	Client *http.Client
+}
+
+// New creates a new vault resolver.
+func New(address, mountPath, token string) *VaultResolver {
+	return &VaultResolver{
+		Address:   address,
+		MountPath: mountPath,
+		Token:     token,
+		Client:    http.DefaultClient,
+	}
+}
+
+// kvResponse is the response of a read request of the key-value secrets engine.
+type kvResponse struct {
+	Data struct {
+		Data map[string]interface{} `json:"data"`
+	} `json:"data"`
+}
+
+// Resolve reads the referenced secret from the store.
+// If the reference defines a key, the value of the key is used as content of the resolved target.
+// Otherwise, the whole secret is used as json.
+func (vr VaultResolver) Resolve(ctx context.Context, target *lsv1alpha1.Target) (*lsv1alpha1.ResolvedTarget, error) {
+	ref := target.Spec.ExternalSecretRef
+	if ref == nil {
+		return nil, fmt.Errorf("target %s/%s has no external secret reference", target.Namespace, target.Name)
+	}
+
+	data, err := vr.read(ctx, ref.Path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read secret %q: %w", ref.Path, err)
+	}
+
+	rt := NewResolvedTarget(target)
+	if len(ref.Key) == 0 {
+		content, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+		rt.Content = string(content)
+		return rt, nil
+	}
+
+	value, ok := data[ref.Key]
+	if !ok {
+		return nil, fmt.Errorf("secret %q has no key %q", ref.Path, ref.Key)
+	}
+	if strValue, ok := value.(string); ok {
+		rt.Content = strValue
+		return rt, nil
+	}
+	content, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	rt.Content = string(content)
+	return rt, nil
+}
+
+func (vr VaultResolver) read(ctx context.Context, path string) (map[string]interface{}, error) {
+	u, err := url.Parse(vr.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %q: %w", vr.Address, err)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/v1/" + strings.Trim(vr.MountPath, "/") + "/data/" + strings.TrimPrefix(path, "/")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", vr.Token)
+
+	client := vr.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	kv := &kvResponse{}
+	if err := json.Unmarshal(body, kv); err != nil {
+		return nil, fmt.Errorf("unable to decode response: %w", err)
+	}
+	return kv.Data.Data, nil
+}
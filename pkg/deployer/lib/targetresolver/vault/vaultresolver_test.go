@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package vault_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/deployer/lib/targetresolver/vault"
+)
+
+var _ = Describe("Vault Target Resolver", func() {
+
+	var (
+		server   *httptest.Server
+		resolver *vault.VaultResolver
+	)
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-Vault-Token") != "my-token" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			if r.URL.Path != "/v1/secret/data/landscaper/my-target" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(`{"data": {"data": {"kubeconfig": "apiVersion: v1", "config": {"host": "example.com"}}}}`))
+		}))
+		resolver = vault.New(server.URL, "secret", "my-token")
+		resolver.Client = server.Client()
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newTarget := func(path, key string) *lsv1alpha1.Target {
+		target := &lsv1alpha1.Target{}
+		target.Spec.ExternalSecretRef = &lsv1alpha1.ExternalSecretReference{
+			Resolver: vault.ResolverName,
+			Path:     path,
+			Key:      key,
+		}
+		return target
+	}
+
+	It("should resolve the value of a key", func() {
+		rt, err := resolver.Resolve(context.Background(), newTarget("landscaper/my-target", "kubeconfig"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rt.Content).To(Equal("apiVersion: v1"))
+	})
+
+	It("should resolve a structured value of a key as json", func() {
+		rt, err := resolver.Resolve(context.Background(), newTarget("landscaper/my-target", "config"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rt.Content).To(MatchJSON(`{"host": "example.com"}`))
+	})
+
+	It("should resolve the whole secret if no key is given", func() {
+		rt, err := resolver.Resolve(context.Background(), newTarget("landscaper/my-target", ""))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rt.Content).To(MatchJSON(`{"kubeconfig": "apiVersion: v1", "config": {"host": "example.com"}}`))
+	})
+
+	It("should not persist the resolved secret in the target", func() {
+		target := newTarget("landscaper/my-target", "kubeconfig")
+		rt, err := resolver.Resolve(context.Background(), target)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rt.Target.Spec.Configuration).To(BeNil())
+		Expect(target.Spec.Configuration).To(BeNil())
+	})
+
+	It("should fail for an unknown secret or key", func() {
+		_, err := resolver.Resolve(context.Background(), newTarget("landscaper/other", "kubeconfig"))
+		Expect(err).To(HaveOccurred())
+		_, err = resolver.Resolve(context.Background(), newTarget("landscaper/my-target", "other"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail for an invalid token", func() {
+		resolver.Token = "other"
+		_, err := resolver.Resolve(context.Background(), newTarget("landscaper/my-target", "kubeconfig"))
+		Expect(err).To(HaveOccurred())
+	})
+
+})
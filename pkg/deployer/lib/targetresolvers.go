@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/pkg/deployer/lib/targetresolver"
+	fileresolver "github.com/gardener/landscaper/pkg/deployer/lib/targetresolver/file"
+	vaultresolver "github.com/gardener/landscaper/pkg/deployer/lib/targetresolver/vault"
+)
+
+// NewTargetResolvers creates the resolvers for targets with an external secret reference from the given configurations.
+// The resolvers are keyed by their name, which is referenced by targets in the field spec.externalSecretRef.resolver.
+func NewTargetResolvers(configs []config.TargetResolverConfiguration) (map[string]targetresolver.TargetResolver, error) {
+	resolvers := map[string]targetresolver.TargetResolver{}
+	for i, cfg := range configs {
+		if len(cfg.Name) == 0 {
+			return nil, fmt.Errorf("target resolver %d has no name", i)
+		}
+		if _, ok := resolvers[cfg.Name]; ok {
+			return nil, fmt.Errorf("target resolver %q is defined multiple times", cfg.Name)
+		}
+
+		switch {
+		case cfg.File != nil && cfg.Vault != nil:
+			return nil, fmt.Errorf("target resolver %q must define exactly one resolver type", cfg.Name)
+		case cfg.File != nil:
+			resolvers[cfg.Name] = fileresolver.New(cfg.File.Directory)
+		case cfg.Vault != nil:
+			token, err := os.ReadFile(cfg.Vault.TokenFile)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read token of target resolver %q: %w", cfg.Name, err)
+			}
+			resolvers[cfg.Name] = vaultresolver.New(cfg.Vault.Address, cfg.Vault.MountPath, strings.TrimSpace(string(token)))
+		default:
+			return nil, fmt.Errorf("target resolver %q must define exactly one resolver type", cfg.Name)
+		}
+	}
+	return resolvers, nil
+}
@@ -10,10 +10,15 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"time"
 
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
@@ -35,18 +40,99 @@ import (
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 )
 
+// DefaultServiceAccountTokenExpirationSeconds is the default validity duration of the tokens
+// that are requested for the service account of a kubernetes cluster target.
+const DefaultServiceAccountTokenExpirationSeconds int64 = 3600
+
 // GetKubeconfigFromTargetConfig fetches the kubeconfig from a given config.
 // If the config defines the target from a secret that secret is read from all provided clients.
+// If the config defines a service account, a short-lived kubeconfig for the service account is returned.
 func GetKubeconfigFromTargetConfig(ctx context.Context, config *targettypes.KubernetesClusterTargetConfig,
 	targetNamespace string, lsClient client.Client) ([]byte, error) {
+	var (
+		kubeconfig []byte
+		err        error
+	)
 	if config.Kubeconfig.StrVal != nil {
-		return []byte(*config.Kubeconfig.StrVal), nil
-	}
-	if config.Kubeconfig.SecretRef == nil {
+		kubeconfig = []byte(*config.Kubeconfig.StrVal)
+	} else if config.Kubeconfig.SecretRef != nil {
+		kubeconfig, err = GetKubeconfigFromSecretRef(ctx, config.Kubeconfig.SecretRef, targetNamespace, lsClient)
+		if err != nil {
+			return nil, err
+		}
+	} else {
 		return nil, errors.New("kubeconfig not defined")
 	}
 
-	return GetKubeconfigFromSecretRef(ctx, config.Kubeconfig.SecretRef, targetNamespace, lsClient)
+	if config.ServiceAccount == nil {
+		return kubeconfig, nil
+	}
+	return GetServiceAccountKubeconfig(ctx, kubeconfig, config.ServiceAccount)
+}
+
+// GetServiceAccountKubeconfig requests a token for the given service account and returns a kubeconfig that uses it.
+// The given kubeconfig is only used to request the token.
+// The kubeconfig is cached and reused until shortly before its token expires.
+func GetServiceAccountKubeconfig(ctx context.Context, kubeconfig []byte, config *targettypes.ServiceAccountTokenConfig) ([]byte, error) {
+	if len(config.Name) == 0 || len(config.Namespace) == 0 {
+		return nil, errors.New("name and namespace of the service account must be defined")
+	}
+
+	return serviceAccountKubeconfigs.Get(kubeconfig, config, func() ([]byte, time.Time, error) {
+		return requestServiceAccountKubeconfig(ctx, kubeconfig, config)
+	})
+}
+
+// requestServiceAccountKubeconfig requests a token for the given service account with the given kubeconfig
+// and returns a kubeconfig that uses the token together with the expiration time of the token.
+func requestServiceAccountKubeconfig(ctx context.Context, kubeconfig []byte,
+	config *targettypes.ServiceAccountTokenConfig) ([]byte, time.Time, error) {
+	clientConfig, err := clientcmd.NewClientConfigFromBytes(kubeconfig)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("unable to parse kubeconfig: %w", err)
+	}
+	restConfig, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("unable to create rest config from kubeconfig: %w", err)
+	}
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("unable to create clientset: %w", err)
+	}
+
+	return generateServiceAccountKubeconfig(ctx, clientset, restConfig, config)
+}
+
+// GenerateServiceAccountKubeconfig requests a token for the given service account with the given clientset and
+// returns a kubeconfig that authenticates with the token at the cluster of the given rest config.
+func GenerateServiceAccountKubeconfig(ctx context.Context, clientset kubernetes.Interface, restConfig *rest.Config,
+	config *targettypes.ServiceAccountTokenConfig) ([]byte, error) {
+	kubeconfig, _, err := generateServiceAccountKubeconfig(ctx, clientset, restConfig, config)
+	return kubeconfig, err
+}
+
+func generateServiceAccountKubeconfig(ctx context.Context, clientset kubernetes.Interface, restConfig *rest.Config,
+	config *targettypes.ServiceAccountTokenConfig) ([]byte, time.Time, error) {
+	expirationSeconds := config.ExpirationSeconds
+	if expirationSeconds == nil {
+		expirationSeconds = pointer.Int64(DefaultServiceAccountTokenExpirationSeconds)
+	}
+
+	serviceAccountKey := client.ObjectKey{Name: config.Name, Namespace: config.Namespace}
+	tokenStatus, err := kutil.RequestServiceAccountToken(ctx, clientset, serviceAccountKey, expirationSeconds, config.Audiences)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("unable to request token for service account %s: %w", serviceAccountKey.String(), err)
+	}
+
+	logger, _ := logging.FromContextOrNew(ctx, nil)
+	logger.Debug("requested token for service account", "serviceAccount", serviceAccountKey.String(),
+		"expirationTimestamp", tokenStatus.ExpirationTimestamp.String())
+
+	kubeconfig, err := kutil.GenerateServiceAccountKubeconfigBytes(restConfig, tokenStatus.Token)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return kubeconfig, tokenStatus.ExpirationTimestamp.Time, nil
 }
 
 func GetKubeconfigFromSecretRef(ctx context.Context, ref *lsv1alpha1.SecretReference, targetNamespace string,
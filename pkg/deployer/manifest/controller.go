@@ -11,10 +11,12 @@ import (
 	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	crval "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile/validation"
+	ddval "github.com/gardener/landscaper/apis/deployer/utils/driftdetection/validation"
 	deployerlib "github.com/gardener/landscaper/pkg/deployer/lib"
 	cr "github.com/gardener/landscaper/pkg/deployer/lib/continuousreconcile"
 	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
@@ -86,3 +88,27 @@ func (d *deployer) NextReconcile(ctx context.Context, last time.Time, di *lsv1al
 	next := schedule.Next(last)
 	return &next, nil
 }
+
+func (d *deployer) DriftDetectionSchedule(ctx context.Context, di *lsv1alpha1.DeployItem) (time.Duration, *metav1.Time, error) {
+	manifest, err := New(d.lsClient, d.hostClient, &d.config, di, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	if !ddval.DriftDetectionEnabled(manifest.ProviderConfiguration.DriftDetection) {
+		// no drift detection configured
+		return 0, nil, nil
+	}
+	var lastCheck *metav1.Time
+	if manifest.ProviderStatus != nil && manifest.ProviderStatus.Drift != nil {
+		lastCheck = &manifest.ProviderStatus.Drift.LastCheckTime
+	}
+	return manifest.ProviderConfiguration.DriftDetection.Interval.Duration, lastCheck, nil
+}
+
+func (d *deployer) DetectDrift(ctx context.Context, _ *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
+	manifest, err := New(d.lsClient, d.hostClient, &d.config, di, rt)
+	if err != nil {
+		return err
+	}
+	return manifest.DetectDrift(ctx)
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+
+	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
+	dd "github.com/gardener/landscaper/apis/deployer/utils/driftdetection"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	deployerlib "github.com/gardener/landscaper/pkg/deployer/lib"
+	"github.com/gardener/landscaper/pkg/deployer/lib/resourcemanager"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// DetectDrift compares the managed resources with the configured manifests and reports the drifted resources
+// in the provider status and the drift condition of the deploy item.
+// Drifted resources are re-applied if auto correction is enabled.
+func (m *Manifest) DetectDrift(ctx context.Context) error {
+	currOp := "DetectDrift"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
+
+	if m.ProviderStatus == nil || m.ProviderConfiguration.DriftDetection == nil {
+		return nil
+	}
+
+	_, targetClient, targetClientSet, err := m.TargetClient(ctx)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "TargetClusterClient", err.Error())
+	}
+
+	detector := resourcemanager.NewDriftDetector(resourcemanager.DriftDetectorOptions{
+		Decoder:          serializer.NewCodecFactory(Scheme).UniversalDecoder(),
+		KubeClient:       targetClient,
+		Clientset:        targetClientSet,
+		DeployItemName:   m.DeployItem.Name,
+		Manifests:        m.ProviderConfiguration.Manifests,
+		ManagedResources: m.ProviderStatus.ManagedResources,
+		Labels: map[string]string{
+			manifestv1alpha2.ManagedDeployItemLabel: m.DeployItem.Name,
+		},
+		UpdateStrategy:     m.ProviderConfiguration.UpdateStrategy,
+		FieldManager:       manifestv1alpha2.FieldManager,
+		ForceConflicts:     m.ProviderConfiguration.ServerSideApply != nil && m.ProviderConfiguration.ServerSideApply.ForceConflicts,
+		DriftFieldManagers: m.ProviderConfiguration.DriftDetection.FieldManagers,
+	})
+
+	drifted, err := detector.Detect(ctx)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "Detect", err.Error())
+	}
+
+	status := &dd.DriftStatus{
+		LastCheckTime:    metav1.Now(),
+		DriftedResources: drifted,
+	}
+	if m.ProviderStatus.Drift != nil {
+		status.LastCorrectionTime = m.ProviderStatus.Drift.LastCorrectionTime
+	}
+
+	corrected := false
+	if len(drifted) != 0 && m.ProviderConfiguration.DriftDetection.AutoCorrect {
+		if err := detector.Correct(ctx); err != nil {
+			logger.Error(err, "unable to correct drifted resources")
+		} else {
+			now := metav1.Now()
+			status.LastCorrectionTime = &now
+			corrected = true
+		}
+	}
+
+	m.ProviderStatus.Drift = status
+	m.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(m.ProviderStatus, Scheme)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ProviderStatus", err.Error())
+	}
+	deployerlib.UpdateDriftCondition(m.DeployItem, status, corrected)
+
+	if err := m.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000169, m.DeployItem); err != nil {
+		return lserrors.NewWrappedError(err, currOp, "UpdateStatus", err.Error())
+	}
+	return nil
+}
@@ -58,6 +58,8 @@ func (m *Manifest) Reconcile(ctx context.Context) error {
 		DeployItemName:   m.DeployItem.Name,
 		DeleteTimeout:    m.ProviderConfiguration.DeleteTimeout.Duration,
 		UpdateStrategy:   m.ProviderConfiguration.UpdateStrategy,
+		FieldManager:     manifestv1alpha2.FieldManager,
+		ForceConflicts:   m.ProviderConfiguration.ServerSideApply != nil && m.ProviderConfiguration.ServerSideApply.ForceConflicts,
 		Manifests:        m.ProviderConfiguration.Manifests,
 		ManagedResources: m.ProviderStatus.ManagedResources,
 		Labels: map[string]string{
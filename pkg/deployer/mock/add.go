@@ -30,6 +30,11 @@ func AddDeployerToManager(logger logging.Logger, lsMgr, hostMgr manager.Manager,
 		return err
 	}
 
+	targetResolvers, err := deployerlib.NewTargetResolvers(config.TargetResolvers)
+	if err != nil {
+		return err
+	}
+
 	return deployerlib.Add(log, lsMgr, hostMgr, deployerlib.DeployerArgs{
 		Name:            Name,
 		Version:         version.Get().String(),
@@ -37,6 +42,7 @@ func AddDeployerToManager(logger logging.Logger, lsMgr, hostMgr manager.Manager,
 		Type:            Type,
 		Deployer:        d,
 		TargetSelectors: config.TargetSelector,
+		TargetResolvers: targetResolvers,
 	})
 }
 
@@ -53,6 +59,11 @@ func NewController(log logging.Logger, kubeClient client.Client, scheme *runtime
 		return nil, err
 	}
 
+	targetResolvers, err := deployerlib.NewTargetResolvers(config.TargetResolvers)
+	if err != nil {
+		return nil, err
+	}
+
 	return deployerlib.NewController(kubeClient,
 		scheme, eventRecorder,
 		kubeClient, scheme,
@@ -60,5 +71,6 @@ func NewController(log logging.Logger, kubeClient client.Client, scheme *runtime
 			Type:            Type,
 			Deployer:        d,
 			TargetSelectors: config.TargetSelector,
+			TargetResolvers: targetResolvers,
 		}), nil
 }
@@ -28,6 +28,17 @@ import (
 // Resolve returns a blueprint from a given reference.
 // If no fs is given, a temporary filesystem will be created.
 func Resolve(ctx context.Context, resolver ctf.ComponentResolver, cdRef *lsv1alpha1.ComponentDescriptorReference, bpDef lsv1alpha1.BlueprintDefinition) (*Blueprint, error) {
+	return resolve(ctx, resolver, cdRef, bpDef, false)
+}
+
+// ResolveVerified returns a blueprint from a given reference like Resolve.
+// The digest of a remote blueprint is verified against the digest of its resource in the component descriptor,
+// which should only be done if the signature of the component descriptor has been verified.
+func ResolveVerified(ctx context.Context, resolver ctf.ComponentResolver, cdRef *lsv1alpha1.ComponentDescriptorReference, bpDef lsv1alpha1.BlueprintDefinition) (*Blueprint, error) {
+	return resolve(ctx, resolver, cdRef, bpDef, true)
+}
+
+func resolve(ctx context.Context, resolver ctf.ComponentResolver, cdRef *lsv1alpha1.ComponentDescriptorReference, bpDef lsv1alpha1.BlueprintDefinition, verifyDigest bool) (*Blueprint, error) {
 	if bpDef.Reference == nil && bpDef.Inline == nil {
 		return nil, errors.New("no remote reference nor a inline blueprint is defined")
 	}
@@ -69,6 +80,9 @@ func Resolve(ctx context.Context, resolver ctf.ComponentResolver, cdRef *lsv1alp
 		return nil, fmt.Errorf("unable to resolve component descriptor for ref %#v: %w", cdRef, err)
 	}
 
+	if verifyDigest {
+		return GetStore().FetchVerified(ctx, cd, blobResolver, bpDef.Reference.ResourceName)
+	}
 	return ResolveBlueprintFromBlobResolver(ctx, cd, blobResolver, bpDef.Reference.ResourceName)
 }
 
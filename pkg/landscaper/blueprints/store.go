@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	errorsutil "k8s.io/apimachinery/pkg/util/errors"
 
+	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/apis/mediatype"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 
@@ -39,6 +40,7 @@ import (
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/registry/components/verification"
 	"github.com/gardener/landscaper/pkg/utils"
 )
 
@@ -84,6 +86,8 @@ type Store struct {
 	indexMethod config.IndexMethod
 	index       cache.Index
 	fs          vfs.FileSystem
+	// verified contains the ids of the stored blueprints whose digest has been verified.
+	verified map[string]bool
 
 	size        int64
 	currentSize int64
@@ -121,6 +125,7 @@ func NewStore(log logging.Logger, baseFs vfs.FileSystem, config config.Blueprint
 		indexMethod: config.IndexMethod,
 		index:       cache.NewIndex(),
 		fs:          fs,
+		verified:    map[string]bool{},
 		gcConfig:    config.GarbageCollectionConfiguration,
 	}
 
@@ -163,6 +168,24 @@ func (s *Store) Fetch(ctx context.Context,
 	cd *cdv2.ComponentDescriptor,
 	blobResolver ctf.BlobResolver,
 	blueprintName string) (*Blueprint, error) {
+	return s.fetch(ctx, cd, blobResolver, blueprintName, false)
+}
+
+// FetchVerified fetches the blueprint like Fetch and verifies the digest of the blueprint blob
+// against the digest of the blueprint resource in the component descriptor.
+// A cached blueprint whose digest has not been verified is fetched again from the remote.
+func (s *Store) FetchVerified(ctx context.Context,
+	cd *cdv2.ComponentDescriptor,
+	blobResolver ctf.BlobResolver,
+	blueprintName string) (*Blueprint, error) {
+	return s.fetch(ctx, cd, blobResolver, blueprintName, true)
+}
+
+func (s *Store) fetch(ctx context.Context,
+	cd *cdv2.ComponentDescriptor,
+	blobResolver ctf.BlobResolver,
+	blueprintName string,
+	verifyDigest bool) (*Blueprint, error) {
 
 	// get blueprint resource from component descriptor
 	resource, err := GetBlueprintResourceFromComponentDescriptor(cd, blueprintName)
@@ -195,26 +218,38 @@ func (s *Store) Fetch(ctx context.Context,
 		}
 	}
 
-	if blueprint, err := s.Get(ctx, blueprintID); err == nil {
-		return blueprint, nil
+	if !verifyDigest || s.isVerified(blueprintID) {
+		if blueprint, err := s.Get(ctx, blueprintID); err == nil {
+			return blueprint, nil
+		}
 	}
 
-	return s.Store(ctx, blobResolver, resource, blueprintID, blobInfo)
+	return s.store(ctx, blobResolver, resource, blueprintID, blobInfo, verifyDigest)
+}
+
+func (s *Store) isVerified(blueprintID string) bool {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	return s.verified[blueprintID]
 }
 
 // Store stores a blueprint on the given filesystem.
 // It is expected that the bpReader contains a tar archive.
 // The blobInfo is optional and will be fetched from the BlobResolver if not defined.
 func (s *Store) Store(ctx context.Context, blobResolver ctf.BlobResolver, resource cdv2.Resource, blueprintID string, blobInfo *ctf.BlobInfo) (*Blueprint, error) {
+	if bp, err := s.Get(ctx, blueprintID); err == nil {
+		// this should never happen when used with the Fetch method.
+		return bp, nil
+	}
+	return s.store(ctx, blobResolver, resource, blueprintID, blobInfo, false)
+}
+
+func (s *Store) store(ctx context.Context, blobResolver ctf.BlobResolver, resource cdv2.Resource, blueprintID string, blobInfo *ctf.BlobInfo, verifyDigest bool) (*Blueprint, error) {
 	if s.closed {
 		return nil, StoreClosedError
 	}
 
 	bpPath := blueprintPath(blueprintID)
-	if bp, err := s.Get(ctx, blueprintID); err == nil {
-		// this should never happen when used with the Fetch method.
-		return bp, nil
-	}
 
 	s.mux.Lock()
 	defer s.mux.Unlock()
@@ -232,7 +267,12 @@ func (s *Store) Store(ctx context.Context, blobResolver ctf.BlobResolver, resour
 			return nil, fmt.Errorf("unable to get blob info: %w", err)
 		}
 	}
-	if err := FetchAndExtractBlueprint(ctx, s.fs, bpPath, blobResolver, resource, blobInfo); err != nil {
+	delete(s.verified, blueprintID)
+	if err := FetchAndExtractBlueprint(ctx, s.fs, bpPath, blobResolver, resource, blobInfo, verifyDigest); err != nil {
+		// remove the partially extracted blueprint so that it is not served from the store
+		if err2 := s.fs.RemoveAll(bpPath); err2 != nil {
+			s.log.Error(err2, "unable to cleanup directory")
+		}
 		return nil, err
 	}
 
@@ -241,6 +281,9 @@ func (s *Store) Store(ctx context.Context, blobResolver ctf.BlobResolver, resour
 		return nil, fmt.Errorf("unable to get size of blueprint directory: %w", err)
 	}
 	s.index.Add(blueprintID, size, time.Now())
+	if verifyDigest {
+		s.verified[blueprintID] = true
+	}
 	s.updateUsage(size)
 	StoredItems.Inc()
 	defer func() {
@@ -251,13 +294,15 @@ func (s *Store) Store(ctx context.Context, blobResolver ctf.BlobResolver, resour
 }
 
 // FetchAndExtractBlueprint fetches a blueprint from a remote blob resolver and extracts the tar to the given path.
+// If verifyDigest is set, the digest of the fetched blob is verified against the digest of the resource.
 func FetchAndExtractBlueprint(
 	ctx context.Context,
 	fs vfs.FileSystem,
 	bpPath string,
 	blobResolver ctf.BlobResolver,
 	resource cdv2.Resource,
-	blobInfo *ctf.BlobInfo) error {
+	blobInfo *ctf.BlobInfo,
+	verifyDigest bool) error {
 
 	mediaType, err := mediatype.Parse(blobInfo.MediaType)
 	if err != nil {
@@ -269,8 +314,9 @@ func FetchAndExtractBlueprint(
 	downloadCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
 	eg, downloadCtx := errgroup.WithContext(downloadCtx)
+	digester := verification.NewBlobDigester(resource)
 	eg.Go(func() error {
-		_, err := blobResolver.Resolve(downloadCtx, resource, utils.NewContextAwareWriter(downloadCtx, pw))
+		_, err := blobResolver.Resolve(downloadCtx, resource, utils.NewContextAwareWriter(downloadCtx, io.MultiWriter(pw, digester)))
 		if err != nil {
 			if err2 := pw.Close(); err2 != nil {
 				return errorsutil.NewAggregate([]error{err, err2})
@@ -299,9 +345,19 @@ func FetchAndExtractBlueprint(
 	if err := tar.ExtractTar(ctx, blobReader, fs, tar.ToPath(bpPath), tar.Overwrite(true)); err != nil {
 		return fmt.Errorf("unable to extract blueprint from blob: %w", err)
 	}
+	// read the remaining data so that the digest is calculated over the whole blob
+	if _, err := io.Copy(io.Discard, pr); err != nil {
+		return fmt.Errorf("unable to read blueprint blob: %w", err)
+	}
 	if err := eg.Wait(); err != nil {
 		return err
 	}
+	if !verifyDigest {
+		return nil
+	}
+	if err := digester.Verify(ctx, nil); err != nil {
+		return lserrors.NewWrappedError(err, "FetchAndExtractBlueprint", "VerifyDigest", err.Error(), lsv1alpha1.ErrorVerificationFailed)
+	}
 	return nil
 }
 
@@ -410,6 +466,7 @@ func (s *Store) RunGarbageCollection() {
 		if err := s.fs.RemoveAll(blueprintPath(item.Name)); err != nil {
 			s.log.Error(err, "unable to delete blueprint directory", "file", item.Name)
 		}
+		delete(s.verified, item.Name)
 		s.log.Debug("garbage collected", "item", item.Name)
 		s.updateUsage(-item.Size)
 		StoredItems.Dec()
@@ -15,7 +15,9 @@ import (
 	"github.com/mandelsoft/vfs/pkg/vfs"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/opencontainers/go-digest"
 
+	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/apis/mediatype"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/landscaper/registry/components/cdutils"
@@ -116,6 +118,88 @@ var _ = Describe("Store", func() {
 			Expect(bp.Info.Annotations).To(HaveKeyWithValue("test", "val"))
 		})
 
+		It("should verify the digest of the blueprint blob", func() {
+			ctx := context.Background()
+			memFs := memoryfs.New()
+			store, err := blueprints.NewStore(logging.Discard(), memFs, defaultStoreConfig)
+			Expect(err).ToNot(HaveOccurred())
+
+			newComponentDescriptor := func(digestValue string) *cdv2.ComponentDescriptor {
+				cd := &cdv2.ComponentDescriptor{}
+				cd.Name = "example.com/a"
+				cd.Version = "0.0.1"
+				res := cdv2.Resource{}
+				res.Name = "blueprint"
+				res.Version = "0.0.2"
+				res.Type = mediatype.BlueprintType
+				res.Digest = &cdv2.DigestSpec{
+					HashAlgorithm:          "sha256",
+					NormalisationAlgorithm: string(cdv2.GenericBlobDigestV1),
+					Value:                  digestValue,
+				}
+				cd.Resources = append(cd.Resources, res)
+				return cd
+			}
+
+			fetch := func(verify bool, digestValue func(blobInfo *ctf.BlobInfo) string) error {
+				data, blobInfo, err := bputils.NewBuilder().Blueprint(&lsv1alpha1.Blueprint{}).BuildResource(false)
+				Expect(err).ToNot(HaveOccurred())
+				defer data.Close()
+				cd := newComponentDescriptor(digestValue(blobInfo))
+				if verify {
+					_, err = store.FetchVerified(ctx, cd, defaultBlobResolver(data, blobInfo), "blueprint")
+				} else {
+					_, err = store.Fetch(ctx, cd, defaultBlobResolver(data, blobInfo), "blueprint")
+				}
+				return err
+			}
+			tampered := func(_ *ctf.BlobInfo) string { return digest.FromString("tampered").Encoded() }
+
+			err = fetch(true, tampered)
+			Expect(err).To(HaveOccurred())
+			Expect(lserrors.ContainsErrorCode(err, lsv1alpha1.ErrorVerificationFailed)).To(BeTrue())
+			// the rejected blueprint must not be served from the store
+			Expect(fetch(true, tampered)).ToNot(Succeed())
+
+			Expect(fetch(true, func(blobInfo *ctf.BlobInfo) string {
+				return digest.Digest(blobInfo.Digest).Encoded()
+			})).To(Succeed())
+		})
+
+		It("should only verify the digest of the blueprint blob if requested", func() {
+			ctx := context.Background()
+			memFs := memoryfs.New()
+			store, err := blueprints.NewStore(logging.Discard(), memFs, defaultStoreConfig)
+			Expect(err).ToNot(HaveOccurred())
+
+			cd := &cdv2.ComponentDescriptor{}
+			cd.Name = "example.com/a"
+			cd.Version = "0.0.1"
+			res := cdv2.Resource{}
+			res.Name = "blueprint"
+			res.Version = "0.0.2"
+			res.Type = mediatype.BlueprintType
+			res.Digest = &cdv2.DigestSpec{
+				HashAlgorithm:          "sha256",
+				NormalisationAlgorithm: string(cdv2.GenericBlobDigestV1),
+				Value:                  digest.FromString("tampered").Encoded(),
+			}
+			cd.Resources = append(cd.Resources, res)
+
+			data, blobInfo, err := bputils.NewBuilder().Blueprint(&lsv1alpha1.Blueprint{}).BuildResource(false)
+			Expect(err).ToNot(HaveOccurred())
+			defer data.Close()
+			_, err = store.Fetch(ctx, cd, defaultBlobResolver(data, blobInfo), "blueprint")
+			Expect(err).ToNot(HaveOccurred())
+
+			// the unverified blueprint is not served from the store if a verification is requested
+			data2, blobInfo2, err := bputils.NewBuilder().Blueprint(&lsv1alpha1.Blueprint{}).BuildResource(false)
+			Expect(err).ToNot(HaveOccurred())
+			defer data2.Close()
+			_, err = store.FetchVerified(ctx, cd, defaultBlobResolver(data2, blobInfo2), "blueprint")
+			Expect(lserrors.ContainsErrorCode(err, lsv1alpha1.ErrorVerificationFailed)).To(BeTrue())
+		})
+
 		It("should not update the stored blueprint if component descriptor and blueprint are immutable (indexmethod component descriptor)", func() {
 			ctx := context.Background()
 			memFs := memoryfs.New()
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentoverwrites
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	overwrites "github.com/gardener/landscaper/pkg/landscaper/registry/componentoverwrites"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// AddControllerToManager adds the controller that computes the status of ComponentVersionOverwrites to the manager.
+func AddControllerToManager(logger logging.Logger, mgr manager.Manager) error {
+	log := logger.Reconciles("componentVersionOverwrites", "ComponentVersionOverwrites")
+	ctrl := NewController(log, mgr.GetClient(), clock.RealClock{})
+
+	// installations are only relevant if their component reference or labels change
+	installationPredicates := builder.WithPredicates(predicate.Or(predicate.LabelChangedPredicate{},
+		predicate.GenerationChangedPredicate{}, resolvedComponentVersionChangedPredicate()))
+
+	return builder.ControllerManagedBy(mgr).
+		For(&lsv1alpha1.ComponentVersionOverwrites{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&source.Kind{Type: &lsv1alpha1.Context{}},
+			handler.EnqueueRequestsFromMapFunc(mapContextToOverwrites),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&source.Kind{Type: &lsv1alpha1.Installation{}},
+			handler.EnqueueRequestsFromMapFunc(ctrl.mapInstallationToOverwrites),
+			installationPredicates).
+		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
+		Complete(ctrl)
+}
+
+// Controller computes the installations that are affected by ComponentVersionOverwrites.
+type Controller struct {
+	log    logging.Logger
+	client client.Client
+	clock  clock.PassiveClock
+}
+
+// NewController returns a new ComponentVersionOverwrites controller.
+func NewController(logger logging.Logger, kubeClient client.Client, passiveClock clock.PassiveClock) *Controller {
+	return &Controller{
+		log:    logger,
+		client: kubeClient,
+		clock:  passiveClock,
+	}
+}
+
+// Writer returns a writer for the landscaper objects.
+func (c *Controller) Writer() *read_write_layer.Writer {
+	return read_write_layer.NewWriter(c.client)
+}
+
+// Reconcile updates the list of affected installations in the status of a ComponentVersionOverwrites object.
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger, ctx := c.log.StartReconcileAndAddToContext(ctx, req)
+
+	cvo := &lsv1alpha1.ComponentVersionOverwrites{}
+	if err := c.client.Get(ctx, req.NamespacedName, cvo); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Debug(err.Error())
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	contexts := &lsv1alpha1.ContextList{}
+	if err := c.client.List(ctx, contexts, client.InNamespace(cvo.Namespace)); err != nil {
+		return reconcile.Result{}, err
+	}
+	installations := &lsv1alpha1.InstallationList{}
+	if err := read_write_layer.ListInstallations(ctx, c.client, installations, client.InNamespace(cvo.Namespace)); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	now := c.clock.Now()
+	affected, err := overwrites.GetAffectedInstallations(cvo, contexts.Items, installations.Items, now)
+	if err != nil {
+		logger.Error(err, "unable to compute affected installations")
+		return reconcile.Result{}, nil
+	}
+
+	changed := !affectedInstallationsEqual(cvo.Status.AffectedInstallations, affected)
+	if changed || cvo.Status.ObservedGeneration != cvo.Generation {
+		if changed {
+			cvo.Status.LastUpdateTime = &metav1.Time{Time: now}
+		}
+		cvo.Status.ObservedGeneration = cvo.Generation
+		cvo.Status.AffectedInstallations = affected
+		if err := c.Writer().UpdateComponentVersionOverwritesStatus(ctx, read_write_layer.W000165, cvo); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	// the affected installations change when an overwrite starts or stops to apply
+	if next := overwrites.NextTransitionTime(cvo, now); next != nil {
+		return reconcile.Result{RequeueAfter: next.Sub(now)}, nil
+	}
+	return reconcile.Result{}, nil
+}
+
+// affectedInstallationsEqual compares two lists of affected installations by their serialized form,
+// as the raw data of repository contexts may differ for equal contexts.
+func affectedInstallationsEqual(a, b []lsv1alpha1.AffectedInstallation) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	dataA, errA := json.Marshal(a)
+	dataB, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(dataA, dataB)
+}
+
+// mapContextToOverwrites enqueues the ComponentVersionOverwrites that are referenced by a context.
+func mapContextToOverwrites(obj client.Object) []reconcile.Request {
+	lsCtx, ok := obj.(*lsv1alpha1.Context)
+	if !ok || len(lsCtx.ComponentVersionOverwritesReference) == 0 {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: kutil.ObjectKey(lsCtx.ComponentVersionOverwritesReference, lsCtx.Namespace)}}
+}
+
+// mapInstallationToOverwrites enqueues the ComponentVersionOverwrites that are referenced by the context of an installation.
+func (c *Controller) mapInstallationToOverwrites(obj client.Object) []reconcile.Request {
+	inst, ok := obj.(*lsv1alpha1.Installation)
+	if !ok || len(inst.Spec.Context) == 0 {
+		return nil
+	}
+	lsCtx := &lsv1alpha1.Context{}
+	if err := c.client.Get(context.Background(), types.NamespacedName{Name: inst.Spec.Context, Namespace: inst.Namespace}, lsCtx); err != nil {
+		if !apierrors.IsNotFound(err) {
+			c.log.Error(err, "unable to get context of installation", "installation", client.ObjectKeyFromObject(inst).String())
+		}
+		return nil
+	}
+	return mapContextToOverwrites(lsCtx)
+}
+
+// resolvedComponentVersionChangedPredicate triggers when the resolved component version of an installation changes.
+func resolvedComponentVersionChangedPredicate() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldInst, ok := e.ObjectOld.(*lsv1alpha1.Installation)
+			if !ok {
+				return false
+			}
+			newInst, ok := e.ObjectNew.(*lsv1alpha1.Installation)
+			if !ok {
+				return false
+			}
+			return !reflect.DeepEqual(oldInst.Status.ResolvedComponentVersion, newInst.Status.ResolvedComponentVersion)
+		},
+	}
+}
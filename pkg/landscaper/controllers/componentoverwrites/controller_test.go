@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentoverwrites_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	componentoverwritesctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/componentoverwrites"
+	testutils "github.com/gardener/landscaper/test/utils"
+)
+
+var _ = Describe("Reconcile", func() {
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+		fakeClock  *testing.FakePassiveClock
+		cvo        *lsv1alpha1.ComponentVersionOverwrites
+		inst       *lsv1alpha1.Installation
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fakeClock = testing.NewFakePassiveClock(time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC))
+
+		cvo = &lsv1alpha1.ComponentVersionOverwrites{}
+		cvo.Name = "overwrites"
+		cvo.Namespace = "test"
+		cvo.Generation = 1
+		cvo.Overwrites = lsv1alpha1.ComponentVersionOverwriteList{
+			{
+				Source: lsv1alpha1.ComponentVersionOverwriteReference{
+					ComponentName: "component.example.com",
+				},
+				Substitution: lsv1alpha1.ComponentVersionOverwriteReference{
+					Version: "v1.0.1",
+				},
+			},
+		}
+
+		lsCtx := &lsv1alpha1.Context{}
+		lsCtx.Name = "default"
+		lsCtx.Namespace = "test"
+		lsCtx.RepositoryContext = testutils.ExampleRepositoryContext()
+		lsCtx.ComponentVersionOverwritesReference = cvo.Name
+
+		inst = &lsv1alpha1.Installation{}
+		inst.Name = "a"
+		inst.Namespace = "test"
+		inst.Spec.Context = lsCtx.Name
+		inst.Spec.ComponentDescriptor = &lsv1alpha1.ComponentDescriptorDefinition{
+			Reference: &lsv1alpha1.ComponentDescriptorReference{
+				ComponentName: "component.example.com",
+				Version:       "v1.0.0",
+			},
+		}
+
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).
+			WithObjects(cvo, lsCtx, inst).Build()
+	})
+
+	It("should list the affected installations in the status", func() {
+		ctrl := componentoverwritesctrl.NewController(logging.Discard(), kubeClient, fakeClock)
+		res, err := ctrl.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(cvo)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.RequeueAfter).To(BeZero())
+
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(cvo), cvo)).To(Succeed())
+		Expect(cvo.Status.ObservedGeneration).To(Equal(int64(1)))
+		Expect(cvo.Status.LastUpdateTime).ToNot(BeNil())
+		Expect(cvo.Status.AffectedInstallations).To(HaveLen(1))
+		Expect(cvo.Status.AffectedInstallations[0].Name).To(Equal("a"))
+		Expect(cvo.Status.AffectedInstallations[0].Original.Version).To(Equal("v1.0.0"))
+		Expect(cvo.Status.AffectedInstallations[0].Substitution.Version).To(Equal("v1.0.1"))
+
+		// the status is not updated if the affected installations have not changed
+		resourceVersion := cvo.ResourceVersion
+		_, err = ctrl.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(cvo)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(cvo), cvo)).To(Succeed())
+		Expect(cvo.ResourceVersion).To(Equal(resourceVersion))
+	})
+
+	It("should requeue the overwrites when an overwrite stops to apply", func() {
+		cvo.Overwrites[0].ValidUntil = &metav1.Time{Time: fakeClock.Now().Add(time.Hour)}
+		Expect(kubeClient.Update(ctx, cvo)).To(Succeed())
+
+		ctrl := componentoverwritesctrl.NewController(logging.Discard(), kubeClient, fakeClock)
+		res, err := ctrl.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(cvo)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.RequeueAfter).To(Equal(time.Hour))
+
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(cvo), cvo)).To(Succeed())
+		Expect(cvo.Status.AffectedInstallations).To(HaveLen(1))
+	})
+
+	It("should evaluate the overwrites at the evaluation time of the current job of an installation", func() {
+		cvo.Overwrites[0].ValidUntil = &metav1.Time{Time: fakeClock.Now().Add(time.Hour)}
+		Expect(kubeClient.Update(ctx, cvo)).To(Succeed())
+
+		inst.Status.JobID = "job"
+		inst.Status.ComponentOverwritesEvaluation = &lsv1alpha1.ComponentOverwritesEvaluation{
+			JobID: "job",
+			Time:  metav1.NewTime(fakeClock.Now()),
+		}
+		Expect(kubeClient.Status().Update(ctx, inst)).To(Succeed())
+
+		// the overwrite has expired, but it still applies to the current job of the installation
+		fakeClock.SetTime(fakeClock.Now().Add(2 * time.Hour))
+		ctrl := componentoverwritesctrl.NewController(logging.Discard(), kubeClient, fakeClock)
+		_, err := ctrl.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(cvo)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(cvo), cvo)).To(Succeed())
+		Expect(cvo.Status.AffectedInstallations).To(HaveLen(1))
+		Expect(cvo.Status.LastUpdateTime.Time).To(BeTemporally("==", fakeClock.Now()))
+
+		// a new job evaluates the overwrites at the current time
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(inst), inst)).To(Succeed())
+		inst.Status.JobID = "next-job"
+		Expect(kubeClient.Status().Update(ctx, inst)).To(Succeed())
+		_, err = ctrl.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(cvo)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(cvo), cvo)).To(Succeed())
+		Expect(cvo.Status.AffectedInstallations).To(BeEmpty())
+	})
+
+})
@@ -20,6 +20,7 @@ import (
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/metrics"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
@@ -27,14 +28,18 @@ func (con *controller) Reconcile(ctx context.Context, req reconcile.Request) (re
 	logger := con.log.StartReconcile(req)
 	ctx = logging.NewContext(ctx, logger)
 
+	start := time.Now()
 	di := &lsv1alpha1.DeployItem{}
 	if err := read_write_layer.GetDeployItem(ctx, con.c, req.NamespacedName, di); err != nil {
 		if apierrors.IsNotFound(err) {
 			logger.Info(err.Error())
+			metrics.DeployItemPhases.Forget(req.NamespacedName)
 			return reconcile.Result{}, nil
 		}
 		return reconcile.Result{}, err
 	}
+	defer metrics.ObserveReconcileDuration(metrics.DeployItemKind, string(di.Spec.Type), start)
+	defer metrics.ObserveDeployItem(di)
 
 	if di.Status.GetJobID() == di.Status.JobIDFinished {
 		logger.Debug("deploy item is finished, nothing to do")
@@ -142,6 +147,8 @@ func (con *controller) writePickupTimeoutExceeded(ctx context.Context, di *lsv1a
 		logger.Error(err, "unable to set deployitem status")
 		return err
 	}
+	metrics.DeployItemTimeouts.WithLabelValues(metrics.PickupTimeout).Inc()
+	metrics.RecordFailure(metrics.DeployItemKind, di.Status.LastError.Codes...)
 
 	return nil
 }
@@ -186,6 +193,8 @@ func (con *controller) writeAbortingTimeoutExceeded(ctx context.Context, di *lsv
 		logger.Error(err, "unable to set deployitem status")
 		return err
 	}
+	metrics.DeployItemTimeouts.WithLabelValues(metrics.AbortingTimeout).Inc()
+	metrics.RecordFailure(metrics.DeployItemKind, di.Status.LastError.Codes...)
 
 	return nil
 }
@@ -231,6 +240,8 @@ func (con *controller) writeProgressingTimeoutExceeded(ctx context.Context, di *
 		logger.Error(err, "unable to update deploy item")
 		return err
 	}
+	metrics.DeployItemTimeouts.WithLabelValues(metrics.ProgressingTimeout).Inc()
+	metrics.DeployItemAborts.Inc()
 
 	return nil
 }
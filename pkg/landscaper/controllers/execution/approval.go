@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	"context"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// approvalRequired returns true if the execution requires an approval and the deploy item templates of
+// its current generation have neither been approved nor applied.
+func approvalRequired(exec *lsv1alpha1.Execution) bool {
+	if !exec.Spec.RequireApproval {
+		return false
+	}
+	if isApproved(exec) {
+		return false
+	}
+	return !deployItemsUpToDate(exec)
+}
+
+// isApproved returns true if the current generation of the execution has been approved.
+func isApproved(exec *lsv1alpha1.Execution) bool {
+	return exec.Status.Approval != nil && exec.Status.Approval.ApprovedGeneration == exec.Generation
+}
+
+// deployItemsUpToDate returns true if all deploy item templates of the current generation of the execution
+// have already been applied, e.g. because only the imports of the deploy items have changed.
+func deployItemsUpToDate(exec *lsv1alpha1.Execution) bool {
+	if len(exec.Status.ExecutionGenerations) != len(exec.Spec.DeployItems) {
+		return false
+	}
+
+	for _, tmpl := range exec.Spec.DeployItems {
+		upToDate := false
+		for _, gen := range exec.Status.ExecutionGenerations {
+			if gen.Name == tmpl.Name && gen.ObservedGeneration == exec.Generation {
+				upToDate = true
+				break
+			}
+		}
+		if !upToDate {
+			return false
+		}
+	}
+	return true
+}
+
+// handlePhaseAwaitingApproval checks whether the current generation of the execution has been approved.
+// An approval is either given by the approve-generation and approved-by annotations or directly in the status of the execution.
+// Approvals by annotation without approving user are rejected.
+// The execution is moved to the phase Init if it has been approved and to the phase InitDelete if it has been deleted.
+func (c *controller) handlePhaseAwaitingApproval(ctx context.Context, exec *lsv1alpha1.Execution) lserrors.LsError {
+	op := "handlePhaseAwaitingApproval"
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(exec).String()})
+
+	if !exec.DeletionTimestamp.IsZero() {
+		// nothing has been deployed for the current generation, so the deletion can start immediately
+		return c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecPhaseInitDelete, nil, read_write_layer.W000153)
+	}
+
+	if approvedGeneration, ok := exec.Annotations[lsv1alpha1.ApproveGenerationAnnotation]; ok {
+		approvedBy := exec.Annotations[lsv1alpha1.ApprovedByAnnotation]
+
+		delete(exec.Annotations, lsv1alpha1.ApproveGenerationAnnotation)
+		delete(exec.Annotations, lsv1alpha1.ApprovedByAnnotation)
+		if err := c.Writer().UpdateExecution(ctx, read_write_layer.W000154, exec); err != nil {
+			return lserrors.NewWrappedError(err, op, "UpdateExecution", err.Error())
+		}
+
+		// the approval is recorded after the annotations have been removed,
+		// as the update of the execution overwrites its in-memory status
+		if len(approvedBy) == 0 {
+			// the webhook verifies that the approved-by annotation identifies the user who has set the annotations
+			logger.Info("ignoring approval without approving user", "approvedGeneration", approvedGeneration)
+			c.eventRecorder.Eventf(exec, corev1.EventTypeWarning, "ApprovalRejected",
+				"approval of generation %s is rejected as the approving user is not identified by the annotation %s",
+				approvedGeneration, lsv1alpha1.ApprovedByAnnotation)
+		} else if approvedGeneration == strconv.FormatInt(exec.Generation, 10) {
+			exec.Status.Approval = &lsv1alpha1.ExecutionApproval{
+				ApprovedGeneration: exec.Generation,
+				ApprovedBy:         approvedBy,
+				ApprovalTime:       metav1.Now(),
+			}
+		} else {
+			logger.Info("ignoring approval of an outdated generation", "approvedGeneration", approvedGeneration, "generation", exec.Generation)
+		}
+	}
+
+	if !isApproved(exec) {
+		// remain in the phase; the execution is reconciled again when it is approved
+		return nil
+	}
+
+	c.eventRecorder.Eventf(exec, corev1.EventTypeNormal, "Approved",
+		"generation %d of the deploy items has been approved by %q", exec.Generation, exec.Status.Approval.ApprovedBy)
+	return c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecPhaseInit, nil, read_write_layer.W000156)
+}
@@ -7,7 +7,9 @@ package execution
 import (
 	"context"
 	"fmt"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -24,8 +26,10 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/landscaper/execution"
 	"github.com/gardener/landscaper/pkg/landscaper/operation"
+	"github.com/gardener/landscaper/pkg/metrics"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 // NewController creates a new execution controller that reconcile Execution resources.
@@ -48,15 +52,18 @@ type controller struct {
 func (c *controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 	logger := c.log.StartReconcile(req)
 	ctx = logging.NewContext(ctx, logger)
+	defer metrics.ObserveReconcileDuration(metrics.ExecutionKind, "", time.Now())
 
 	exec := &lsv1alpha1.Execution{}
 	if err := read_write_layer.GetExecution(ctx, c.client, req.NamespacedName, exec); err != nil {
 		if apierrors.IsNotFound(err) {
 			logger.Info(err.Error())
+			metrics.ExecutionPhases.Forget(req.NamespacedName)
 			return reconcile.Result{}, nil
 		}
 		return reconcile.Result{}, err
 	}
+	defer metrics.ObserveExecution(exec)
 
 	if exec.DeletionTimestamp.IsZero() && !kubernetes.HasFinalizer(exec, lsv1alpha1.LandscaperFinalizer) {
 		controllerutil.AddFinalizer(exec, lsv1alpha1.LandscaperFinalizer)
@@ -75,7 +82,9 @@ func (c *controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	if exec.Status.JobID != exec.Status.JobIDFinished {
 		// Execution is unfinished
 
+		ctx, span := tracing.StartJobSpan(ctx, "Execution", exec.Status.JobID, exec, tracing.KeyKind.String("Execution"))
 		err := c.handleReconcilePhase(ctx, exec)
+		tracing.EndSpan(span, err)
 		return reconcile.Result{}, err
 	} else {
 		// Execution is finished; nothing to do
@@ -105,6 +114,23 @@ func (c *controller) handleReconcilePhase(ctx context.Context, exec *lsv1alpha1.
 		}
 	}
 
+	if exec.Status.ExecutionPhase == lsv1alpha1.ExecPhaseInit && approvalRequired(exec) {
+		c.eventRecorder.Eventf(exec, corev1.EventTypeNormal, "AwaitingApproval",
+			"generation %d of the deploy items must be approved", exec.Generation)
+		if err := c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecPhaseAwaitingApproval, nil, read_write_layer.W000152); err != nil {
+			return err
+		}
+	}
+
+	if exec.Status.ExecutionPhase == lsv1alpha1.ExecPhaseAwaitingApproval {
+		if err := c.handlePhaseAwaitingApproval(ctx, exec); err != nil {
+			return err
+		}
+		if exec.Status.ExecutionPhase == lsv1alpha1.ExecPhaseAwaitingApproval {
+			return nil
+		}
+	}
+
 	if exec.Status.ExecutionPhase == lsv1alpha1.ExecPhaseInit {
 		if err := c.handlePhaseInit(ctx, exec); err != nil {
 			if lsutil.IsRecoverableError(err) {
@@ -213,7 +239,10 @@ func (c *controller) handlePhaseCompleting(ctx context.Context, exec *lsv1alpha1
 	forceReconcile := false
 	o := execution.NewOperation(operation.NewOperation(c.client, c.scheme, c.eventRecorder), exec, forceReconcile)
 
-	return o.CollectAndUpdateExportsNew(ctx)
+	ctx, span := tracing.StartSpan(ctx, "CollectExports")
+	err := o.CollectAndUpdateExportsNew(ctx)
+	tracing.EndSpan(span, err)
+	return err
 }
 
 func (c *controller) handlePhaseInitDelete(ctx context.Context, exec *lsv1alpha1.Execution) lserrors.LsError {
@@ -270,6 +299,20 @@ func (c *controller) handleInterruptOperation(ctx context.Context, exec *lsv1alp
 
 	op := "handleInterruptOperation"
 
+	if exec.Status.ExecutionPhase == lsv1alpha1.ExecPhaseAwaitingApproval {
+		// no deploy item has been touched so far, therefore only the execution must be finished
+		exec.Status.LastError = lserrors.UpdatedError(exec.Status.LastError,
+			"InterruptOperation",
+			"InterruptOperation",
+			"operation was interrupted while awaiting approval")
+		exec.Status.ExecutionPhase = lsv1alpha1.ExecPhaseFailed
+		exec.Status.JobIDFinished = exec.Status.JobID
+		if err := c.Writer().UpdateExecutionStatus(ctx, read_write_layer.W000155, exec); err != nil {
+			return lserrors.NewWrappedError(err, op, "UpdateExecutionStatus", err.Error())
+		}
+		return nil
+	}
+
 	forceReconcile := false
 	o := execution.NewOperation(operation.NewOperation(c.client, c.scheme, c.eventRecorder), exec, forceReconcile)
 
@@ -311,6 +354,10 @@ func (c *controller) setExecutionPhaseAndUpdate(ctx context.Context, exec *lsv1a
 		logger.Error(lsErr, "setExecutionPhaseAndUpdate")
 	}
 
+	if lsErr != nil && (phase == lsv1alpha1.ExecPhaseFailed || phase == lsv1alpha1.ExecPhaseDeleteFailed) {
+		metrics.RecordFailure(metrics.ExecutionKind, lserrors.CollectErrorCodes(lsErr)...)
+	}
+
 	exec.Status.ExecutionPhase = phase
 
 	if exec.Status.ExecutionPhase == lsv1alpha1.ExecPhaseSucceeded ||
@@ -6,12 +6,14 @@ package execution_test
 
 import (
 	"context"
+	"strconv"
 
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -100,6 +102,58 @@ var _ = Describe("Reconcile", func() {
 		Expect(apierrors.IsNotFound(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec))).To(BeTrue(), "expect the execution to be deleted")
 	})
 
+	It("should not create deploy items before the execution has been approved", func() {
+		ctx := context.Background()
+		exec := &lsv1alpha1.Execution{}
+		exec.GenerateName = "test-"
+		exec.Namespace = state.Namespace
+		exec.Spec.RequireApproval = true
+		exec.Spec.DeployItems = []lsv1alpha1.DeployItemTemplate{
+			{
+				Name: "def",
+				Type: "test-type",
+				Configuration: &runtime.RawExtension{
+					Raw: []byte(`
+{
+  "apiVersion": "sometest",
+  "kind": "somekind"
+}
+`),
+				},
+			},
+		}
+
+		Expect(state.Create(ctx, exec)).To(Succeed())
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec)).To(Succeed())
+		Expect(testutils.UpdateJobIdForExecution(ctx, testenv, exec)).To(Succeed())
+		testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(exec))
+
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec)).To(Succeed())
+		Expect(exec.Status.ExecutionPhase).To(Equal(lsv1alpha1.ExecPhaseAwaitingApproval))
+		Expect(exec.Status.Approval).To(BeNil())
+
+		items := &lsv1alpha1.DeployItemList{}
+		testutils.ExpectNoError(testenv.Client.List(ctx, items, client.InNamespace(state.Namespace)))
+		Expect(items.Items).To(BeEmpty())
+
+		// approve the current generation
+		metav1.SetMetaDataAnnotation(&exec.ObjectMeta, lsv1alpha1.ApproveGenerationAnnotation, strconv.FormatInt(exec.Generation, 10))
+		metav1.SetMetaDataAnnotation(&exec.ObjectMeta, lsv1alpha1.ApprovedByAnnotation, "operator")
+		testutils.ExpectNoError(testenv.Client.Update(ctx, exec))
+		_ = testutils.ShouldNotReconcile(ctx, ctrl, testutils.RequestFromObject(exec))
+
+		Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec)).To(Succeed())
+		Expect(exec.Status.ExecutionPhase).To(Equal(lsv1alpha1.ExecPhaseProgressing))
+		Expect(exec.Status.Approval).ToNot(BeNil())
+		Expect(exec.Status.Approval.ApprovedGeneration).To(Equal(exec.Generation))
+		Expect(exec.Status.Approval.ApprovedBy).To(Equal("operator"))
+		Expect(exec.Annotations).ToNot(HaveKey(lsv1alpha1.ApproveGenerationAnnotation))
+		Expect(exec.Annotations).ToNot(HaveKey(lsv1alpha1.ApprovedByAnnotation))
+
+		testutils.ExpectNoError(testenv.Client.List(ctx, items, client.InNamespace(state.Namespace)))
+		Expect(items.Items).To(HaveLen(1))
+	})
+
 	It("should cleanup the abort annotation of deploy items", func() {
 		ctx := context.Background()
 
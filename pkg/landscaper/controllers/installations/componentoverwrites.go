@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	reconcileReasonComponentOverwritesTransition = "componentOverwritesTransition"
+)
+
+// recomputeComponentOverwritesTransition requeues a succeeded root installation until the next time at which
+// a component version overwrite that selects the installation starts or stops to apply,
+// and triggers a reconcile of the installation once this time has passed.
+func (c *Controller) recomputeComponentOverwritesTransition(ctx context.Context, inst *lsv1alpha1.Installation, oldResult reconcile.Result, oldError error) (reconcile.Result, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	evaluation := inst.Status.ComponentOverwritesEvaluation
+	if oldError != nil || evaluation == nil || evaluation.NextTransitionTime == nil ||
+		evaluation.JobID != inst.Status.JobID ||
+		metav1.HasAnnotation(inst.ObjectMeta, lsv1alpha1.OperationAnnotation) ||
+		!installations.IsRootInstallation(inst) ||
+		!inst.DeletionTimestamp.IsZero() ||
+		inst.Status.JobID != inst.Status.JobIDFinished ||
+		inst.Status.InstallationPhase != lsv1alpha1.InstallationPhaseSucceeded {
+		return oldResult, oldError
+	}
+
+	now := c.clock.Now()
+	if now.Before(evaluation.NextTransitionTime.Time) {
+		return requeueNotLaterThan(oldResult, evaluation.NextTransitionTime.Sub(now)), nil
+	}
+
+	logger.Info("time window of a component version overwrite has started or ended, triggering reconcile",
+		"transitionTime", evaluation.NextTransitionTime.String())
+	lsv1alpha1helper.SetOperation(&inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
+	metav1.SetMetaDataAnnotation(&inst.ObjectMeta, lsv1alpha1.ReconcileReasonAnnotation, reconcileReasonComponentOverwritesTransition)
+	if err := c.Writer().UpdateInstallation(ctx, read_write_layer.W000173, inst); err != nil {
+		logger.Error(err, "failed to trigger reconcile for component version overwrite transition")
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{}, nil
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	lsoperation "github.com/gardener/landscaper/pkg/landscaper/operation"
+)
+
+var _ = Describe("Component Overwrites", func() {
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+		clok       *testing.FakePassiveClock
+		ctrl       *Controller
+		inst       *lsv1alpha1.Installation
+		start      time.Time
+	)
+
+	BeforeEach(func() {
+		ctx = logging.NewContext(context.Background(), logging.Discard())
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+		op := lsoperation.NewOperation(kubeClient, api.LandscaperScheme, record.NewFakeRecorder(1024))
+		start = time.Date(2022, time.June, 1, 8, 0, 0, 0, time.UTC)
+		clok = testing.NewFakePassiveClock(start)
+		ctrl = NewTestActuator(*op, logging.Discard(), clok, &config.LandscaperConfiguration{})
+
+		cvo := &lsv1alpha1.ComponentVersionOverwrites{}
+		cvo.Name = "overwrites"
+		cvo.Namespace = "test"
+		cvo.Overwrites = lsv1alpha1.ComponentVersionOverwriteList{
+			{
+				Source:       lsv1alpha1.ComponentVersionOverwriteReference{ComponentName: "example.com/a"},
+				Substitution: lsv1alpha1.ComponentVersionOverwriteReference{Version: "v1.0.1"},
+				ValidFrom:    &metav1.Time{Time: start.Add(time.Hour)},
+			},
+		}
+		Expect(kubeClient.Create(ctx, cvo)).To(Succeed())
+
+		lsCtx := &lsv1alpha1.Context{}
+		lsCtx.Name = "default"
+		lsCtx.Namespace = "test"
+		lsCtx.ComponentVersionOverwritesReference = cvo.Name
+		Expect(kubeClient.Create(ctx, lsCtx)).To(Succeed())
+
+		inst = &lsv1alpha1.Installation{}
+		inst.Name = "root"
+		inst.Namespace = "test"
+		inst.Spec.Context = lsCtx.Name
+		inst.Status.JobID = "job1"
+		inst.Status.JobIDFinished = "job1"
+		inst.Status.InstallationPhase = lsv1alpha1.InstallationPhaseSucceeded
+		Expect(kubeClient.Create(ctx, inst)).To(Succeed())
+	})
+
+	It("should evaluate the time windows of the overwrites once per job", func() {
+		Expect(installations.ComponentOverwritesEvaluationRequired(inst)).To(BeTrue())
+		Expect(installations.EvaluateComponentOverwrites(ctx, kubeClient, inst, start)).To(Succeed())
+
+		evaluation := inst.Status.ComponentOverwritesEvaluation
+		Expect(evaluation).ToNot(BeNil())
+		Expect(evaluation.JobID).To(Equal("job1"))
+		Expect(evaluation.NextTransitionTime).ToNot(BeNil())
+		Expect(evaluation.NextTransitionTime.Time).To(BeTemporally("==", start.Add(time.Hour)))
+		Expect(installations.ComponentOverwritesEvaluationRequired(inst)).To(BeFalse())
+		Expect(installations.GetComponentOverwritesEvaluationTime(inst)).To(BeTemporally("==", start))
+
+		inst.Status.JobID = "job2"
+		Expect(installations.ComponentOverwritesEvaluationRequired(inst)).To(BeTrue())
+	})
+
+	It("should requeue until the next transition and trigger a reconcile afterwards", func() {
+		Expect(installations.EvaluateComponentOverwrites(ctx, kubeClient, inst, start)).To(Succeed())
+
+		clok.SetTime(start.Add(20 * time.Minute))
+		result, err := ctrl.recomputeComponentOverwritesTransition(ctx, inst, reconcile.Result{}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(40 * time.Minute))
+		Expect(lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation)).To(BeFalse())
+
+		clok.SetTime(start.Add(time.Hour))
+		result, err = ctrl.recomputeComponentOverwritesTransition(ctx, inst, reconcile.Result{}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(inst), inst)).To(Succeed())
+		Expect(lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation)).To(BeTrue())
+		Expect(inst.Annotations).To(HaveKeyWithValue(lsv1alpha1.ReconcileReasonAnnotation, reconcileReasonComponentOverwritesTransition))
+	})
+
+})
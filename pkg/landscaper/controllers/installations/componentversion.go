@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	reconcileReasonComponentVersionUpgrade = "componentVersionUpgrade"
+)
+
+var (
+	defaultComponentVersionUpgradeInterval = 1 * time.Hour
+)
+
+// resolveComponentVersion resolves the version constraint of the component reference of the installation
+// and records the resolved version for the current job in the status of the installation.
+func (c *Controller) resolveComponentVersion(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	version, err := c.getLatestComponentVersion(ctx, inst)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("resolved component version", "versionConstraint", installations.GetComponentVersionConstraint(inst), "version", version)
+	inst.Status.ResolvedComponentVersion = &lsv1alpha1.ResolvedComponentVersion{
+		Constraint:    installations.GetComponentVersionConstraint(inst),
+		Version:       version,
+		JobID:         inst.Status.JobID,
+		LatestVersion: version,
+		LastCheckTime: metav1.NewTime(c.clock.Now()),
+	}
+	return nil
+}
+
+// getLatestComponentVersion returns the highest component version that satisfies the version constraint
+// of the component reference of the installation.
+// Overwrites of the component reference are not applied, as they take precedence over the resolved version.
+func (c *Controller) getLatestComponentVersion(ctx context.Context, inst *lsv1alpha1.Installation) (string, error) {
+	extCtx, err := installations.GetExternalContext(ctx, c.Client(), inst)
+	if err != nil {
+		return "", err
+	}
+	if extCtx.ConstrainedComponentRef == nil {
+		return "", fmt.Errorf("the component reference of the installation does not define a version constraint")
+	}
+
+	op := c.Operation.Copy()
+	if err := c.SetupRegistries(ctx, op, append(extCtx.RegistryPullSecrets(), inst.Spec.RegistryPullSecrets...), inst); err != nil {
+		return "", err
+	}
+	return installations.GetLatestComponentVersion(ctx, op.ComponentsRegistry(), extCtx.ConstrainedComponentRef)
+}
+
+// recomputeComponentVersionUpgrade periodically checks for newer component versions that satisfy the version
+// constraint of a succeeded root installation, and triggers a reconcile of the installation if one is found.
+func (c *Controller) recomputeComponentVersionUpgrade(ctx context.Context, inst *lsv1alpha1.Installation, oldResult reconcile.Result, oldError error) (reconcile.Result, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	if oldError != nil || !c.isComponentVersionUpgradeActivated(inst) ||
+		metav1.HasAnnotation(inst.ObjectMeta, lsv1alpha1.OperationAnnotation) ||
+		!installations.IsRootInstallation(inst) ||
+		!inst.DeletionTimestamp.IsZero() ||
+		inst.Status.JobID != inst.Status.JobIDFinished ||
+		inst.Status.InstallationPhase != lsv1alpha1.InstallationPhaseSucceeded ||
+		len(installations.GetResolvedComponentVersion(inst)) == 0 {
+		return oldResult, oldError
+	}
+
+	resolved := inst.Status.ResolvedComponentVersion
+	interval := c.getComponentVersionUpgradeInterval(inst)
+	nextCheckTime := resolved.LastCheckTime.Add(interval)
+	if c.clock.Now().Before(nextCheckTime) {
+		return requeueNotLaterThan(oldResult, nextCheckTime.Sub(c.clock.Now())), nil
+	}
+
+	latestVersion, err := c.getLatestComponentVersion(ctx, inst)
+	if err != nil {
+		logger.Error(err, "unable to check for newer component versions")
+	} else {
+		resolved.LatestVersion = latestVersion
+	}
+	resolved.LastCheckTime = metav1.NewTime(c.clock.Now())
+	if err := c.Writer().UpdateInstallationStatus(ctx, read_write_layer.W000163, inst); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err == nil && resolved.LatestVersion != resolved.Version {
+		logger.Info("newer component version found, triggering reconcile",
+			"version", resolved.Version, "latestVersion", resolved.LatestVersion)
+		lsv1alpha1helper.SetOperation(&inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
+		metav1.SetMetaDataAnnotation(&inst.ObjectMeta, lsv1alpha1.ReconcileReasonAnnotation, reconcileReasonComponentVersionUpgrade)
+		if err := c.Writer().UpdateInstallation(ctx, read_write_layer.W000164, inst); err != nil {
+			logger.Error(err, "failed to trigger reconcile for component version upgrade")
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
+	return requeueNotLaterThan(oldResult, interval), nil
+}
+
+func (c *Controller) isComponentVersionUpgradeActivated(inst *lsv1alpha1.Installation) bool {
+	return inst.Spec.AutomaticReconcile != nil && inst.Spec.AutomaticReconcile.ComponentVersionUpgrade != nil &&
+		len(installations.GetComponentVersionConstraint(inst)) != 0
+}
+
+func (c *Controller) getComponentVersionUpgradeInterval(inst *lsv1alpha1.Installation) time.Duration {
+	interval := inst.Spec.AutomaticReconcile.ComponentVersionUpgrade.Interval
+	if interval == nil {
+		return defaultComponentVersionUpgradeInterval
+	}
+	return interval.Duration
+}
+
+// requeueNotLaterThan returns the result with a requeue after the given duration,
+// unless the result already requests an earlier requeue.
+func requeueNotLaterThan(result reconcile.Result, after time.Duration) reconcile.Result {
+	if result.RequeueAfter > 0 && result.RequeueAfter < after {
+		return result
+	}
+	return reconcile.Result{
+		Requeue:      true,
+		RequeueAfter: after,
+	}
+}
@@ -8,10 +8,10 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/gardener/component-cli/ociclient/cache"
 	"github.com/google/uuid"
-	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
@@ -28,12 +28,14 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/api"
-	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
 	"github.com/gardener/landscaper/pkg/landscaper/operation"
+	componentsregistry "github.com/gardener/landscaper/pkg/landscaper/registry/components"
+	"github.com/gardener/landscaper/pkg/metrics"
 	"github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 const (
@@ -62,6 +64,14 @@ func NewController(logger logging.Logger,
 		logger.Debug("setup shared components registry  cache")
 	}
 
+	if lsConfig != nil && lsConfig.Registry.CTF != nil {
+		var err error
+		ctrl.CTFRegistry, err = componentsregistry.NewCTFClient(lsConfig.Registry.CTF.RootPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	op := operation.NewOperation(kubeClient, scheme, eventRecorder)
 	ctrl.Operation = *op
 	return ctrl, nil
@@ -86,19 +96,25 @@ type Controller struct {
 	clock       clock.PassiveClock
 	LsConfig    *config.LandscaperConfiguration
 	SharedCache cache.Cache
+	// CTFRegistry is the registry for component archives and CTF archives.
+	// It is shared by all reconciles, so that unchanged archives are only read once.
+	CTFRegistry componentsregistry.TypedRegistry
 }
 
 func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 	logger, ctx := c.log.StartReconcileAndAddToContext(ctx, req)
+	defer metrics.ObserveReconcileDuration(metrics.InstallationKind, "", time.Now())
 
 	inst := &lsv1alpha1.Installation{}
 	if err := read_write_layer.GetInstallation(ctx, c.Client(), req.NamespacedName, inst); err != nil {
 		if apierrors.IsNotFound(err) {
 			logger.Info(err.Error())
+			metrics.InstallationPhases.Forget(req.NamespacedName)
 			return reconcile.Result{}, nil
 		}
 		return reconcile.Result{}, err
 	}
+	defer metrics.ObserveInstallation(inst)
 
 	// default the installation as it not done by the Controller runtime
 	if err := c.updateInstallationWithDefaults(ctx, inst); err != nil {
@@ -126,7 +142,7 @@ func (c *Controller) updateInstallationWithDefaults(ctx context.Context, inst *l
 }
 
 func (c *Controller) handleAutomaticReconcile(ctx context.Context, inst *lsv1alpha1.Installation) (reconcile.Result, error) {
-	retryHelper := newRetryHelper(c.Client(), c.clock)
+	retryHelper := newRetryHelper(c.Client(), c.clock, c.LsConfig)
 
 	if err := retryHelper.preProcessRetry(ctx, inst); err != nil {
 		return reconcile.Result{}, err
@@ -136,6 +152,10 @@ func (c *Controller) handleAutomaticReconcile(ctx context.Context, inst *lsv1alp
 
 	result, err = retryHelper.recomputeRetry(ctx, inst, result, err)
 
+	result, err = c.recomputeComponentVersionUpgrade(ctx, inst, result, err)
+
+	result, err = c.recomputeComponentOverwritesTransition(ctx, inst, result, err)
+
 	return result, err
 }
 
@@ -157,6 +177,20 @@ func (c *Controller) reconcileInstallation(ctx context.Context, inst *lsv1alpha1
 		return reconcile.Result{}, nil
 	}
 
+	if lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.PlanOperation) {
+		if err := c.handlePlanOperation(ctx, inst); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.RollbackOperation) {
+		if err := c.handleRollbackOperation(ctx, inst); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
 	if !installations.IsRootInstallation(inst) && lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation) {
 		// only root installations could be triggered with operation annotation to prevent that end users interfere with overall
 		// algorithm
@@ -189,7 +223,9 @@ func (c *Controller) reconcileInstallation(ctx context.Context, inst *lsv1alpha1
 	// handle reconcile
 	if inst.Status.JobID != inst.Status.JobIDFinished {
 
+		ctx, span := tracing.StartJobSpan(ctx, "Installation", inst.Status.JobID, inst, tracing.KeyKind.String("Installation"))
 		err := c.handleReconcilePhase(ctx, inst)
+		tracing.EndSpan(span, err)
 		return reconcile.Result{}, err
 
 	} else {
@@ -198,24 +234,38 @@ func (c *Controller) reconcileInstallation(ctx context.Context, inst *lsv1alpha1
 	}
 }
 
-// initPrerequisites prepares installation operations by fetching context and registries, resolving the blueprint and creating an internal installation.
+// initPrerequisites prepares installation operations by fetching context and registries, verifying the component descriptor,
+// resolving the blueprint and creating an internal installation.
 // It does not modify the installation resource in the cluster in any way.
 func (c *Controller) initPrerequisites(ctx context.Context, inst *lsv1alpha1.Installation) (*installations.Operation, lserrors.LsError) {
 	currOp := "InitPrerequisites"
 	op := c.Operation.Copy()
 
-	lsCtx, err := installations.GetInstallationContext(ctx, c.Client(), inst)
+	ctx, span := tracing.StartSpan(ctx, "FetchComponentDescriptor")
+	defer span.End()
+
+	// a rollback uses the blueprint and component descriptor of the rolled back revision instead of the spec
+	jobInst, err := getJobInstallation(inst)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err, currOp, "GetRollbackRevision", err.Error())
+	}
+
+	lsCtx, err := installations.GetInstallationContext(ctx, c.Client(), jobInst)
 	if err != nil {
 		return nil, lserrors.NewWrappedError(err, currOp, "CalculateContext", err.Error())
 	}
 
-	if err := c.SetupRegistries(ctx, op, append(lsCtx.External.RegistryPullSecrets(), inst.Spec.RegistryPullSecrets...), inst); err != nil {
-		return nil, lserrors.NewWrappedError(err, currOp, "SetupRegistries", err.Error())
+	if err := c.SetupRegistries(ctx, op, append(lsCtx.External.RegistryPullSecrets(), inst.Spec.RegistryPullSecrets...), jobInst); err != nil {
+		return nil, lserrors.NewWrappedError(err, currOp, "SetupRegistries", err.Error(), lsv1alpha1.ErrorRegistryProblem)
+	}
+
+	if lsErr := verifyComponentDescriptor(ctx, op.ComponentsRegistry(), &lsCtx.External, inst); lsErr != nil {
+		return nil, lsErr
 	}
 
-	intBlueprint, err := blueprints.Resolve(ctx, op.ComponentsRegistry(), lsCtx.External.ComponentDescriptorRef(), inst.Spec.Blueprint)
+	intBlueprint, err := installations.ResolveBlueprint(ctx, op.ComponentsRegistry(), &lsCtx.External, jobInst.Spec.Blueprint)
 	if err != nil {
-		return nil, lserrors.NewWrappedError(err, currOp, "ResolveBlueprint", err.Error())
+		return nil, lserrors.NewWrappedError(err, currOp, "ResolveBlueprint", err.Error(), lsv1alpha1.ErrorRegistryProblem)
 	}
 
 	internalInstallation := installations.NewInstallationImportsAndBlueprint(inst, intBlueprint)
@@ -299,9 +349,8 @@ func (c *Controller) setInstallationPhaseAndUpdate(ctx context.Context, inst *ls
 
 	inst.Status.LastError = lserrors.TryUpdateLsError(inst.Status.LastError, lsError)
 
-	if inst.Status.LastError != nil {
-		lastErr := inst.Status.LastError
-		c.EventRecorder().Event(inst, corev1.EventTypeWarning, lastErr.Reason, lastErr.Message)
+	if lsError != nil && (phase == lsv1alpha1.InstallationPhaseFailed || phase == lsv1alpha1.InstallationPhaseDeleteFailed) {
+		metrics.RecordFailure(metrics.InstallationKind, lserrors.CollectErrorCodes(lsError)...)
 	}
 
 	inst.Status.InstallationPhase = phase
@@ -309,6 +358,7 @@ func (c *Controller) setInstallationPhaseAndUpdate(ctx context.Context, inst *ls
 		phase == lsv1alpha1.InstallationPhaseSucceeded ||
 		phase == lsv1alpha1.InstallationPhaseDeleteFailed {
 		inst.Status.JobIDFinished = inst.Status.JobID
+		inst.Status.RollbackRevision = nil
 	}
 
 	if err := c.Writer().UpdateInstallationStatus(ctx, writeID, inst); err != nil {
@@ -6,13 +6,18 @@ package installations_test
 
 import (
 	"context"
+	"encoding/json"
 
 	"k8s.io/utils/clock"
 
 	"github.com/gardener/component-spec/bindings-go/ctf"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/opencontainers/go-digest"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/gardener/landscaper/apis/config"
@@ -21,6 +26,7 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/api"
 	installationsctl "github.com/gardener/landscaper/pkg/landscaper/controllers/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
 	lsoperation "github.com/gardener/landscaper/pkg/landscaper/operation"
 	componentsregistry "github.com/gardener/landscaper/pkg/landscaper/registry/components"
 	testutils "github.com/gardener/landscaper/test/utils"
@@ -130,6 +136,156 @@ var _ = Describe("Installation Controller", func() {
 			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(subinst), subinst))
 			Expect(subinst.ObjectMeta.Annotations).To(HaveKeyWithValue(lsv1alpha1.OperationAnnotation, string(lsv1alpha1.InterruptOperation)))
 		})
+
+		It("should start a new job for the revision of a rollback annotation without changing the spec", func() {
+			// We consider a finished Installation with two revisions.
+			// The Installation has a rollback annotation for the first revision. After a reconciliation, a new job
+			// should be started for the first revision and the annotations should be removed.
+			// The spec of the installation must not be changed.
+			ctx := context.Background()
+
+			var err error
+			state, err = testenv.InitResources(ctx, "./testdata/state/test10")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "root"
+			inst.Namespace = state.Namespace
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Status.Revisions).To(HaveLen(2))
+			Expect(inst.Spec.ComponentDescriptor.Reference.Version).To(Equal("1.0.0"))
+
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.ObjectMeta.Annotations).NotTo(HaveKey(lsv1alpha1.OperationAnnotation))
+			Expect(inst.ObjectMeta.Annotations).NotTo(HaveKey(lsv1alpha1.RollbackRevisionAnnotation))
+			Expect(inst.Spec.ComponentDescriptor.Reference.Version).To(Equal("1.0.0"))
+			Expect(inst.Status.RollbackRevision).To(PointTo(BeEquivalentTo(1)))
+			Expect(inst.Status.JobID).NotTo(Equal("job2"))
+			Expect(inst.Status.JobIDFinished).To(Equal("job2"))
+		})
+
+		It("should compute a plan without creating subinstallations and executions", func() {
+			// We consider a finished Installation without subinstallations and execution, but with a plan annotation.
+			// After a reconciliation, the plan should contain the rendered subinstallation and deploy item,
+			// but neither of them should have been created and no new job should have been started.
+			ctx := context.Background()
+
+			var err error
+			state, err = testenv.InitResources(ctx, "./testdata/state/test12")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "root"
+			inst.Namespace = state.Namespace
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.ObjectMeta.Annotations).NotTo(HaveKey(lsv1alpha1.OperationAnnotation))
+			Expect(inst.Status.JobID).To(Equal("job1"))
+			Expect(inst.Status.JobIDFinished).To(Equal("job1"))
+			Expect(inst.Status.ExecutionReference).To(BeNil())
+			Expect(inst.Status.InstallationReferences).To(BeEmpty())
+
+			Expect(inst.Status.Plan).ToNot(BeNil())
+			Expect(inst.Status.Plan.LastError).To(BeNil())
+			Expect(inst.Status.Plan.ObservedGeneration).To(Equal(inst.Generation))
+			Expect(inst.Status.Plan.Subinstallations).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Name":      Equal("subinst"),
+				"Action":    Equal(lsv1alpha1.PlanActionCreate),
+				"Reference": BeNil(),
+				"Rendered":  BeNil(),
+			})))
+			Expect(inst.Status.Plan.DeployItems).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Name":      Equal("subexec"),
+				"Action":    Equal(lsv1alpha1.PlanActionCreate),
+				"Reference": BeNil(),
+				"Rendered":  BeNil(),
+			})))
+
+			details := getPlanDetails(ctx, inst)
+			Expect(details.Subinstallations).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Name":     Equal("subinst"),
+				"Rendered": Not(BeNil()),
+			})))
+			Expect(details.DeployItems).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Name":     Equal("subexec"),
+				"Rendered": Not(BeNil()),
+			})))
+
+			instList := &lsv1alpha1.InstallationList{}
+			testutils.ExpectNoError(testenv.Client.List(ctx, instList, client.InNamespace(state.Namespace)))
+			Expect(instList.Items).To(HaveLen(1))
+			execList := &lsv1alpha1.ExecutionList{}
+			testutils.ExpectNoError(testenv.Client.List(ctx, execList, client.InNamespace(state.Namespace)))
+			Expect(execList.Items).To(BeEmpty())
+			diList := &lsv1alpha1.DeployItemList{}
+			testutils.ExpectNoError(testenv.Client.List(ctx, diList, client.InNamespace(state.Namespace)))
+			Expect(diList.Items).To(BeEmpty())
+		})
+
+		It("should not show sensitive import values in the plan", func() {
+			// We consider a finished Installation with a plan annotation that imports a sensitive data object.
+			// The imported value is rendered into a deploy item whose current configuration contains an older value.
+			// Neither the rendered value nor the changed values should be visible in the plan.
+			ctx := context.Background()
+
+			var err error
+			state, err = testenv.InitResources(ctx, "./testdata/state/test13")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "root"
+			inst.Namespace = state.Namespace
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+
+			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
+			Expect(inst.Status.Plan).ToNot(BeNil())
+			Expect(inst.Status.Plan.LastError).To(BeNil())
+			Expect(inst.Status.Plan.DeployItems).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Name":            Equal("subexec"),
+				"Action":          Equal(lsv1alpha1.PlanActionUpdate),
+				"NumberOfChanges": Equal(1),
+			})))
+
+			details := getPlanDetails(ctx, inst)
+			Expect(details.DeployItems).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Name":    Equal("subexec"),
+				"Changes": ConsistOf(MatchFields(IgnoreExtras, Fields{"Path": ContainSubstring("password")})),
+			})))
+
+			for _, obj := range []interface{}{inst.Status.Plan, details} {
+				data, err := json.Marshal(obj)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(data)).ToNot(ContainSubstring("my-secret-value"))
+				Expect(string(data)).ToNot(ContainSubstring("my-old-secret-value"))
+			}
+			data, err := json.Marshal(details)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(ContainSubstring(dataobjects.RedactedValue))
+			Expect(string(data)).To(ContainSubstring("admin"))
+		})
 	})
 
 })
+
+// getPlanDetails returns the plan details that are referenced in the status of the given installation.
+func getPlanDetails(ctx context.Context, inst *lsv1alpha1.Installation) *lsv1alpha1.InstallationPlan {
+	Expect(inst.Status.Plan.DetailsSecretRef).ToNot(BeNil())
+	secret := &corev1.Secret{}
+	testutils.ExpectNoError(testenv.Client.Get(ctx, inst.Status.Plan.DetailsSecretRef.NamespacedName(), secret))
+	data := secret.Data[inst.Status.Plan.DetailsSecretRef.Key]
+	Expect(digest.FromBytes(data).String()).To(Equal(inst.Status.Plan.DetailsHash))
+
+	details := &lsv1alpha1.InstallationPlan{}
+	testutils.ExpectNoError(json.Unmarshal(data, details))
+	return details
+}
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/json"
+	"fmt"
+
+	"github.com/opencontainers/go-digest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/imports"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/subinstallations"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// maxPlanDetailsSize is the maximal size in bytes of the plan details that are stored for an installation.
+// Larger details are not stored, so that only the summary of the plan is available.
+const maxPlanDetailsSize = 512 * 1024
+
+// handlePlanOperation computes the plan of an installation, stores its details in a secret, writes its summary
+// into the status and removes the plan operation.
+// Subinstallations, executions and deploy items are not modified.
+func (c *Controller) handlePlanOperation(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(inst).String()})
+
+	// the plan is computed on a copy, as the computation modifies the in-memory status of the installation
+	plan := c.plan(ctx, inst.DeepCopy())
+	if plan.LastError != nil {
+		logger.Info("unable to compute plan", lc.KeyError, plan.LastError.Message)
+	}
+
+	if err := c.storePlanDetails(ctx, inst, plan); err != nil {
+		return err
+	}
+	inst.Status.Plan = plan
+
+	if err := c.Writer().UpdateInstallationStatus(ctx, read_write_layer.W000150, inst); err != nil {
+		return err
+	}
+
+	delete(inst.Annotations, lsv1alpha1.OperationAnnotation)
+	if err := c.Writer().UpdateInstallation(ctx, read_write_layer.W000151, inst); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// plan resolves the imports of the installation and renders its subinstallations and deploy items.
+// Errors are not returned but reported in the plan.
+func (c *Controller) plan(ctx context.Context, inst *lsv1alpha1.Installation) *lsv1alpha1.InstallationPlan {
+	currentOperation := "Plan"
+
+	plan := &lsv1alpha1.InstallationPlan{
+		ObservedGeneration: inst.GetGeneration(),
+		PlanTime:           metav1.NewTime(c.clock.Now()),
+	}
+
+	// the plan uses the component version that would be resolved by the next job, without recording it
+	if len(installations.GetComponentVersionConstraint(inst)) != 0 {
+		inst = inst.DeepCopy()
+		if err := c.resolveComponentVersion(ctx, inst); err != nil {
+			plan.LastError = lserrors.TryUpdateLsError(nil, lserrors.NewWrappedError(err, currentOperation, "ResolveComponentVersion", err.Error()))
+			return plan
+		}
+	}
+
+	instOp, imps, importsHash, _, fatalError, normalError := c.init(ctx, inst)
+	if fatalError != nil {
+		plan.LastError = lserrors.TryUpdateLsError(nil, fatalError)
+		return plan
+	} else if normalError != nil {
+		plan.LastError = lserrors.TryUpdateLsError(nil, normalError)
+		return plan
+	}
+	plan.ImportsHash = importsHash
+
+	if err := imports.NewConstructor(instOp).Construct(ctx, imps); err != nil {
+		plan.LastError = lserrors.TryUpdateLsError(nil, lserrors.NewWrappedError(err, currentOperation, "ConstructImports", err.Error()))
+		return plan
+	}
+
+	plannedSubinstallations, err := subinstallations.New(instOp).Plan(ctx)
+	if err != nil {
+		plan.LastError = lserrors.TryUpdateLsError(nil, lserrors.NewWrappedError(err, currentOperation, "PlanSubinstallations", err.Error()))
+		return plan
+	}
+	plan.Subinstallations = plannedSubinstallations
+
+	plannedDeployItems, err := executions.New(instOp).Plan(ctx, instOp.Inst)
+	if err != nil {
+		plan.LastError = lserrors.TryUpdateLsError(nil, lserrors.NewWrappedError(err, currentOperation, "PlanDeployItems", err.Error()))
+		return plan
+	}
+	plan.DeployItems = plannedDeployItems
+
+	return plan
+}
+
+// storePlanDetails stores the given plan including the rendered specifications and changes of all planned objects
+// in a secret owned by the installation. Afterwards, the plan only contains the summary of the planned objects,
+// so that the size of the status of the installation does not depend on the size of the rendered objects.
+func (c *Controller) storePlanDetails(ctx context.Context, inst *lsv1alpha1.Installation, plan *lsv1alpha1.InstallationPlan) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	secret := &corev1.Secret{}
+	secret.Name = planSecretName(inst)
+	secret.Namespace = inst.Namespace
+
+	if plan.LastError != nil {
+		// the details of a previous plan are outdated
+		if err := c.Client().Delete(ctx, secret); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("unable to delete outdated plan details: %w", err)
+		}
+		return nil
+	}
+
+	data, err := json.Marshal(plan)
+	if err != nil {
+		return fmt.Errorf("unable to marshal plan details: %w", err)
+	}
+	plan.DetailsHash = digest.FromBytes(data).String()
+	summarizePlannedObjects(plan.Subinstallations)
+	summarizePlannedObjects(plan.DeployItems)
+
+	if len(data) > maxPlanDetailsSize {
+		logger.Info("Plan details exceed the maximal size of a plan and are not stored",
+			"size", len(data), "maxSize", maxPlanDetailsSize)
+		if err := c.Client().Delete(ctx, secret); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("unable to delete outdated plan details: %w", err)
+		}
+		return nil
+	}
+
+	if _, err := controllerutil.CreateOrUpdate(ctx, c.Client(), secret, func() error {
+		secret.Data = map[string][]byte{
+			lsv1alpha1.DataObjectSecretDataKey: data,
+		}
+		return controllerutil.SetControllerReference(inst, secret, api.LandscaperScheme)
+	}); err != nil {
+		return fmt.Errorf("unable to store plan details: %w", err)
+	}
+
+	plan.DetailsSecretRef = &lsv1alpha1.SecretReference{
+		ObjectReference: lsv1alpha1.ObjectReference{
+			Name:      secret.Name,
+			Namespace: secret.Namespace,
+		},
+		Key: lsv1alpha1.DataObjectSecretDataKey,
+	}
+	return nil
+}
+
+// summarizePlannedObjects removes the rendered specifications and changes of the given planned objects.
+func summarizePlannedObjects(planned []lsv1alpha1.PlannedObject) {
+	for i := range planned {
+		planned[i].Rendered = nil
+		planned[i].Changes = nil
+	}
+}
+
+// planSecretName returns the name of the secret that contains the plan details of an installation.
+func planSecretName(inst *lsv1alpha1.Installation) string {
+	h := sha1.New()
+	_, _ = h.Write([]byte(fmt.Sprintf("%s/plan", inst.Name)))
+	// we need base32 encoding as some base64 (even url safe base64) characters are not supported by k8s
+	return base32.NewEncoding(lsv1alpha1helper.Base32EncodeStdLowerCase).WithPadding(base32.NoPadding).EncodeToString(h.Sum(nil))
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/installations/reconcilehelper"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/subinstallations"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
 )
 
 func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.Installation) lserrors.LsError {
@@ -194,6 +195,27 @@ func (c *Controller) handlePhaseInit(ctx context.Context, inst *lsv1alpha1.Insta
 		return lserrors.NewWrappedError(err, currentOperation, "CleanupExports", err.Error()), nil
 	}
 
+	// the time windows of component version overwrites are evaluated once per job,
+	// so that all steps of the job use the same overwrites
+	if installations.ComponentOverwritesEvaluationRequired(inst) {
+		if err := installations.EvaluateComponentOverwrites(ctx, c.Client(), inst, c.clock.Now()); err != nil {
+			return lserrors.NewWrappedError(err, currentOperation, "EvaluateComponentOverwrites", err.Error()), nil
+		}
+		if err := c.Writer().UpdateInstallationStatus(ctx, read_write_layer.W000172, inst); err != nil {
+			return lserrors.NewWrappedError(err, currentOperation, "UpdateComponentOverwritesEvaluation", err.Error()), nil
+		}
+	}
+
+	// the version constraint of the component reference is resolved once per job
+	if installations.ComponentVersionResolutionRequired(inst) {
+		if err := c.resolveComponentVersion(ctx, inst); err != nil {
+			return lserrors.NewWrappedError(err, currentOperation, "ResolveComponentVersion", err.Error(), lsv1alpha1.ErrorRegistryProblem), nil
+		}
+		if err := c.Writer().UpdateInstallationStatus(ctx, read_write_layer.W000162, inst); err != nil {
+			return lserrors.NewWrappedError(err, currentOperation, "UpdateResolvedComponentVersion", err.Error()), nil
+		}
+	}
+
 	instOp, imps, importsHash, predecessorMap, fatalError, normalError := c.init(ctx, inst)
 
 	if fatalError != nil {
@@ -238,6 +260,9 @@ func (c *Controller) init(ctx context.Context, inst *lsv1alpha1.Installation) (*
 
 	currentOperation := "init"
 
+	ctx, span := tracing.StartSpan(ctx, "ResolveImports")
+	defer span.End()
+
 	instOp, fatalError := c.initPrerequisites(ctx, inst)
 	if fatalError != nil {
 		return nil, nil, "", nil, fatalError, nil
@@ -457,13 +482,20 @@ func (c *Controller) handlePhaseCompleting(ctx context.Context, inst *lsv1alpha1
 	if err != nil {
 		return lserrors.NewWrappedError(err, currentOperation, "ConstructImportsForExports", err.Error()), nil
 	}
+	if err := c.applyRollbackImportValues(ctx, instOp); err != nil {
+		return lserrors.NewWrappedError(err, currentOperation, "ApplyRollbackImportValues", err.Error()), nil
+	}
 
-	dataExports, targetExports, err := exports.NewConstructor(instOp).Construct(ctx)
+	exportCtx, span := tracing.StartSpan(ctx, "CollectExports")
+	dataExports, targetExports, err := exports.NewConstructor(instOp).Construct(exportCtx)
 	if err != nil {
+		tracing.EndSpan(span, err)
 		return lserrors.NewWrappedError(err, currentOperation, "ConstructExports", err.Error()), nil
 	}
 
-	if err := instOp.CreateOrUpdateExports(ctx, dataExports, targetExports); err != nil {
+	err = instOp.CreateOrUpdateExports(exportCtx, dataExports, targetExports)
+	tracing.EndSpan(span, err)
+	if err != nil {
 		if apierrors.IsConflict(err) {
 			return nil, lserrors.NewWrappedError(err, currentOperation, "CreateOrUpdateExports", err.Error())
 		}
@@ -477,6 +509,10 @@ func (c *Controller) handlePhaseCompleting(ctx context.Context, inst *lsv1alpha1
 		return lserrors.NewWrappedError(err, currentOperation, "TriggerDependents", err.Error()), nil
 	}
 
+	if err := c.recordRevision(ctx, instOp); err != nil {
+		return nil, lserrors.NewWrappedError(err, currentOperation, "RecordRevision", err.Error())
+	}
+
 	return nil, nil
 }
 
@@ -488,18 +524,39 @@ func (c *Controller) CreateImportsAndSubobjects(ctx context.Context, op *install
 	if err := constructor.Construct(ctx, imps); err != nil {
 		return lserrors.NewWrappedError(err, currOp, "ConstructImports", err.Error())
 	}
+	if err := c.applyRollbackImportValues(ctx, op); err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ApplyRollbackImportValues", err.Error())
+	}
 
 	if err := op.CreateOrUpdateImports(ctx); err != nil {
 		return lserrors.NewWrappedError(err, currOp, "CreateOrUpdateImports", err.Error())
 	}
 
+	subInstCtx, span := tracing.StartSpan(ctx, "RenderSubinstallations")
 	subinstallation := subinstallations.New(op)
-	if err := subinstallation.Ensure(ctx); err != nil {
+	err := subinstallation.Ensure(subInstCtx)
+	tracing.EndSpan(span, err)
+	if err != nil {
 		return lserrors.NewWrappedError(err, currOp, "EnsureSubinstallations", err.Error())
 	}
 
+	ctx, span = tracing.StartSpan(ctx, "RenderDeployItems")
+	defer span.End()
 	exec := executions.New(op)
-	if err := exec.Ensure(ctx, inst); err != nil {
+	if rollbackRevision := inst.GetInstallation().Status.RollbackRevision; rollbackRevision != nil {
+		// a rollback re-applies the deploy item templates of the selected revision instead of the rendered ones
+		revision, ok := getRevision(inst.GetInstallation(), *rollbackRevision)
+		if !ok {
+			return lserrors.NewError(currOp, "GetRollbackRevision", fmt.Sprintf("revision %d not found", *rollbackRevision))
+		}
+		deployItems, err := c.getRevisionDeployItems(ctx, revision)
+		if err != nil {
+			return lserrors.NewWrappedError(err, currOp, "GetRevisionDeployItems", err.Error())
+		}
+		if err := exec.EnsureRevision(ctx, inst, deployItems); err != nil {
+			return lserrors.NewWrappedError(err, currOp, "ReconcileExecution", err.Error())
+		}
+	} else if err := exec.Ensure(ctx, inst); err != nil {
 		return lserrors.NewWrappedError(err, currOp, "ReconcileExecution", err.Error())
 	}
 
@@ -7,11 +7,14 @@ package installations
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
@@ -26,6 +29,8 @@ import (
 var (
 	SiblingImportError = errors.New("a sibling still imports some of the exports")
 	SiblingDeleteError = errors.New("deletion of a sibling failed")
+
+	SharedExportImportError = errors.New("an installation in another namespace still imports some of the shared exports")
 )
 
 func (c *Controller) handleDeletionPhaseInit(ctx context.Context, inst *lsv1alpha1.Installation) (fatalError lserrors.LsError, normalError lserrors.LsError) {
@@ -194,7 +199,26 @@ func (c *Controller) deleteAllowed(ctx context.Context, inst *lsv1alpha1.Install
 	}
 
 	// check if suitable for deletion
-	return checkIfSiblingImports(inst, installations.CreateInternalInstallationBases(siblings...))
+	fatalError, normalError = checkIfSiblingImports(inst, installations.CreateInternalInstallationBases(siblings...))
+	if fatalError != nil || normalError != nil {
+		return fatalError, normalError
+	}
+
+	// exports that are shared with other namespaces must not be removed while they are still imported there
+	importers, err := installations.GetSharedExportImporters(ctx, c.Client(), inst)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err,
+			op, "GetSharedExportImporters", err.Error(), lsv1alpha1.ErrorInternalProblem)
+	}
+	if len(importers) != 0 {
+		names := make([]string, len(importers))
+		for i := range importers {
+			names[i] = client.ObjectKeyFromObject(&importers[i]).String()
+		}
+		return nil, lserrors.NewWrappedError(SharedExportImportError,
+			op, "SharedExportImport", fmt.Sprintf("%s: %s", SharedExportImportError.Error(), strings.Join(names, ", ")))
+	}
+	return nil, nil
 }
 
 // checkIfSiblingImports checks if a sibling imports any of the installations exports.
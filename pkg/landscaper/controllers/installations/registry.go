@@ -47,6 +47,12 @@ func (c *Controller) SetupRegistries(ctx context.Context, op *operation.Operatio
 		}
 	}
 
+	if c.CTFRegistry != nil {
+		if err := compRegistry.Set(c.CTFRegistry); err != nil {
+			return err
+		}
+	}
+
 	// always add an oci client to support unauthenticated requests
 	ociConfigFiles := make([]string, 0)
 	if c.LsConfig.Registry.OCI != nil {
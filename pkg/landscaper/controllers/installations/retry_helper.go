@@ -6,6 +6,8 @@ package installations
 
 import (
 	"context"
+	"fmt"
+	"hash/fnv"
 	"time"
 
 	"k8s.io/utils/clock"
@@ -14,8 +16,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/gardener/landscaper/apis/config"
+	lscore "github.com/gardener/landscaper/apis/core"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
@@ -27,22 +32,48 @@ const (
 var (
 	defaultRetryDurationForFailed          = 5 * time.Minute
 	defaultRetryDurationForNewAndSucceeded = 24 * time.Hour
+	defaultFastRetryDurationForFailed      = 30 * time.Second
+	defaultRetryBackoffMaxInterval         = time.Hour
+	defaultRetryBackoffFactor              = int32(2)
+	defaultRetryBackoffJitterPercent       = int32(10)
 )
 
 type retryHelper struct {
-	cl     client.Client
-	writer *read_write_layer.Writer
-	clock  clock.PassiveClock
+	cl       client.Client
+	writer   *read_write_layer.Writer
+	clock    clock.PassiveClock
+	defaults *config.FailedReconcileDefaults
 }
 
-func newRetryHelper(cl client.Client, passiveClock clock.PassiveClock) *retryHelper {
+func newRetryHelper(cl client.Client, passiveClock clock.PassiveClock, lsConfig *config.LandscaperConfiguration) *retryHelper {
+	var defaults *config.FailedReconcileDefaults
+	if lsConfig != nil {
+		defaults = lsConfig.Controllers.Installations.FailedReconcile
+	}
+	if defaults == nil {
+		defaults = &config.FailedReconcileDefaults{}
+	}
 	return &retryHelper{
-		cl:     cl,
-		writer: read_write_layer.NewWriter(cl),
-		clock:  passiveClock,
+		cl:       cl,
+		writer:   read_write_layer.NewWriter(cl),
+		clock:    passiveClock,
+		defaults: defaults,
 	}
 }
 
+// retryPolicy is the policy for the automatically repeated reconciliations of a failed installation.
+// It combines the configuration of the installation with the defaults of the landscaper configuration.
+type retryPolicy struct {
+	interval          time.Duration
+	backoff           bool
+	factor            int32
+	maxInterval       time.Duration
+	jitterPercent     int32
+	noRetry           []lsv1alpha1.ErrorCode
+	fastRetry         []lsv1alpha1.ErrorCode
+	fastRetryInterval time.Duration
+}
+
 func (r *retryHelper) preProcessRetry(ctx context.Context, inst *lsv1alpha1.Installation) error {
 
 	if lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation) &&
@@ -110,8 +141,14 @@ func (r *retryHelper) isRetryActivatedForFailed(inst *lsv1alpha1.Installation) b
 }
 
 func (r *retryHelper) recomputeRetryForFailed(ctx context.Context, inst *lsv1alpha1.Installation, oldResult reconcile.Result, oldError error) (reconcile.Result, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
 	retryStatus := inst.Status.AutomaticReconcileStatus
 
+	if r.isRetryExcludedForFailed(inst) {
+		logger.Info("no retry of failed installation due to the error codes of the last error", "codes", inst.Status.LastError.Codes)
+		return oldResult, oldError
+	}
+
 	// first failure, or installation changed
 	if retryStatus == nil {
 		if err := r.addReconcileAnnotation(ctx, inst); err != nil {
@@ -269,12 +306,127 @@ func (r *retryHelper) getNextRetryTimeForSucceeded(inst *lsv1alpha1.Installation
 	return lastRetryTime.Add(r.getRetryIntervalForSucceeded(inst))
 }
 
+// isRetryExcludedForFailed returns true if the last error contains an error code for which no retries are configured.
+func (r *retryHelper) isRetryExcludedForFailed(inst *lsv1alpha1.Installation) bool {
+	return r.lastErrorContainsAnyErrorCode(inst, r.getRetryPolicyForFailed(inst).noRetry)
+}
+
+// getRetryIntervalForFailed returns the interval between the last and the next retry.
+// Errors with a fast retry error code are retried after the fast retry interval. Otherwise, the interval is increased
+// exponentially with the number of already executed retries if a backoff is configured.
 func (r *retryHelper) getRetryIntervalForFailed(inst *lsv1alpha1.Installation) time.Duration {
-	retryInterval := inst.Spec.AutomaticReconcile.FailedReconcile.Interval
-	if retryInterval == nil {
-		return defaultRetryDurationForFailed
+	policy := r.getRetryPolicyForFailed(inst)
+	if r.lastErrorContainsAnyErrorCode(inst, policy.fastRetry) {
+		return policy.fastRetryInterval
 	}
-	return retryInterval.Duration
+	if !policy.backoff {
+		return policy.interval
+	}
+
+	numberOfReconciles := 0
+	if inst.Status.AutomaticReconcileStatus != nil {
+		numberOfReconciles = inst.Status.AutomaticReconcileStatus.NumberOfReconciles
+	}
+
+	interval := policy.interval
+	for i := 1; i < numberOfReconciles && interval < policy.maxInterval; i++ {
+		if policy.factor <= 1 || interval > policy.maxInterval/time.Duration(policy.factor) {
+			interval = policy.maxInterval
+			break
+		}
+		interval *= time.Duration(policy.factor)
+	}
+	if interval > policy.maxInterval {
+		interval = policy.maxInterval
+	}
+	return interval + r.getRetryJitter(inst, numberOfReconciles, interval, policy.jitterPercent)
+}
+
+// getRetryJitter returns a jitter of up to the given percentage of the interval.
+// The jitter is derived from the installation and the number of the retry, so that it is stable across
+// reconciliations, but differs between installations that fail at the same time.
+func (r *retryHelper) getRetryJitter(inst *lsv1alpha1.Installation, numberOfReconciles int, interval time.Duration, jitterPercent int32) time.Duration {
+	maxJitter := int64(interval) / 100 * int64(jitterPercent)
+	if maxJitter <= 0 {
+		return 0
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(fmt.Sprintf("%s/%s/%s/%d", inst.UID, inst.Namespace, inst.Name, numberOfReconciles)))
+	return time.Duration(h.Sum64() % uint64(maxJitter))
+}
+
+func (r *retryHelper) getRetryPolicyForFailed(inst *lsv1alpha1.Installation) *retryPolicy {
+	failedReconcile := inst.Spec.AutomaticReconcile.FailedReconcile
+	policy := &retryPolicy{
+		interval:          defaultRetryDurationForFailed,
+		factor:            defaultRetryBackoffFactor,
+		maxInterval:       defaultRetryBackoffMaxInterval,
+		jitterPercent:     defaultRetryBackoffJitterPercent,
+		fastRetryInterval: defaultFastRetryDurationForFailed,
+	}
+
+	if failedReconcile.Interval != nil {
+		policy.interval = failedReconcile.Interval.Duration
+	} else if r.defaults.Interval != nil {
+		policy.interval = r.defaults.Interval.Duration
+	}
+
+	if failedReconcile.Backoff != nil {
+		policy.backoff = true
+		if failedReconcile.Backoff.Factor != nil {
+			policy.factor = *failedReconcile.Backoff.Factor
+		}
+		if failedReconcile.Backoff.MaxInterval != nil {
+			policy.maxInterval = failedReconcile.Backoff.MaxInterval.Duration
+		}
+		if failedReconcile.Backoff.JitterPercent != nil {
+			policy.jitterPercent = *failedReconcile.Backoff.JitterPercent
+		}
+	} else if r.defaults.Backoff != nil {
+		policy.backoff = true
+		if r.defaults.Backoff.Factor != nil {
+			policy.factor = *r.defaults.Backoff.Factor
+		}
+		if r.defaults.Backoff.MaxInterval != nil {
+			policy.maxInterval = r.defaults.Backoff.MaxInterval.Duration
+		}
+		if r.defaults.Backoff.JitterPercent != nil {
+			policy.jitterPercent = *r.defaults.Backoff.JitterPercent
+		}
+	}
+
+	if failedReconcile.ErrorCodes != nil {
+		policy.noRetry = failedReconcile.ErrorCodes.NoRetry
+		policy.fastRetry = failedReconcile.ErrorCodes.FastRetry
+		if failedReconcile.ErrorCodes.FastRetryInterval != nil {
+			policy.fastRetryInterval = failedReconcile.ErrorCodes.FastRetryInterval.Duration
+		} else if r.defaults.ErrorCodes != nil && r.defaults.ErrorCodes.FastRetryInterval != nil {
+			policy.fastRetryInterval = r.defaults.ErrorCodes.FastRetryInterval.Duration
+		}
+	} else if r.defaults.ErrorCodes != nil {
+		policy.noRetry = convertErrorCodes(r.defaults.ErrorCodes.NoRetry)
+		policy.fastRetry = convertErrorCodes(r.defaults.ErrorCodes.FastRetry)
+		if r.defaults.ErrorCodes.FastRetryInterval != nil {
+			policy.fastRetryInterval = r.defaults.ErrorCodes.FastRetryInterval.Duration
+		}
+	}
+
+	return policy
+}
+
+func (r *retryHelper) lastErrorContainsAnyErrorCode(inst *lsv1alpha1.Installation, codes []lsv1alpha1.ErrorCode) bool {
+	return inst.Status.LastError != nil && lserrors.ContainsAnyErrorCode(inst.Status.LastError.Codes, codes)
+}
+
+func convertErrorCodes(codes []lscore.ErrorCode) []lsv1alpha1.ErrorCode {
+	if codes == nil {
+		return nil
+	}
+	res := make([]lsv1alpha1.ErrorCode, len(codes))
+	for i, code := range codes {
+		res[i] = lsv1alpha1.ErrorCode(code)
+	}
+	return res
 }
 
 func (r *retryHelper) getRetryIntervalForSucceeded(inst *lsv1alpha1.Installation) time.Duration {
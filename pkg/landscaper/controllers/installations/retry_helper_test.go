@@ -249,5 +249,63 @@ var _ = Describe("Retry handler", func() {
 			Expect(inst.Status.AutomaticReconcileStatus.NumberOfReconciles).To(Equal(1))
 			Expect(inst.Status.AutomaticReconcileStatus.LastReconcileTime.Time.UnixMilli()).To(Equal(t6.UnixMilli()))
 		})
+
+		It("should retry a failed installation with exponential backoff", func() {
+			ctx := context.Background()
+
+			var err error
+			state, err = testenv.InitResources(ctx, "./testdata/state/test11")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testutils.CreateExampleDefaultContext(ctx, testenv.Client, state.Namespace)).To(Succeed())
+
+			inst := state.Installations[state.Namespace+"/root"]
+
+			// failedRetry reconciles the installation with a new job id and afterwards the failing installation
+			failedRetry := func() {
+				testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+				testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+				Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+			}
+
+			t1 := time.Date(2020, time.May, 1, 8, 0, 0, 0, time.UTC)
+			clok.SetTime(t1)
+
+			// installation fails; retry handler directly triggers the 1st retry
+			failedRetry()
+			Expect(inst.ObjectMeta.Annotations).To(HaveKeyWithValue(v1alpha1.ReconcileReasonAnnotation, "retry"))
+			Expect(inst.Status.AutomaticReconcileStatus).NotTo(BeNil())
+			Expect(inst.Status.AutomaticReconcileStatus.NumberOfReconciles).To(Equal(1))
+
+			// 1st retry fails; the 2nd retry is triggered after the initial interval
+			failedRetry()
+			Expect(inst.ObjectMeta.Annotations).NotTo(HaveKey(v1alpha1.ReconcileReasonAnnotation))
+
+			t2 := t1.Add(61 * time.Minute)
+			clok.SetTime(t2)
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+			Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+			Expect(inst.ObjectMeta.Annotations).To(HaveKeyWithValue(v1alpha1.ReconcileReasonAnnotation, "retry"))
+			Expect(inst.Status.AutomaticReconcileStatus.NumberOfReconciles).To(Equal(2))
+			Expect(inst.Status.AutomaticReconcileStatus.LastReconcileTime.Time.UnixMilli()).To(Equal(t2.UnixMilli()))
+
+			// 2nd retry fails; the interval is doubled but limited by the max interval of 90 minutes
+			failedRetry()
+			Expect(inst.ObjectMeta.Annotations).NotTo(HaveKey(v1alpha1.ReconcileReasonAnnotation))
+
+			t3 := t2.Add(61 * time.Minute)
+			clok.SetTime(t3)
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+			Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+			Expect(inst.ObjectMeta.Annotations).NotTo(HaveKey(v1alpha1.ReconcileReasonAnnotation)) // too early
+			Expect(inst.Status.AutomaticReconcileStatus.NumberOfReconciles).To(Equal(2))
+
+			t4 := t2.Add(91 * time.Minute)
+			clok.SetTime(t4)
+			testutils.ShouldReconcile(ctx, ctrl, testutils.RequestFromObject(inst))
+			Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).To(Succeed())
+			Expect(inst.ObjectMeta.Annotations).To(HaveKeyWithValue(v1alpha1.ReconcileReasonAnnotation, "retry"))
+			Expect(inst.Status.AutomaticReconcileStatus.NumberOfReconciles).To(Equal(3))
+			Expect(inst.Status.AutomaticReconcileStatus.LastReconcileTime.Time.UnixMilli()).To(Equal(t4.UnixMilli()))
+		})
 	})
 })
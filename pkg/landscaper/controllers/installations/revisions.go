@@ -0,0 +1,405 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/opencontainers/go-digest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	// defaultRevisionHistoryLimit is the number of revisions which are kept if the installation does not define a limit.
+	defaultRevisionHistoryLimit = 3
+
+	// maxRevisionDataSize is the maximal size in bytes of the imported values and deploy item templates
+	// that are recorded for a revision. Larger data is not recorded, so that the revision cannot be rolled back.
+	maxRevisionDataSize = 512 * 1024
+
+	// revisionImportValuesKey is the key of the imported values in the secret of a revision.
+	// The deploy item templates are stored with the key lsv1alpha1.DataObjectSecretDataKey.
+	revisionImportValuesKey = "imports"
+)
+
+// getRevisionHistoryLimit returns the maximal number of revisions which are kept in the status of the installation.
+func getRevisionHistoryLimit(inst *lsv1alpha1.Installation) int {
+	if inst.Spec.RevisionHistoryLimit == nil {
+		return defaultRevisionHistoryLimit
+	}
+	if *inst.Spec.RevisionHistoryLimit < 0 {
+		return 0
+	}
+	return int(*inst.Spec.RevisionHistoryLimit)
+}
+
+// getRevision returns the revision of the installation with the given number.
+func getRevision(inst *lsv1alpha1.Installation, revision int64) (*lsv1alpha1.InstallationRevision, bool) {
+	for i := range inst.Status.Revisions {
+		if inst.Status.Revisions[i].Revision == revision {
+			return &inst.Status.Revisions[i], true
+		}
+	}
+	return nil, false
+}
+
+// addRevision adds the revision to the history of the installation and removes the oldest revisions
+// that exceed the revision history limit.
+// A revision which has already been recorded for the same job is replaced.
+func addRevision(inst *lsv1alpha1.Installation, revision lsv1alpha1.InstallationRevision) {
+	revisions := inst.Status.Revisions
+	if len(revisions) != 0 && revisions[len(revisions)-1].JobID == revision.JobID {
+		revision.Revision = revisions[len(revisions)-1].Revision
+		revisions = revisions[:len(revisions)-1]
+	} else if len(revisions) != 0 {
+		revision.Revision = revisions[len(revisions)-1].Revision + 1
+	} else {
+		revision.Revision = 1
+	}
+	revisions = append(revisions, revision)
+
+	limit := getRevisionHistoryLimit(inst)
+	if len(revisions) > limit {
+		revisions = revisions[len(revisions)-limit:]
+	}
+	if len(revisions) == 0 {
+		revisions = nil
+	}
+	inst.Status.Revisions = revisions
+}
+
+// getJobInstallation returns the installation with the spec that is used by the current job.
+// If the job rolls back a revision, a copy of the installation with the blueprint and component descriptor
+// of the revision is returned, so that the spec of the installation itself is not changed.
+func getJobInstallation(inst *lsv1alpha1.Installation) (*lsv1alpha1.Installation, error) {
+	if inst.Status.RollbackRevision == nil {
+		return inst, nil
+	}
+	revision, ok := getRevision(inst, *inst.Status.RollbackRevision)
+	if !ok {
+		return nil, fmt.Errorf("revision %d not found", *inst.Status.RollbackRevision)
+	}
+	jobInst := inst.DeepCopy()
+	jobInst.Spec.Blueprint = *revision.Blueprint.DeepCopy()
+	jobInst.Spec.ComponentDescriptor = revision.ComponentDescriptor.DeepCopy()
+	return jobInst, nil
+}
+
+// recordRevision adds the state of the installation that has been used by the current job, the references to its
+// imports and its imported values and the deploy item templates of its execution as new revision to the status
+// of the installation.
+// The imported values and the deploy item templates are stored in a secret owned by the installation,
+// which is only referenced by the revision. The generations of sensitive imports are not recorded.
+// The status is not written.
+func (c *Controller) recordRevision(ctx context.Context, instOp *installations.Operation) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+	inst := instOp.Inst.GetInstallation()
+	jobInst, err := getJobInstallation(inst)
+	if err != nil {
+		return err
+	}
+
+	revision := lsv1alpha1.InstallationRevision{
+		JobID:               inst.Status.JobID,
+		ObservedGeneration:  inst.Status.ObservedGeneration,
+		ReconcileTime:       metav1.NewTime(c.clock.Now()),
+		RollbackOf:          inst.Status.RollbackRevision,
+		Blueprint:           *jobInst.Spec.Blueprint.DeepCopy(),
+		ComponentDescriptor: jobInst.Spec.ComponentDescriptor.DeepCopy(),
+		ImportsHash:         inst.Status.ImportsHash,
+	}
+	if revision.ComponentDescriptor != nil && revision.ComponentDescriptor.Inline == nil {
+		// the effective reference is recorded, so that a rollback neither depends on
+		// version constraints nor on component overwrites
+		if ref := instOp.Context().External.ComponentDescriptorRef(); ref != nil {
+			revision.ComponentDescriptor = &lsv1alpha1.ComponentDescriptorDefinition{Reference: ref}
+		}
+	}
+	for _, imp := range inst.Status.Imports {
+		recorded := imp.DeepCopy()
+		if instOp.Inst.IsSensitiveImport(imp.Name) {
+			// the generation of a sensitive import is derived from its value and is therefore not recorded
+			recorded.ConfigGeneration = ""
+		}
+		revision.Imports = append(revision.Imports, *recorded)
+	}
+
+	exec, err := executions.GetExecutionForInstallation(ctx, c.Client(), inst)
+	if err != nil {
+		return err
+	}
+
+	oldRevisions := append([]lsv1alpha1.InstallationRevision(nil), inst.Status.Revisions...)
+	addRevision(inst, revision)
+	latest := &inst.Status.Revisions[len(inst.Status.Revisions)-1]
+
+	data := map[string][]byte{}
+	importValues, err := json.Marshal(instOp.Inst.GetImports())
+	if err != nil {
+		return fmt.Errorf("unable to marshal imported values: %w", err)
+	}
+	latest.ImportValuesHash = digest.FromBytes(importValues).String()
+	data[revisionImportValuesKey] = importValues
+
+	if exec != nil {
+		deployItems, err := json.Marshal(exec.Spec.DeployItems)
+		if err != nil {
+			return fmt.Errorf("unable to marshal deploy item templates: %w", err)
+		}
+		latest.DeployItemsHash = digest.FromBytes(deployItems).String()
+		data[lsv1alpha1.DataObjectSecretDataKey] = deployItems
+	}
+
+	size := 0
+	for _, value := range data {
+		size += len(value)
+	}
+	if size > maxRevisionDataSize {
+		logger.Info("Imported values and deploy item templates exceed the maximal size of a revision and are not recorded",
+			"revision", latest.Revision, "size", size, "maxSize", maxRevisionDataSize)
+	} else {
+		name, err := c.storeRevisionData(ctx, inst, latest.Revision, data)
+		if err != nil {
+			return err
+		}
+		latest.ImportValuesSecretRef = newRevisionSecretReference(name, inst.Namespace, revisionImportValuesKey)
+		if exec != nil {
+			latest.DeployItemsSecretRef = newRevisionSecretReference(name, inst.Namespace, lsv1alpha1.DataObjectSecretDataKey)
+		}
+	}
+
+	return c.cleanupRevisionSecrets(ctx, inst, oldRevisions)
+}
+
+// getRevisionDeployItems returns the recorded deploy item templates of the given revision.
+func (c *Controller) getRevisionDeployItems(ctx context.Context, revision *lsv1alpha1.InstallationRevision) (lsv1alpha1.DeployItemTemplateList, error) {
+	if len(revision.DeployItemsHash) == 0 {
+		return nil, nil
+	}
+	var deployItems lsv1alpha1.DeployItemTemplateList
+	if err := c.getRevisionData(ctx, revision, "deploy item templates", revision.DeployItemsSecretRef, revision.DeployItemsHash, &deployItems); err != nil {
+		return nil, err
+	}
+	return deployItems, nil
+}
+
+// getRevisionImportValues returns the recorded imported values of the given revision.
+// The second return value is false if the values have not been recorded, which is the case for revisions
+// that have been recorded by an older version of the landscaper.
+func (c *Controller) getRevisionImportValues(ctx context.Context, revision *lsv1alpha1.InstallationRevision) (map[string]interface{}, bool, error) {
+	if len(revision.ImportValuesHash) == 0 {
+		return nil, false, nil
+	}
+	var values map[string]interface{}
+	if err := c.getRevisionData(ctx, revision, "imported values", revision.ImportValuesSecretRef, revision.ImportValuesHash, &values); err != nil {
+		return nil, false, err
+	}
+	return values, true, nil
+}
+
+// getRevisionData reads the recorded data of a revision from the referenced secret, verifies its digest
+// and decodes it into the given object.
+func (c *Controller) getRevisionData(ctx context.Context, revision *lsv1alpha1.InstallationRevision, description string,
+	ref *lsv1alpha1.SecretReference, hash string, into interface{}) error {
+	if ref == nil {
+		return fmt.Errorf("the %s of revision %d have not been recorded as they exceed the maximal size of a revision", description, revision.Revision)
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.Client().Get(ctx, ref.NamespacedName(), secret); err != nil {
+		return fmt.Errorf("unable to get %s of revision %d: %w", description, revision.Revision, err)
+	}
+	data := secret.Data[ref.Key]
+	if dig := digest.FromBytes(data).String(); dig != hash {
+		return fmt.Errorf("digest %q of the %s of revision %d does not match the expected digest %q", dig, description, revision.Revision, hash)
+	}
+	if err := json.Unmarshal(data, into); err != nil {
+		return fmt.Errorf("unable to decode %s of revision %d: %w", description, revision.Revision, err)
+	}
+	return nil
+}
+
+// storeRevisionData stores the recorded data of a revision in a secret owned by the installation
+// and returns the name of the secret.
+func (c *Controller) storeRevisionData(ctx context.Context, inst *lsv1alpha1.Installation, revision int64, data map[string][]byte) (string, error) {
+	secret := &corev1.Secret{}
+	secret.Name = revisionSecretName(inst, revision)
+	secret.Namespace = inst.Namespace
+	if _, err := controllerutil.CreateOrUpdate(ctx, c.Client(), secret, func() error {
+		secret.Data = data
+		return controllerutil.SetControllerReference(inst, secret, api.LandscaperScheme)
+	}); err != nil {
+		return "", fmt.Errorf("unable to store data of revision %d: %w", revision, err)
+	}
+	return secret.Name, nil
+}
+
+func newRevisionSecretReference(name, namespace, key string) *lsv1alpha1.SecretReference {
+	return &lsv1alpha1.SecretReference{
+		ObjectReference: lsv1alpha1.ObjectReference{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Key: key,
+	}
+}
+
+// cleanupRevisionSecrets removes the secrets of the given revisions that are not referenced by the current
+// revisions of the installation anymore.
+func (c *Controller) cleanupRevisionSecrets(ctx context.Context, inst *lsv1alpha1.Installation, oldRevisions []lsv1alpha1.InstallationRevision) error {
+	referenced := sets.NewString()
+	for _, revision := range inst.Status.Revisions {
+		referenced.Insert(revisionSecretNames(revision).UnsortedList()...)
+	}
+
+	for _, revision := range oldRevisions {
+		for _, name := range revisionSecretNames(revision).Difference(referenced).List() {
+			secret := &corev1.Secret{}
+			secret.Name = name
+			secret.Namespace = inst.Namespace
+			if err := c.Client().Delete(ctx, secret); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("unable to delete data of revision %d: %w", revision.Revision, err)
+			}
+		}
+	}
+	return nil
+}
+
+// revisionSecretNames returns the names of the secrets that are referenced by a revision.
+func revisionSecretNames(revision lsv1alpha1.InstallationRevision) sets.String {
+	names := sets.NewString()
+	if revision.ImportValuesSecretRef != nil {
+		names.Insert(revision.ImportValuesSecretRef.Name)
+	}
+	if revision.DeployItemsSecretRef != nil {
+		names.Insert(revision.DeployItemsSecretRef.Name)
+	}
+	return names
+}
+
+// revisionSecretName returns the name of the secret that contains the deploy item templates of a revision.
+func revisionSecretName(inst *lsv1alpha1.Installation, revision int64) string {
+	h := sha1.New()
+	_, _ = h.Write([]byte(fmt.Sprintf("%s/revision-%d", inst.Name, revision)))
+	// we need base32 encoding as some base64 (even url safe base64) characters are not supported by k8s
+	return base32.NewEncoding(lsv1alpha1helper.Base32EncodeStdLowerCase).WithPadding(base32.NoPadding).EncodeToString(h.Sum(nil))
+}
+
+// handleRollbackOperation starts a new job of a root installation which re-applies the selected revision.
+// The job uses the blueprint, component descriptor, imported values and deploy item templates of the revision,
+// which are selected by the rollback revision in the status. The spec of the installation is not changed.
+func (c *Controller) handleRollbackOperation(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(inst).String()})
+	currentOperation := "handleRollbackOperation"
+
+	if !installations.IsRootInstallation(inst) || !inst.DeletionTimestamp.IsZero() {
+		logger.Info("Removing rollback annotation. A rollback annotation has only an effect at root installations which are not deleted")
+		return c.removeRollbackAnnotations(ctx, inst)
+	}
+
+	if inst.Status.JobID != inst.Status.JobIDFinished {
+		// the rollback is started when the current job has finished
+		logger.Info("Delaying rollback until the current job has finished")
+		return nil
+	}
+
+	revision, err := c.getRollbackRevision(inst)
+	if err == nil && len(revision.DeployItemsHash) != 0 && revision.DeployItemsSecretRef == nil {
+		err = fmt.Errorf("revision %d cannot be rolled back as its data exceeds the maximal size of a revision", revision.Revision)
+	}
+	if err != nil {
+		inst.Status.LastError = lserrors.TryUpdateLsError(inst.Status.LastError,
+			lserrors.NewWrappedError(err, currentOperation, "GetRollbackRevision", err.Error()))
+		if err := c.Writer().UpdateInstallationStatus(ctx, read_write_layer.W000157, inst); err != nil {
+			return err
+		}
+		return c.removeRollbackAnnotations(ctx, inst)
+	}
+
+	logger.Info("Rolling back installation", "revision", revision.Revision)
+
+	rollbackRevision := revision.Revision
+	if err := c.removeRollbackAnnotations(ctx, inst); err != nil {
+		return err
+	}
+
+	inst.Status.JobID = uuid.New().String()
+	inst.Status.RollbackRevision = &rollbackRevision
+	return c.Writer().UpdateInstallationStatus(ctx, read_write_layer.W000158, inst)
+}
+
+// applyRollbackImportValues replaces the imported values by the recorded values of the revision
+// that is rolled back by the current job.
+func (c *Controller) applyRollbackImportValues(ctx context.Context, instOp *installations.Operation) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+	inst := instOp.Inst.GetInstallation()
+	if inst.Status.RollbackRevision == nil {
+		return nil
+	}
+	revision, ok := getRevision(inst, *inst.Status.RollbackRevision)
+	if !ok {
+		return fmt.Errorf("revision %d not found", *inst.Status.RollbackRevision)
+	}
+
+	values, ok, err := c.getRevisionImportValues(ctx, revision)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		logger.Info("Imported values of the revision have not been recorded, the current imports are used", "revision", revision.Revision)
+		return nil
+	}
+	instOp.Inst.SetImports(values)
+	return nil
+}
+
+// getRollbackRevision returns the revision selected by the rollback revision annotation.
+// Without annotation, the revision before the latest one is returned.
+func (c *Controller) getRollbackRevision(inst *lsv1alpha1.Installation) (*lsv1alpha1.InstallationRevision, error) {
+	value, ok := inst.Annotations[lsv1alpha1.RollbackRevisionAnnotation]
+	if !ok {
+		if len(inst.Status.Revisions) < 2 {
+			return nil, fmt.Errorf("installation has no previous revision")
+		}
+		return &inst.Status.Revisions[len(inst.Status.Revisions)-2], nil
+	}
+
+	number, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rollback revision %q: %w", value, err)
+	}
+
+	revision, ok := getRevision(inst, number)
+	if !ok {
+		return nil, fmt.Errorf("revision %d not found", number)
+	}
+	return revision, nil
+}
+
+func (c *Controller) removeRollbackAnnotations(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	delete(inst.Annotations, lsv1alpha1.OperationAnnotation)
+	delete(inst.Annotations, lsv1alpha1.RollbackRevisionAnnotation)
+	return c.Writer().UpdateInstallation(ctx, read_write_layer.W000159, inst)
+}
@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	lsoperation "github.com/gardener/landscaper/pkg/landscaper/operation"
+)
+
+var _ = Describe("Revisions", func() {
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+		ctrl       *Controller
+		inst       *lsv1alpha1.Installation
+		instOp     *installations.Operation
+	)
+
+	BeforeEach(func() {
+		ctx = logging.NewContext(context.Background(), logging.Discard())
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+		op := lsoperation.NewOperation(kubeClient, api.LandscaperScheme, record.NewFakeRecorder(1024))
+		ctrl = NewTestActuator(*op, logging.Discard(), clock.RealClock{}, &config.LandscaperConfiguration{})
+
+		inst = &lsv1alpha1.Installation{}
+		inst.Name = "root"
+		inst.Namespace = "test"
+		inst.UID = "abc"
+		inst.Spec.RevisionHistoryLimit = pointer.Int32(2)
+		inst.Status.Imports = []lsv1alpha1.ImportStatus{
+			{
+				Name:             "config",
+				Type:             lsv1alpha1.DataImportStatusType,
+				DataRef:          "my-do",
+				ConfigGeneration: "gen-1",
+			},
+		}
+		instOp = &installations.Operation{
+			Operation: op,
+			Inst:      installations.NewInstallationImportsAndBlueprint(inst, nil),
+		}
+		instOp.Inst.SetImports(map[string]interface{}{
+			"config": map[string]interface{}{"password": "my-secret-value"},
+		})
+	})
+
+	// createExecution creates or updates the execution of the installation with one deploy item template.
+	createExecution := func(config string) {
+		exec := &lsv1alpha1.Execution{}
+		exec.Name = inst.Name
+		exec.Namespace = inst.Namespace
+		_, err := controllerutil.CreateOrUpdate(ctx, kubeClient, exec, func() error {
+			exec.Spec.DeployItems = lsv1alpha1.DeployItemTemplateList{
+				{
+					Name:          "item",
+					Type:          "landscaper.gardener.cloud/mock",
+					Configuration: &runtime.RawExtension{Raw: []byte(config)},
+				},
+			}
+			return nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	It("should record import references and store the deploy item templates in a secret", func() {
+		createExecution(`{"key":"val"}`)
+		inst.Status.JobID = "job1"
+		Expect(ctrl.recordRevision(ctx, instOp)).To(Succeed())
+
+		Expect(inst.Status.Revisions).To(HaveLen(1))
+		revision := &inst.Status.Revisions[0]
+		Expect(revision.Imports).To(Equal(inst.Status.Imports))
+		Expect(revision.DeployItemsHash).ToNot(BeEmpty())
+		Expect(revision.DeployItemsSecretRef).ToNot(BeNil())
+
+		deployItems, err := ctrl.getRevisionDeployItems(ctx, revision)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deployItems).To(HaveLen(1))
+		Expect(deployItems[0].Configuration.Raw).To(MatchJSON(`{"key":"val"}`))
+	})
+
+	It("should record the imported values and use them for a rollback", func() {
+		createExecution(`{"key":"val"}`)
+		inst.Status.JobID = "job1"
+		Expect(ctrl.recordRevision(ctx, instOp)).To(Succeed())
+
+		revision := &inst.Status.Revisions[0]
+		Expect(revision.ImportValuesHash).ToNot(BeEmpty())
+		Expect(revision.ImportValuesSecretRef).ToNot(BeNil())
+		Expect(revision.ImportValuesSecretRef.Name).To(Equal(revision.DeployItemsSecretRef.Name))
+
+		instOp.Inst.SetImports(map[string]interface{}{
+			"config": map[string]interface{}{"password": "my-new-value"},
+		})
+		Expect(ctrl.applyRollbackImportValues(ctx, instOp)).To(Succeed())
+		Expect(instOp.Inst.GetImports()).To(HaveKeyWithValue("config", HaveKeyWithValue("password", "my-new-value")))
+
+		inst.Status.RollbackRevision = pointer.Int64(revision.Revision)
+		Expect(ctrl.applyRollbackImportValues(ctx, instOp)).To(Succeed())
+		Expect(instOp.Inst.GetImports()).To(HaveKeyWithValue("config", HaveKeyWithValue("password", "my-secret-value")))
+	})
+
+	It("should use the blueprint and component descriptor of the rolled back revision without changing the spec", func() {
+		inst.Spec.Blueprint.Reference = &lsv1alpha1.RemoteBlueprintReference{ResourceName: "blueprint-v1"}
+		inst.Status.JobID = "job1"
+		Expect(ctrl.recordRevision(ctx, instOp)).To(Succeed())
+
+		inst.Spec.Blueprint.Reference = &lsv1alpha1.RemoteBlueprintReference{ResourceName: "blueprint-v2"}
+		inst.Status.RollbackRevision = pointer.Int64(inst.Status.Revisions[0].Revision)
+		jobInst, err := getJobInstallation(inst)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(jobInst.Spec.Blueprint.Reference.ResourceName).To(Equal("blueprint-v1"))
+		Expect(inst.Spec.Blueprint.Reference.ResourceName).To(Equal("blueprint-v2"))
+
+		inst.Status.RollbackRevision = pointer.Int64(42)
+		_, err = getJobInstallation(inst)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should neither record the values nor the generations of sensitive imports", func() {
+		instOp.Inst.SetSensitiveImports(sets.NewString("config"))
+		createExecution(`{"key":"val"}`)
+		inst.Status.JobID = "job1"
+		Expect(ctrl.recordRevision(ctx, instOp)).To(Succeed())
+
+		revision := &inst.Status.Revisions[0]
+		Expect(revision.Imports).To(HaveLen(1))
+		Expect(revision.Imports[0].DataRef).To(Equal("my-do"))
+		Expect(revision.Imports[0].ConfigGeneration).To(BeEmpty())
+
+		data, err := json.Marshal(inst.Status.Revisions)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).ToNot(ContainSubstring("my-secret-value"))
+		Expect(string(data)).ToNot(ContainSubstring("gen-1"))
+	})
+
+	It("should remove the secrets of revisions that exceed the revision history limit", func() {
+		for _, jobID := range []string{"job1", "job2", "job3"} {
+			createExecution(`{"job":"` + jobID + `"}`)
+			inst.Status.JobID = jobID
+			Expect(ctrl.recordRevision(ctx, instOp)).To(Succeed())
+		}
+
+		Expect(inst.Status.Revisions).To(HaveLen(2))
+		secrets := &corev1.SecretList{}
+		Expect(kubeClient.List(ctx, secrets)).To(Succeed())
+		Expect(secrets.Items).To(HaveLen(2))
+		for _, revision := range inst.Status.Revisions {
+			Expect(secrets.Items).To(ContainElement(HaveField("Name", revision.DeployItemsSecretRef.Name)))
+		}
+	})
+
+	It("should not record deploy item templates that exceed the maximal size", func() {
+		createExecution(`{"key":"` + strings.Repeat("a", maxRevisionDataSize) + `"}`)
+		inst.Status.JobID = "job1"
+		Expect(ctrl.recordRevision(ctx, instOp)).To(Succeed())
+
+		revision := &inst.Status.Revisions[0]
+		Expect(revision.DeployItemsHash).ToNot(BeEmpty())
+		Expect(revision.DeployItemsSecretRef).To(BeNil())
+		_, err := ctrl.getRevisionDeployItems(ctx, revision)
+		Expect(err).To(HaveOccurred())
+	})
+
+})
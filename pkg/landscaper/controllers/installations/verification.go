@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/component-spec/bindings-go/ctf"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/registry/components/verification"
+)
+
+// verifyComponentDescriptor verifies the signature of the component descriptor of the installation
+// if a verification is configured in the context of the installation.
+// The result of the verification is recorded in the ComponentVerification condition of the installation.
+func verifyComponentDescriptor(ctx context.Context, compResolver ctf.ComponentResolver,
+	extCtx *installations.ExternalContext, inst *lsv1alpha1.Installation) lserrors.LsError {
+	currOp := "VerifyComponentDescriptor"
+	cdRef := extCtx.ComponentDescriptorRef()
+	if extCtx.Verification == nil || cdRef == nil {
+		return nil
+	}
+
+	cond := lsv1alpha1helper.GetOrInitCondition(inst.Status.Conditions, lsv1alpha1.ComponentVerificationCondition)
+	setFailedCondition := func(reason, message string, codes ...lsv1alpha1.ErrorCode) {
+		cond = lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse, reason, message, codes...)
+		inst.Status.Conditions = lsv1alpha1helper.MergeConditions(inst.Status.Conditions, cond)
+	}
+
+	verifier, err := verification.NewVerifier(extCtx.Verification)
+	if err != nil {
+		err = fmt.Errorf("invalid verification configuration in context %q: %w", extCtx.Name, err)
+		setFailedCondition("InvalidConfiguration", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+		return lserrors.NewWrappedError(err, currOp, "CreateVerifier", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	cd, err := compResolver.Resolve(ctx, cdRef.RepositoryContext, cdRef.ComponentName, cdRef.Version)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ResolveComponentDescriptor", err.Error())
+	}
+
+	if err := verifier.Verify(cd); err != nil {
+		setFailedCondition("VerificationFailed", err.Error(), lsv1alpha1.ErrorVerificationFailed)
+		return lserrors.NewWrappedError(err, currOp, "VerifySignature", err.Error(), lsv1alpha1.ErrorVerificationFailed)
+	}
+
+	cond = lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionTrue, "Verified",
+		fmt.Sprintf("Signature %q of component descriptor %s:%s is verified", extCtx.Verification.SignatureName, cd.GetName(), cd.GetVersion()))
+	inst.Status.Conditions = lsv1alpha1helper.MergeConditions(inst.Status.Conditions, cond)
+	return nil
+}
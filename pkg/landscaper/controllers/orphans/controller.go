@@ -0,0 +1,237 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package orphans
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// AddControllerToManager adds the controller that reports and deletes orphaned DataObjects and Targets to the manager.
+func AddControllerToManager(logger logging.Logger, mgr manager.Manager, cfg config.OrphansController) error {
+	log := logger.Reconciles("orphans", "OrphanReport")
+	if cfg.Disable {
+		log.Info("Orphans controller is disabled")
+		return nil
+	}
+	ctrl := NewController(log, mgr.GetClient(), clock.RealClock{}, cfg)
+
+	// data objects and targets are only relevant if they are created, deleted or their labels change
+	labelPredicates := builder.WithPredicates(predicate.LabelChangedPredicate{})
+
+	return builder.ControllerManagedBy(mgr).
+		For(&lsv1alpha1.OrphanReport{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&source.Kind{Type: &lsv1alpha1.Installation{}},
+			handler.EnqueueRequestsFromMapFunc(mapToOrphanReport),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&source.Kind{Type: &lsv1alpha1.DataObject{}},
+			handler.EnqueueRequestsFromMapFunc(mapToOrphanReport),
+			labelPredicates).
+		Watches(&source.Kind{Type: &lsv1alpha1.Target{}},
+			handler.EnqueueRequestsFromMapFunc(mapToOrphanReport),
+			labelPredicates).
+		WithOptions(utils.ConvertCommonControllerConfigToControllerOptions(cfg.CommonControllerConfig)).
+		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
+		Complete(ctrl)
+}
+
+// Controller detects the DataObjects and Targets of a namespace that are neither produced nor consumed by any installation.
+// The orphaned objects are reported in the OrphanReport of the namespace
+// and are deleted after a grace period if configured.
+type Controller struct {
+	log    logging.Logger
+	client client.Client
+	clock  clock.PassiveClock
+	config config.OrphansController
+}
+
+// NewController returns a new orphans controller.
+func NewController(logger logging.Logger, kubeClient client.Client, passiveClock clock.PassiveClock, cfg config.OrphansController) *Controller {
+	return &Controller{
+		log:    logger,
+		client: kubeClient,
+		clock:  passiveClock,
+		config: cfg,
+	}
+}
+
+// Writer returns a writer for the landscaper objects.
+func (c *Controller) Writer() *read_write_layer.Writer {
+	return read_write_layer.NewWriter(c.client)
+}
+
+// Reconcile updates the OrphanReport of a namespace and deletes orphaned objects whose grace period has expired.
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger, ctx := c.log.StartReconcileAndAddToContext(ctx, req)
+
+	report := &lsv1alpha1.OrphanReport{}
+	report.Name = lsv1alpha1.OrphanReportName
+	report.Namespace = req.Namespace
+	reportExists := true
+	if err := c.client.Get(ctx, kutil.ObjectKeyFromObject(report), report); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return reconcile.Result{}, err
+		}
+		reportExists = false
+	}
+
+	installations := &lsv1alpha1.InstallationList{}
+	if err := read_write_layer.ListInstallations(ctx, c.client, installations, client.InNamespace(req.Namespace)); err != nil {
+		return reconcile.Result{}, err
+	}
+	dataObjects := &lsv1alpha1.DataObjectList{}
+	if err := c.client.List(ctx, dataObjects, client.InNamespace(req.Namespace)); err != nil {
+		return reconcile.Result{}, err
+	}
+	targets := &lsv1alpha1.TargetList{}
+	if err := c.client.List(ctx, targets, client.InNamespace(req.Namespace)); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	now := c.clock.Now()
+	var gracePeriod *time.Duration
+	if c.config.DeleteOrphans {
+		gracePeriod = new(time.Duration)
+		if c.config.GracePeriod != nil {
+			*gracePeriod = c.config.GracePeriod.Duration
+		}
+	}
+
+	orphanedDataObjects, orphanedTargets := FindOrphans(installations.Items, dataObjects.Items, targets.Items)
+	orphanedDataObjects = MergeOrphans(report.Status.DataObjects, orphanedDataObjects, now, gracePeriod)
+	orphanedTargets = MergeOrphans(report.Status.Targets, orphanedTargets, now, gracePeriod)
+
+	var err error
+	orphanedDataObjects, err = c.deleteExpired(ctx, orphanedDataObjects, now, func(name string) error {
+		do := &lsv1alpha1.DataObject{}
+		do.Name = name
+		do.Namespace = req.Namespace
+		return c.Writer().DeleteDataObject(ctx, read_write_layer.W000167, do)
+	})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	orphanedTargets, err = c.deleteExpired(ctx, orphanedTargets, now, func(name string) error {
+		target := &lsv1alpha1.Target{}
+		target.Name = name
+		target.Namespace = req.Namespace
+		return c.Writer().DeleteTarget(ctx, read_write_layer.W000168, target)
+	})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	changed := !orphansEqual(report.Status.DataObjects, orphanedDataObjects) || !orphansEqual(report.Status.Targets, orphanedTargets)
+	if changed && (reportExists || len(orphanedDataObjects) != 0 || len(orphanedTargets) != 0) {
+		logger.Info("Orphaned objects changed", "dataObjects", len(orphanedDataObjects), "targets", len(orphanedTargets))
+		if _, err := c.Writer().CreateOrUpdateOrphanReport(ctx, read_write_layer.W000166, report, func() error {
+			report.Status.LastUpdateTime = metav1.Time{Time: now}
+			report.Status.DataObjects = orphanedDataObjects
+			report.Status.Targets = orphanedTargets
+			return nil
+		}); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if next := nextDeletionTime(append(orphanedDataObjects, orphanedTargets...)); next != nil {
+		return reconcile.Result{RequeueAfter: next.Sub(now)}, nil
+	}
+	return reconcile.Result{}, nil
+}
+
+// deleteExpired deletes the orphaned objects whose deletion time has passed
+// and returns the remaining orphaned objects.
+func (c *Controller) deleteExpired(ctx context.Context, orphans []lsv1alpha1.OrphanedObject, now time.Time,
+	deleteFunc func(name string) error) ([]lsv1alpha1.OrphanedObject, error) {
+	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "deleteExpired"})
+	remaining := make([]lsv1alpha1.OrphanedObject, 0, len(orphans))
+	for _, orphan := range orphans {
+		if orphan.DeletionTime == nil || orphan.DeletionTime.After(now) {
+			remaining = append(remaining, orphan)
+			continue
+		}
+		logger.Info("Deleting orphaned object", "name", orphan.Name, "reason", string(orphan.Reason))
+		if err := deleteFunc(orphan.Name); err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	if len(remaining) == 0 {
+		return nil, nil
+	}
+	return remaining, nil
+}
+
+// nextDeletionTime returns the earliest deletion time of the orphaned objects.
+func nextDeletionTime(orphans []lsv1alpha1.OrphanedObject) *time.Time {
+	var next *time.Time
+	for _, orphan := range orphans {
+		if orphan.DeletionTime == nil {
+			continue
+		}
+		if next == nil || orphan.DeletionTime.Time.Before(*next) {
+			t := orphan.DeletionTime.Time
+			next = &t
+		}
+	}
+	return next
+}
+
+// orphansEqual compares two lists of orphaned objects.
+// Nil and empty lists are considered to be equal.
+func orphansEqual(a, b []lsv1alpha1.OrphanedObject) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !orphanEqual(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func orphanEqual(a, b lsv1alpha1.OrphanedObject) bool {
+	// times are compared with second precision as they are serialized with second precision
+	if a.OrphanedSince.Unix() != b.OrphanedSince.Unix() {
+		return false
+	}
+	if (a.DeletionTime == nil) != (b.DeletionTime == nil) ||
+		a.DeletionTime != nil && a.DeletionTime.Unix() != b.DeletionTime.Unix() {
+		return false
+	}
+	a.OrphanedSince, b.OrphanedSince = metav1.Time{}, metav1.Time{}
+	a.DeletionTime, b.DeletionTime = nil, nil
+	return reflect.DeepEqual(a, b)
+}
+
+// mapToOrphanReport enqueues the OrphanReport of the namespace of an object.
+func mapToOrphanReport(obj client.Object) []reconcile.Request {
+	return []reconcile.Request{{NamespacedName: kutil.ObjectKey(lsv1alpha1.OrphanReportName, obj.GetNamespace())}}
+}
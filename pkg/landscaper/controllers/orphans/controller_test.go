@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package orphans_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/controllers/orphans"
+)
+
+var _ = Describe("Reconcile", func() {
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+		fakeClock  *testing.FakePassiveClock
+		req        reconcile.Request
+		orphanedDO *lsv1alpha1.DataObject
+		exportedDO *lsv1alpha1.DataObject
+		orphanedT  *lsv1alpha1.Target
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fakeClock = testing.NewFakePassiveClock(time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC))
+		req = reconcile.Request{NamespacedName: kutil.ObjectKey(lsv1alpha1.OrphanReportName, "test")}
+
+		inst := newInstallation("root", "")
+		inst.Spec.Exports.Data = []lsv1alpha1.DataExport{{Name: "a", DataRef: "root-a"}}
+
+		exportedDO = newDataObject("exported", "", "Inst.root", lsv1alpha1.ExportDataObjectSourceType, "root-a")
+		orphanedDO = newDataObject("orphaned", "", "Inst.root", lsv1alpha1.ExportDataObjectSourceType, "old-a")
+		orphanedT = newTarget("orphaned", "", "Inst.deleted", lsv1alpha1.ExportDataObjectSourceType, "t")
+
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).
+			WithObjects(inst, exportedDO, orphanedDO, orphanedT).Build()
+	})
+
+	getReport := func() *lsv1alpha1.OrphanReport {
+		report := &lsv1alpha1.OrphanReport{}
+		Expect(kubeClient.Get(ctx, req.NamespacedName, report)).To(Succeed())
+		return report
+	}
+
+	It("should report orphaned objects without deleting them", func() {
+		ctrl := orphans.NewController(logging.Discard(), kubeClient, fakeClock, config.OrphansController{})
+		res, err := ctrl.Reconcile(ctx, req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.RequeueAfter).To(BeZero())
+
+		report := getReport()
+		Expect(report.Status.DataObjects).To(HaveLen(1))
+		Expect(report.Status.DataObjects[0].Name).To(Equal(orphanedDO.Name))
+		Expect(report.Status.DataObjects[0].Reason).To(Equal(lsv1alpha1.OrphanReasonNotExported))
+		Expect(report.Status.DataObjects[0].DeletionTime).To(BeNil())
+		Expect(report.Status.Targets).To(HaveLen(1))
+		Expect(report.Status.Targets[0].Reason).To(Equal(lsv1alpha1.OrphanReasonSourceNotFound))
+
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(orphanedDO), orphanedDO)).To(Succeed())
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(orphanedT), orphanedT)).To(Succeed())
+
+		// the report is not updated if nothing changed
+		resourceVersion := report.ResourceVersion
+		_, err = ctrl.Reconcile(ctx, req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(getReport().ResourceVersion).To(Equal(resourceVersion))
+	})
+
+	It("should set the deletion time and requeue until the grace period is expired", func() {
+		ctrl := orphans.NewController(logging.Discard(), kubeClient, fakeClock, config.OrphansController{
+			DeleteOrphans: true,
+			GracePeriod:   &metav1.Duration{Duration: time.Hour},
+		})
+		res, err := ctrl.Reconcile(ctx, req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.RequeueAfter).To(Equal(time.Hour))
+
+		report := getReport()
+		Expect(report.Status.DataObjects).To(HaveLen(1))
+		Expect(report.Status.DataObjects[0].DeletionTime).ToNot(BeNil())
+		Expect(report.Status.DataObjects[0].DeletionTime.Time).To(BeTemporally("==", fakeClock.Now().Add(time.Hour)))
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(orphanedDO), orphanedDO)).To(Succeed())
+
+		fakeClock.SetTime(fakeClock.Now().Add(30 * time.Minute))
+		res, err = ctrl.Reconcile(ctx, req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.RequeueAfter).To(Equal(30 * time.Minute))
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(orphanedDO), orphanedDO)).To(Succeed())
+
+		fakeClock.SetTime(fakeClock.Now().Add(30 * time.Minute))
+		_, err = ctrl.Reconcile(ctx, req)
+		Expect(err).ToNot(HaveOccurred())
+		err = kubeClient.Get(ctx, client.ObjectKeyFromObject(orphanedDO), orphanedDO)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should delete orphaned objects after the grace period", func() {
+		ctrl := orphans.NewController(logging.Discard(), kubeClient, fakeClock, config.OrphansController{
+			DeleteOrphans: true,
+			GracePeriod:   &metav1.Duration{},
+		})
+		res, err := ctrl.Reconcile(ctx, req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.RequeueAfter).To(BeZero())
+
+		err = kubeClient.Get(ctx, client.ObjectKeyFromObject(orphanedDO), orphanedDO)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		err = kubeClient.Get(ctx, client.ObjectKeyFromObject(orphanedT), orphanedT)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(exportedDO), exportedDO)).To(Succeed())
+
+		// no report is created if there are no orphans
+		err = kubeClient.Get(ctx, req.NamespacedName, &lsv1alpha1.OrphanReport{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package orphans
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+)
+
+// wiring describes the installations of a namespace and the keys they import.
+type wiring struct {
+	installations map[string]*lsv1alpha1.Installation
+
+	// imported keys by data object context
+	importedData    map[string]sets.String
+	importedTargets map[string]sets.String
+}
+
+func newWiring(insts []lsv1alpha1.Installation) *wiring {
+	w := &wiring{
+		installations:   map[string]*lsv1alpha1.Installation{},
+		importedData:    map[string]sets.String{},
+		importedTargets: map[string]sets.String{},
+	}
+	add := func(keys map[string]sets.String, context string, key string) {
+		if len(key) == 0 {
+			return
+		}
+		if _, ok := keys[context]; !ok {
+			keys[context] = sets.NewString()
+		}
+		keys[context].Insert(key)
+	}
+
+	for i := range insts {
+		inst := &insts[i]
+		w.installations[lsv1alpha1helper.DataObjectSourceFromInstallation(inst)] = inst
+		context := installations.GetInstallationContextName(inst)
+
+		for _, imp := range inst.Spec.Imports.Data {
+			add(w.importedData, context, imp.DataRef)
+		}
+		for _, imp := range inst.Spec.Imports.Targets {
+			add(w.importedTargets, context, imp.Target)
+			add(w.importedTargets, context, imp.TargetListReference)
+			for _, target := range imp.Targets {
+				add(w.importedTargets, context, target)
+			}
+		}
+	}
+	return w
+}
+
+// FindOrphans returns the DataObjects and Targets that are neither produced nor consumed by any of the given installations.
+// All objects and installations are expected to be in the same namespace.
+//
+// An exported object is orphaned if no installation in its context imports it and
+// its source installation does not exist anymore or does not export it anymore.
+// An imported object is orphaned if the installation it was created for does not exist anymore or does not import it anymore.
+// Objects that were not created for an installation are never reported.
+func FindOrphans(insts []lsv1alpha1.Installation, dos []lsv1alpha1.DataObject, targets []lsv1alpha1.Target) (orphanedDataObjects, orphanedTargets []lsv1alpha1.OrphanedObject) {
+	w := newWiring(insts)
+
+	for i := range dos {
+		do := &dos[i]
+		meta := dataobjects.GetMetadataFromObject(do, nil)
+		if reason, ok := w.orphanReason(meta, w.importedData, exportsData, importsData); ok {
+			orphanedDataObjects = append(orphanedDataObjects, newOrphanedObject(do.Name, meta, reason))
+		}
+	}
+	for i := range targets {
+		target := &targets[i]
+		meta := dataobjects.GetMetadataFromObject(target, nil)
+		if reason, ok := w.orphanReason(meta, w.importedTargets, exportsTarget, importsTarget); ok {
+			orphanedTargets = append(orphanedTargets, newOrphanedObject(target.Name, meta, reason))
+		}
+	}
+
+	sortOrphans(orphanedDataObjects)
+	sortOrphans(orphanedTargets)
+	return orphanedDataObjects, orphanedTargets
+}
+
+// orphanReason returns the reason why an object with the given metadata is orphaned.
+// The second return value is false if the object is not orphaned.
+func (w *wiring) orphanReason(meta dataobjects.Metadata, imported map[string]sets.String,
+	exportsKey, importsKey func(inst *lsv1alpha1.Installation, key string) bool) (lsv1alpha1.OrphanReason, bool) {
+	if !strings.HasPrefix(meta.Source, lsv1alpha1helper.InstallationPrefix) {
+		return "", false
+	}
+	inst, instExists := w.installations[meta.Source]
+
+	switch meta.SourceType {
+	case lsv1alpha1.ExportDataObjectSourceType:
+		if imported[meta.Context].Has(meta.Key) {
+			return "", false
+		}
+		if !instExists {
+			return lsv1alpha1.OrphanReasonSourceNotFound, true
+		}
+		if !exportsKey(inst, meta.Key) {
+			return lsv1alpha1.OrphanReasonNotExported, true
+		}
+	case lsv1alpha1.ImportDataObjectSourceType:
+		if !instExists {
+			return lsv1alpha1.OrphanReasonSourceNotFound, true
+		}
+		if !importsKey(inst, meta.Key) {
+			return lsv1alpha1.OrphanReasonNotImported, true
+		}
+	}
+	return "", false
+}
+
+func exportsData(inst *lsv1alpha1.Installation, key string) bool {
+	for _, exp := range inst.Spec.Exports.Data {
+		if exp.DataRef == key {
+			return true
+		}
+	}
+	return false
+}
+
+func exportsTarget(inst *lsv1alpha1.Installation, key string) bool {
+	for _, exp := range inst.Spec.Exports.Targets {
+		if exp.Target == key {
+			return true
+		}
+	}
+	return false
+}
+
+func importsData(inst *lsv1alpha1.Installation, name string) bool {
+	for _, imp := range inst.Spec.Imports.Data {
+		if imp.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func importsTarget(inst *lsv1alpha1.Installation, name string) bool {
+	for _, imp := range inst.Spec.Imports.Targets {
+		if imp.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func newOrphanedObject(name string, meta dataobjects.Metadata, reason lsv1alpha1.OrphanReason) lsv1alpha1.OrphanedObject {
+	return lsv1alpha1.OrphanedObject{
+		Name:       name,
+		Context:    meta.Context,
+		Key:        meta.Key,
+		Source:     meta.Source,
+		SourceType: meta.SourceType,
+		Reason:     reason,
+	}
+}
+
+func sortOrphans(orphans []lsv1alpha1.OrphanedObject) {
+	sort.Slice(orphans, func(i, j int) bool {
+		return orphans[i].Name < orphans[j].Name
+	})
+}
+
+// MergeOrphans keeps the time since when an object is orphaned from the previously reported orphans
+// and sets the deletion time of the objects if a grace period is given.
+func MergeOrphans(previous, current []lsv1alpha1.OrphanedObject, now time.Time, gracePeriod *time.Duration) []lsv1alpha1.OrphanedObject {
+	since := map[string]metav1.Time{}
+	for _, orphan := range previous {
+		since[orphan.Name] = orphan.OrphanedSince
+	}
+
+	merged := make([]lsv1alpha1.OrphanedObject, len(current))
+	for i, orphan := range current {
+		orphan.OrphanedSince = metav1.Time{Time: now}
+		if t, ok := since[orphan.Name]; ok {
+			orphan.OrphanedSince = t
+		}
+		orphan.DeletionTime = nil
+		if gracePeriod != nil {
+			orphan.DeletionTime = &metav1.Time{Time: orphan.OrphanedSince.Add(*gracePeriod)}
+		}
+		merged[i] = orphan
+	}
+	return merged
+}
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package orphans_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/controllers/orphans"
+)
+
+func newInstallation(name, parent string) *lsv1alpha1.Installation {
+	inst := &lsv1alpha1.Installation{}
+	inst.Name = name
+	inst.Namespace = "test"
+	if len(parent) != 0 {
+		inst.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: lsv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "Installation",
+			Name:       parent,
+		}}
+	}
+	return inst
+}
+
+func newLabels(context, source string, sourceType lsv1alpha1.DataObjectSourceType, key string) map[string]string {
+	labels := map[string]string{
+		lsv1alpha1.DataObjectSourceLabel: source,
+		lsv1alpha1.DataObjectKeyLabel:    key,
+	}
+	if len(context) != 0 {
+		labels[lsv1alpha1.DataObjectContextLabel] = context
+	}
+	if len(sourceType) != 0 {
+		labels[lsv1alpha1.DataObjectSourceTypeLabel] = string(sourceType)
+	}
+	return labels
+}
+
+func newDataObject(name, context, source string, sourceType lsv1alpha1.DataObjectSourceType, key string) *lsv1alpha1.DataObject {
+	do := &lsv1alpha1.DataObject{}
+	do.Name = name
+	do.Namespace = "test"
+	do.Labels = newLabels(context, source, sourceType, key)
+	return do
+}
+
+func newTarget(name, context, source string, sourceType lsv1alpha1.DataObjectSourceType, key string) *lsv1alpha1.Target {
+	target := &lsv1alpha1.Target{}
+	target.Name = name
+	target.Namespace = "test"
+	target.Labels = newLabels(context, source, sourceType, key)
+	return target
+}
+
+func orphanNames(orphaned []lsv1alpha1.OrphanedObject) map[string]lsv1alpha1.OrphanReason {
+	names := map[string]lsv1alpha1.OrphanReason{}
+	for _, orphan := range orphaned {
+		names[orphan.Name] = orphan.Reason
+	}
+	return names
+}
+
+var _ = Describe("FindOrphans", func() {
+
+	var (
+		root *lsv1alpha1.Installation
+		sub  *lsv1alpha1.Installation
+	)
+
+	BeforeEach(func() {
+		root = newInstallation("root", "")
+		root.Spec.Exports.Data = []lsv1alpha1.DataExport{{Name: "a", DataRef: "root-a"}}
+		root.Spec.Exports.Targets = []lsv1alpha1.TargetExport{{Name: "t", Target: "root-t"}}
+
+		sub = newInstallation("sub", "root")
+		sub.Spec.Imports.Data = []lsv1alpha1.DataImport{{Name: "in", DataRef: "sibling-a"}}
+		sub.Spec.Imports.Targets = []lsv1alpha1.TargetImport{
+			{Name: "cluster", Target: "sibling-t"},
+			{Name: "clusters", TargetListReference: "sibling-list"},
+		}
+	})
+
+	It("should not report objects that are exported or imported", func() {
+		dos := []lsv1alpha1.DataObject{
+			*newDataObject("export", "", "Inst.root", lsv1alpha1.ExportDataObjectSourceType, "root-a"),
+			*newDataObject("consumed", "Inst.root", "Inst.deleted", lsv1alpha1.ExportDataObjectSourceType, "sibling-a"),
+			*newDataObject("import", "Inst.sub", "Inst.sub", lsv1alpha1.ImportDataObjectSourceType, "in"),
+		}
+		targets := []lsv1alpha1.Target{
+			*newTarget("export", "", "Inst.root", lsv1alpha1.ExportDataObjectSourceType, "root-t"),
+			*newTarget("consumed", "Inst.root", "Inst.deleted", lsv1alpha1.ExportDataObjectSourceType, "sibling-t"),
+			*newTarget("consumed-list", "Inst.root", "Inst.deleted", lsv1alpha1.ExportDataObjectSourceType, "sibling-list"),
+			*newTarget("import", "Inst.sub", "Inst.sub", lsv1alpha1.ImportDataObjectSourceType, "cluster"),
+		}
+
+		orphanedDOs, orphanedTargets := orphans.FindOrphans([]lsv1alpha1.Installation{*root, *sub}, dos, targets)
+		Expect(orphanedDOs).To(BeEmpty())
+		Expect(orphanedTargets).To(BeEmpty())
+	})
+
+	It("should report exports of installations that do not exist or do not export them anymore", func() {
+		dos := []lsv1alpha1.DataObject{
+			*newDataObject("stale-export", "", "Inst.root", lsv1alpha1.ExportDataObjectSourceType, "old-a"),
+			*newDataObject("missing-source", "", "Inst.deleted", lsv1alpha1.ExportDataObjectSourceType, "root-a"),
+			// imported in another context
+			*newDataObject("other-context", "", "Inst.deleted", lsv1alpha1.ExportDataObjectSourceType, "sibling-a"),
+		}
+		targets := []lsv1alpha1.Target{
+			*newTarget("stale-export", "", "Inst.root", lsv1alpha1.ExportDataObjectSourceType, "old-t"),
+			// data exports do not produce targets
+			*newTarget("wrong-kind", "", "Inst.root", lsv1alpha1.ExportDataObjectSourceType, "root-a"),
+		}
+
+		orphanedDOs, orphanedTargets := orphans.FindOrphans([]lsv1alpha1.Installation{*root, *sub}, dos, targets)
+		Expect(orphanNames(orphanedDOs)).To(Equal(map[string]lsv1alpha1.OrphanReason{
+			"stale-export":   lsv1alpha1.OrphanReasonNotExported,
+			"missing-source": lsv1alpha1.OrphanReasonSourceNotFound,
+			"other-context":  lsv1alpha1.OrphanReasonSourceNotFound,
+		}))
+		Expect(orphanNames(orphanedTargets)).To(Equal(map[string]lsv1alpha1.OrphanReason{
+			"stale-export": lsv1alpha1.OrphanReasonNotExported,
+			"wrong-kind":   lsv1alpha1.OrphanReasonNotExported,
+		}))
+		Expect(orphanedDOs[0].Name).To(Equal("missing-source"), "orphans should be sorted by name")
+		Expect(orphanedDOs[0].SourceType).To(Equal(lsv1alpha1.ExportDataObjectSourceType))
+		Expect(orphanedDOs[0].Source).To(Equal("Inst.deleted"))
+		Expect(orphanedDOs[0].Key).To(Equal("root-a"))
+	})
+
+	It("should report imports of installations that do not exist or do not import them anymore", func() {
+		dos := []lsv1alpha1.DataObject{
+			*newDataObject("stale-import", "Inst.sub", "Inst.sub", lsv1alpha1.ImportDataObjectSourceType, "old"),
+			*newDataObject("missing-source", "Inst.deleted", "Inst.deleted", lsv1alpha1.ImportDataObjectSourceType, "in"),
+		}
+		targets := []lsv1alpha1.Target{
+			*newTarget("stale-import", "Inst.sub", "Inst.sub", lsv1alpha1.ImportDataObjectSourceType, "old"),
+		}
+
+		orphanedDOs, orphanedTargets := orphans.FindOrphans([]lsv1alpha1.Installation{*root, *sub}, dos, targets)
+		Expect(orphanNames(orphanedDOs)).To(Equal(map[string]lsv1alpha1.OrphanReason{
+			"stale-import":   lsv1alpha1.OrphanReasonNotImported,
+			"missing-source": lsv1alpha1.OrphanReasonSourceNotFound,
+		}))
+		Expect(orphanNames(orphanedTargets)).To(Equal(map[string]lsv1alpha1.OrphanReason{
+			"stale-import": lsv1alpha1.OrphanReasonNotImported,
+		}))
+	})
+
+	It("should ignore objects that were not created for an installation", func() {
+		dos := []lsv1alpha1.DataObject{
+			*newDataObject("execution", "Exec.exec", "Exec.exec", "", "a"),
+			*newDataObject("manual", "", "", "", ""),
+		}
+		orphanedDOs, _ := orphans.FindOrphans(nil, dos, nil)
+		Expect(orphanedDOs).To(BeEmpty())
+	})
+})
+
+var _ = Describe("MergeOrphans", func() {
+
+	It("should keep the time since when an object is orphaned and compute the deletion time", func() {
+		now := time.Now()
+		before := metav1.NewTime(now.Add(-time.Hour))
+		previous := []lsv1alpha1.OrphanedObject{{Name: "a", OrphanedSince: before}}
+		current := []lsv1alpha1.OrphanedObject{{Name: "a"}, {Name: "b"}}
+
+		merged := orphans.MergeOrphans(previous, current, now, nil)
+		Expect(merged).To(HaveLen(2))
+		Expect(merged[0].OrphanedSince).To(Equal(before))
+		Expect(merged[0].DeletionTime).To(BeNil())
+		Expect(merged[1].OrphanedSince.Time).To(Equal(now))
+
+		gracePeriod := 2 * time.Hour
+		merged = orphans.MergeOrphans(previous, current, now, &gracePeriod)
+		Expect(merged[0].DeletionTime.Time).To(Equal(before.Add(gracePeriod)))
+		Expect(merged[1].DeletionTime.Time).To(Equal(now.Add(gracePeriod)))
+	})
+})
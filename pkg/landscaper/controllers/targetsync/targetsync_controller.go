@@ -39,6 +39,7 @@ import (
 	kutils "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
 // AddControllerToManagerForTargetSyncs adds the controller to the manager
@@ -71,12 +72,17 @@ func NewTargetSyncController(logger logging.Logger, targetClient client.Client,
 	}
 }
 
+// Writer returns a writer for the landscaper objects.
+func (c *TargetSyncController) Writer() *read_write_layer.Writer {
+	return read_write_layer.NewWriter(c.targetClient)
+}
+
 // Reconcile reconciles requests for TargetSyncs
 func (c *TargetSyncController) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 	logger, ctx := c.log.StartReconcileAndAddToContext(ctx, req)
 
 	targetSync := &lsv1alpha1.TargetSync{}
-	if err := c.targetClient.Get(ctx, req.NamespacedName, targetSync); err != nil {
+	if err := read_write_layer.GetTargetSync(ctx, c.targetClient, req.NamespacedName, targetSync); err != nil {
 		if apierrors.IsNotFound(err) {
 			logger.Info(err.Error())
 			return reconcile.Result{}, nil
@@ -151,7 +157,7 @@ func (c *TargetSyncController) handleReconcile(ctx context.Context, targetSync *
 	targetSync.Status.ObservedGeneration = targetSync.GetGeneration()
 	targetSync.Status.LastUpdateTime = &now
 
-	if err = c.targetClient.Status().Update(ctx, targetSync); err != nil {
+	if err = c.Writer().UpdateTargetSyncStatus(ctx, read_write_layer.W000160, targetSync); err != nil {
 		logger.Error(err, "updating status at the end of reconcile of targetsync object failed")
 		return err
 	}
@@ -176,7 +182,7 @@ func (c *TargetSyncController) handleDelete(ctx context.Context, targetSync *lsv
 		targetSync.Status.ObservedGeneration = targetSync.GetGeneration()
 		targetSync.Status.LastUpdateTime = &now
 
-		if internalErr := c.targetClient.Status().Update(ctx, targetSync); err != nil {
+		if internalErr := c.Writer().UpdateTargetSyncStatus(ctx, read_write_layer.W000161, targetSync); err != nil {
 			logger.Error(err, "updating status with error for deleting targetsync object failed")
 			return internalErr
 		}
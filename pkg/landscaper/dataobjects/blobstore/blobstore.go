@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blobstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/component-cli/ociclient"
+	"github.com/opencontainers/go-digest"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/utils"
+)
+
+// Store stores the data of DataObjects in a blob store.
+type Store interface {
+	// Put stores the data of the DataObject and returns the reference to the stored data.
+	Put(ctx context.Context, do *lsv1alpha1.DataObject, data []byte) (*lsv1alpha1.DataObjectBlobReference, error)
+	// Get returns the data that is referenced by the blob reference of the DataObject.
+	Get(ctx context.Context, do *lsv1alpha1.DataObject) ([]byte, error)
+	// Cleanup removes the stored data of the DataObject that is not referenced by its blob reference anymore.
+	Cleanup(ctx context.Context, do *lsv1alpha1.DataObject) error
+}
+
+// BlobStorage stores the data of DataObjects that exceed a threshold in a blob store
+// and only keeps a reference to the data in the DataObject.
+// The data of sensitive DataObjects is always stored in the secret store.
+type BlobStorage struct {
+	threshold int64
+	storeType lsv1alpha1.DataObjectBlobStoreType
+	stores    map[lsv1alpha1.DataObjectBlobStoreType]Store
+}
+
+// New creates a new blob storage that stores data larger than the threshold in the store of the given type.
+// Data that has been stored in one of the other stores can still be read.
+// If no store type is given, only the data of sensitive DataObjects is offloaded.
+func New(threshold int64, storeType lsv1alpha1.DataObjectBlobStoreType, stores map[lsv1alpha1.DataObjectBlobStoreType]Store) (*BlobStorage, error) {
+	if _, ok := stores[storeType]; len(storeType) != 0 && !ok {
+		return nil, fmt.Errorf("no blob store of type %q is configured", storeType)
+	}
+	return &BlobStorage{
+		threshold: threshold,
+		storeType: storeType,
+		stores:    stores,
+	}, nil
+}
+
+// NewFromConfiguration creates a new blob storage from the landscaper configuration.
+// The secret and config map stores are always available to read data; the oci store only if it is configured.
+// If no configuration is given, only the data of sensitive DataObjects is offloaded.
+func NewFromConfiguration(log logging.Logger, kubeClient client.Client, cfg *config.DataObjectStorageConfiguration, ociConfig *config.OCIConfiguration) (*BlobStorage, error) {
+	if cfg == nil {
+		return New(0, "", map[lsv1alpha1.DataObjectBlobStoreType]Store{
+			lsv1alpha1.SecretDataObjectBlobStoreType:    NewSecretStore(kubeClient, defaultChunkSize),
+			lsv1alpha1.ConfigMapDataObjectBlobStoreType: NewConfigMapStore(kubeClient, defaultChunkSize),
+		})
+	}
+	threshold, err := resource.ParseQuantity(cfg.Threshold)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse threshold %q: %w", cfg.Threshold, err)
+	}
+	chunkSize, err := resource.ParseQuantity(cfg.ChunkSize)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse chunk size %q: %w", cfg.ChunkSize, err)
+	}
+	if chunkSize.Value() <= 0 {
+		return nil, fmt.Errorf("chunk size has to be greater than 0")
+	}
+
+	stores := map[lsv1alpha1.DataObjectBlobStoreType]Store{
+		lsv1alpha1.SecretDataObjectBlobStoreType:    NewSecretStore(kubeClient, chunkSize.Value()),
+		lsv1alpha1.ConfigMapDataObjectBlobStoreType: NewConfigMapStore(kubeClient, chunkSize.Value()),
+	}
+	if cfg.OCI != nil {
+		ociClient, err := ociclient.NewClient(log.Logr(), utils.WithConfiguration(ociConfig))
+		if err != nil {
+			return nil, fmt.Errorf("unable to create oci client: %w", err)
+		}
+		stores[lsv1alpha1.OCIDataObjectBlobStoreType] = NewOCIStore(ociClient, cfg.OCI.Repository)
+	}
+	return New(threshold.Value(), lsv1alpha1.DataObjectBlobStoreType(cfg.Type), stores)
+}
+
+// Offload stores the data of the DataObject in the blob store if it exceeds the threshold or if it is sensitive.
+// The data is removed from the DataObject and replaced by a blob reference.
+// DataObjects without data are not changed as their data is either empty or already offloaded.
+func (s *BlobStorage) Offload(ctx context.Context, do *lsv1alpha1.DataObject) error {
+	data := do.Data.RawMessage
+	if len(data) == 0 {
+		return nil
+	}
+	storeType := s.storeType
+	if do.Sensitive {
+		storeType = lsv1alpha1.SecretDataObjectBlobStoreType
+	} else if len(storeType) == 0 || int64(len(data)) <= s.threshold {
+		do.BlobRef = nil
+		return nil
+	}
+	store, ok := s.stores[storeType]
+	if !ok {
+		return fmt.Errorf("unable to store data of DataObject %s/%s: no %s blob store is configured", do.Namespace, do.Name, storeType)
+	}
+	ref, err := store.Put(ctx, do, data)
+	if err != nil {
+		return fmt.Errorf("unable to store data of DataObject %s/%s in %s blob store: %w", do.Namespace, do.Name, storeType, err)
+	}
+	do.BlobRef = ref
+	do.Data = lsv1alpha1.AnyJSON{}
+	return nil
+}
+
+// Resolve reads the data of a DataObject with a blob reference from the blob store into the DataObject.
+func (s *BlobStorage) Resolve(ctx context.Context, do *lsv1alpha1.DataObject) error {
+	if do.BlobRef == nil || len(do.Data.RawMessage) != 0 {
+		return nil
+	}
+	store, ok := s.stores[do.BlobRef.Type]
+	if !ok {
+		return fmt.Errorf("the data of DataObject %s/%s is stored in a %s blob store which is not configured", do.Namespace, do.Name, do.BlobRef.Type)
+	}
+	data, err := store.Get(ctx, do)
+	if err != nil {
+		return fmt.Errorf("unable to read data of DataObject %s/%s from %s blob store: %w", do.Namespace, do.Name, do.BlobRef.Type, err)
+	}
+	if dig := digest.FromBytes(data); dig.String() != do.BlobRef.Digest {
+		return fmt.Errorf("digest %q of the data of DataObject %s/%s does not match the expected digest %q", dig.String(), do.Namespace, do.Name, do.BlobRef.Digest)
+	}
+	do.Data.RawMessage = data
+	return nil
+}
+
+// Cleanup removes the data of the DataObject from all blob stores that is not referenced by the DataObject anymore.
+func (s *BlobStorage) Cleanup(ctx context.Context, do *lsv1alpha1.DataObject) error {
+	for storeType, store := range s.stores {
+		if err := store.Cleanup(ctx, do); err != nil {
+			return fmt.Errorf("unable to cleanup %s blob store of DataObject %s/%s: %w", storeType, do.Namespace, do.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blobstore_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/gardener/component-cli/ociclient"
+	mock_oci "github.com/gardener/component-cli/ociclient/mock"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects/blobstore"
+)
+
+// newDataObject creates a DataObject whose data is a json string of the given size.
+func newDataObject(size int) *lsv1alpha1.DataObject {
+	data, err := json.Marshal(strings.Repeat("a", size-2))
+	Expect(err).ToNot(HaveOccurred())
+	do := &lsv1alpha1.DataObject{}
+	do.Name = "my-do"
+	do.Namespace = "test"
+	do.UID = types.UID("abc")
+	do.Data.RawMessage = data
+	return do
+}
+
+var _ = Describe("BlobStorage", func() {
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+	})
+
+	newStorage := func(storeType lsv1alpha1.DataObjectBlobStoreType) *blobstore.BlobStorage {
+		storage, err := blobstore.New(100, storeType, map[lsv1alpha1.DataObjectBlobStoreType]blobstore.Store{
+			lsv1alpha1.SecretDataObjectBlobStoreType:    blobstore.NewSecretStore(kubeClient, 40),
+			lsv1alpha1.ConfigMapDataObjectBlobStoreType: blobstore.NewConfigMapStore(kubeClient, 40),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return storage
+	}
+
+	It("should not offload data below the threshold", func() {
+		storage := newStorage(lsv1alpha1.SecretDataObjectBlobStoreType)
+		do := newDataObject(100)
+		Expect(storage.Offload(ctx, do)).To(Succeed())
+		Expect(do.BlobRef).To(BeNil())
+		Expect(do.Data.RawMessage).To(HaveLen(100))
+	})
+
+	It("should offload data into secret chunks and resolve it", func() {
+		storage := newStorage(lsv1alpha1.SecretDataObjectBlobStoreType)
+		do := newDataObject(101)
+		data := do.Data.RawMessage
+
+		Expect(storage.Offload(ctx, do)).To(Succeed())
+		Expect(do.Data.RawMessage).To(BeEmpty())
+		Expect(do.BlobRef).ToNot(BeNil())
+		Expect(do.BlobRef.Type).To(Equal(lsv1alpha1.SecretDataObjectBlobStoreType))
+		Expect(do.BlobRef.Size).To(Equal(int64(101)))
+		Expect(do.BlobRef.Chunks).To(Equal(3))
+
+		secrets := &corev1.SecretList{}
+		Expect(kubeClient.List(ctx, secrets)).To(Succeed())
+		Expect(secrets.Items).To(HaveLen(3))
+		Expect(secrets.Items[0].OwnerReferences).To(HaveLen(1))
+		Expect(secrets.Items[0].OwnerReferences[0].Name).To(Equal(do.Name))
+
+		Expect(storage.Resolve(ctx, do)).To(Succeed())
+		Expect(do.Data.RawMessage).To(Equal(data))
+	})
+
+	It("should offload data into config map chunks and resolve it", func() {
+		storage := newStorage(lsv1alpha1.ConfigMapDataObjectBlobStoreType)
+		do := newDataObject(120)
+		data := do.Data.RawMessage
+
+		Expect(storage.Offload(ctx, do)).To(Succeed())
+		Expect(do.BlobRef.Type).To(Equal(lsv1alpha1.ConfigMapDataObjectBlobStoreType))
+		configMaps := &corev1.ConfigMapList{}
+		Expect(kubeClient.List(ctx, configMaps)).To(Succeed())
+		Expect(configMaps.Items).To(HaveLen(3))
+
+		Expect(storage.Resolve(ctx, do)).To(Succeed())
+		Expect(do.Data.RawMessage).To(Equal(data))
+	})
+
+	It("should fail to resolve data that has been modified", func() {
+		storage := newStorage(lsv1alpha1.SecretDataObjectBlobStoreType)
+		do := newDataObject(101)
+		Expect(storage.Offload(ctx, do)).To(Succeed())
+
+		secret := &corev1.Secret{}
+		Expect(kubeClient.Get(ctx, client.ObjectKey{Name: do.BlobRef.Reference + "-0", Namespace: do.Namespace}, secret)).To(Succeed())
+		secret.Data["data"] = []byte("modified")
+		Expect(kubeClient.Update(ctx, secret)).To(Succeed())
+
+		Expect(storage.Resolve(ctx, do)).ToNot(Succeed())
+	})
+
+	It("should remove chunks that are not referenced anymore", func() {
+		storage := newStorage(lsv1alpha1.SecretDataObjectBlobStoreType)
+		do := newDataObject(101)
+		Expect(storage.Offload(ctx, do)).To(Succeed())
+		oldRef := do.BlobRef.Reference
+
+		// update the data
+		do.Data.RawMessage = newDataObject(150).Data.RawMessage
+		Expect(storage.Offload(ctx, do)).To(Succeed())
+		Expect(do.BlobRef.Reference).ToNot(Equal(oldRef))
+		Expect(storage.Cleanup(ctx, do)).To(Succeed())
+
+		secrets := &corev1.SecretList{}
+		Expect(kubeClient.List(ctx, secrets)).To(Succeed())
+		Expect(secrets.Items).To(HaveLen(4))
+		for _, secret := range secrets.Items {
+			Expect(secret.Name).To(HavePrefix(do.BlobRef.Reference))
+		}
+
+		// the data is stored in the object again
+		do.Data.RawMessage = newDataObject(10).Data.RawMessage
+		Expect(storage.Offload(ctx, do)).To(Succeed())
+		Expect(do.BlobRef).To(BeNil())
+		Expect(storage.Cleanup(ctx, do)).To(Succeed())
+		Expect(kubeClient.List(ctx, secrets)).To(Succeed())
+		Expect(secrets.Items).To(BeEmpty())
+	})
+
+	It("should set the owner of chunks that have been written before the DataObject has been created", func() {
+		storage := newStorage(lsv1alpha1.SecretDataObjectBlobStoreType)
+		do := newDataObject(101)
+		do.UID = ""
+		Expect(storage.Offload(ctx, do)).To(Succeed())
+
+		secrets := &corev1.SecretList{}
+		Expect(kubeClient.List(ctx, secrets)).To(Succeed())
+		Expect(secrets.Items[0].OwnerReferences).To(BeEmpty())
+
+		do.UID = types.UID("abc")
+		Expect(storage.Cleanup(ctx, do)).To(Succeed())
+		Expect(kubeClient.List(ctx, secrets)).To(Succeed())
+		Expect(secrets.Items).To(HaveLen(3))
+		for _, secret := range secrets.Items {
+			Expect(secret.OwnerReferences).To(HaveLen(1))
+		}
+	})
+
+	It("should always store sensitive data in secrets", func() {
+		storage := newStorage(lsv1alpha1.ConfigMapDataObjectBlobStoreType)
+		do := newDataObject(10)
+		do.Sensitive = true
+		data := do.Data.RawMessage
+
+		Expect(storage.Offload(ctx, do)).To(Succeed())
+		Expect(do.Data.RawMessage).To(BeEmpty())
+		Expect(do.BlobRef).ToNot(BeNil())
+		Expect(do.BlobRef.Type).To(Equal(lsv1alpha1.SecretDataObjectBlobStoreType))
+
+		Expect(storage.Resolve(ctx, do)).To(Succeed())
+		Expect(do.Data.RawMessage).To(Equal(data))
+	})
+
+	It("should only offload sensitive data if no store type is configured", func() {
+		storage, err := blobstore.New(0, "", map[lsv1alpha1.DataObjectBlobStoreType]blobstore.Store{
+			lsv1alpha1.SecretDataObjectBlobStoreType: blobstore.NewSecretStore(kubeClient, 40),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		do := newDataObject(101)
+		Expect(storage.Offload(ctx, do)).To(Succeed())
+		Expect(do.BlobRef).To(BeNil())
+		Expect(do.Data.RawMessage).To(HaveLen(101))
+
+		do.Sensitive = true
+		Expect(storage.Offload(ctx, do)).To(Succeed())
+		Expect(do.BlobRef).ToNot(BeNil())
+		Expect(do.BlobRef.Type).To(Equal(lsv1alpha1.SecretDataObjectBlobStoreType))
+	})
+
+	It("should not resolve data of a blob store that is not configured", func() {
+		storage := newStorage(lsv1alpha1.SecretDataObjectBlobStoreType)
+		do := newDataObject(10)
+		do.Data.RawMessage = nil
+		do.BlobRef = &lsv1alpha1.DataObjectBlobReference{Type: lsv1alpha1.OCIDataObjectBlobStoreType}
+		Expect(storage.Resolve(ctx, do)).ToNot(Succeed())
+	})
+
+	It("should not create a blob storage with a store type that is not configured", func() {
+		_, err := blobstore.New(100, lsv1alpha1.OCIDataObjectBlobStoreType, map[lsv1alpha1.DataObjectBlobStoreType]blobstore.Store{
+			lsv1alpha1.SecretDataObjectBlobStoreType: blobstore.NewSecretStore(kubeClient, 40),
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("OCI", func() {
+
+		var (
+			ctrl      *gomock.Controller
+			ociClient *mock_oci.MockClient
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			ociClient = mock_oci.NewMockClient(ctrl)
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should push the data as oci artifact and fetch it", func() {
+			storage, err := blobstore.New(100, lsv1alpha1.OCIDataObjectBlobStoreType, map[lsv1alpha1.DataObjectBlobStoreType]blobstore.Store{
+				lsv1alpha1.OCIDataObjectBlobStoreType: blobstore.NewOCIStore(ociClient, "example.com/dataobjects"),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			do := newDataObject(101)
+			data := []byte(do.Data.RawMessage)
+
+			var pushedManifest *ocispecv1.Manifest
+			var pushedData []byte
+			ociClient.EXPECT().PushManifest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, ref string, manifest *ocispecv1.Manifest, options ...ociclient.PushOption) error {
+					Expect(ref).To(HavePrefix("example.com/dataobjects:"))
+					Expect(manifest.Layers).To(HaveLen(1))
+					opts := (&ociclient.PushOptions{}).ApplyOptions(options)
+					reader, err := opts.Store.Get(manifest.Layers[0])
+					Expect(err).ToNot(HaveOccurred())
+					buf := make([]byte, manifest.Layers[0].Size)
+					_, err = reader.Read(buf)
+					Expect(err).ToNot(HaveOccurred())
+					pushedManifest, pushedData = manifest, buf
+					return nil
+				})
+			Expect(storage.Offload(ctx, do)).To(Succeed())
+			Expect(do.BlobRef.Type).To(Equal(lsv1alpha1.OCIDataObjectBlobStoreType))
+			Expect(pushedData).To(Equal(data))
+
+			ociClient.EXPECT().GetManifest(gomock.Any(), do.BlobRef.Reference).Return(pushedManifest, nil)
+			ociClient.EXPECT().Fetch(gomock.Any(), do.BlobRef.Reference, pushedManifest.Layers[0], gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ string, _ ocispecv1.Descriptor, writer io.Writer) error {
+					_, err := writer.Write(pushedData)
+					return err
+				})
+			Expect(storage.Resolve(ctx, do)).To(Succeed())
+			Expect([]byte(do.Data.RawMessage)).To(Equal(data))
+		})
+	})
+})
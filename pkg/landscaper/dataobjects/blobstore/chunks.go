@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blobstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+)
+
+// chunkDataKey is the key of the data in a secret or config map chunk.
+const chunkDataKey = "data"
+
+// defaultChunkSize is the chunk size that is used if no data object storage is configured.
+// It matches the default of the landscaper configuration.
+const defaultChunkSize = 512 * 1024
+
+// chunkStore stores the data of DataObjects in chunks of secrets or config maps in the namespace of the DataObject.
+// The chunks are owned by the DataObject so that they are garbage collected together with it.
+type chunkStore struct {
+	client    client.Client
+	storeType lsv1alpha1.DataObjectBlobStoreType
+	chunkSize int64
+}
+
+// NewSecretStore creates a store that splits the data of DataObjects into secrets of the given size.
+func NewSecretStore(kubeClient client.Client, chunkSize int64) Store {
+	return &chunkStore{
+		client:    kubeClient,
+		storeType: lsv1alpha1.SecretDataObjectBlobStoreType,
+		chunkSize: chunkSize,
+	}
+}
+
+// NewConfigMapStore creates a store that splits the data of DataObjects into config maps of the given size.
+func NewConfigMapStore(kubeClient client.Client, chunkSize int64) Store {
+	return &chunkStore{
+		client:    kubeClient,
+		storeType: lsv1alpha1.ConfigMapDataObjectBlobStoreType,
+		chunkSize: chunkSize,
+	}
+}
+
+func (s *chunkStore) Put(ctx context.Context, do *lsv1alpha1.DataObject, data []byte) (*lsv1alpha1.DataObjectBlobReference, error) {
+	dig := digest.FromBytes(data)
+	ref := &lsv1alpha1.DataObjectBlobReference{
+		Type:      s.storeType,
+		Reference: chunkPrefix(do.Name, dig),
+		Digest:    dig.String(),
+		Size:      int64(len(data)),
+	}
+
+	for offset := int64(0); offset < ref.Size; offset += s.chunkSize {
+		end := offset + s.chunkSize
+		if end > ref.Size {
+			end = ref.Size
+		}
+		chunk := s.newChunk(chunkName(ref.Reference, ref.Chunks), do.Namespace)
+		if _, err := kutil.CreateOrUpdate(ctx, s.client, chunk, func() error {
+			kutil.SetMetaDataLabel(chunk, lsv1alpha1.DataObjectBlobOwnerLabel, ownerLabelValue(do.Name))
+			if len(do.UID) != 0 {
+				if err := controllerutil.SetOwnerReference(do, chunk, s.client.Scheme()); err != nil {
+					return err
+				}
+			}
+			setChunkData(chunk, data[offset:end])
+			return nil
+		}); err != nil {
+			return nil, fmt.Errorf("unable to write chunk %d: %w", ref.Chunks, err)
+		}
+		ref.Chunks++
+	}
+	return ref, nil
+}
+
+func (s *chunkStore) Get(ctx context.Context, do *lsv1alpha1.DataObject) ([]byte, error) {
+	data := make([]byte, 0, do.BlobRef.Size)
+	for i := 0; i < do.BlobRef.Chunks; i++ {
+		chunk := s.newChunk(chunkName(do.BlobRef.Reference, i), do.Namespace)
+		if err := s.client.Get(ctx, kutil.ObjectKeyFromObject(chunk), chunk); err != nil {
+			return nil, fmt.Errorf("unable to read chunk %d: %w", i, err)
+		}
+		data = append(data, getChunkData(chunk)...)
+	}
+	return data, nil
+}
+
+// Cleanup deletes all chunks of the DataObject that do not belong to its current blob reference
+// and adds the DataObject as owner to the chunks that have been written before the DataObject has been created.
+func (s *chunkStore) Cleanup(ctx context.Context, do *lsv1alpha1.DataObject) error {
+	chunks, err := s.listChunks(ctx, do)
+	if err != nil {
+		return err
+	}
+	for _, chunk := range chunks {
+		if do.BlobRef == nil || do.BlobRef.Type != s.storeType || !strings.HasPrefix(chunk.GetName(), do.BlobRef.Reference+"-") {
+			if err := s.client.Delete(ctx, chunk); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("unable to delete chunk %s: %w", chunk.GetName(), err)
+			}
+			continue
+		}
+		if len(do.UID) == 0 || len(chunk.GetOwnerReferences()) != 0 {
+			continue
+		}
+		if err := controllerutil.SetOwnerReference(do, chunk, s.client.Scheme()); err != nil {
+			return err
+		}
+		if err := s.client.Update(ctx, chunk); err != nil {
+			return fmt.Errorf("unable to set owner of chunk %s: %w", chunk.GetName(), err)
+		}
+	}
+	return nil
+}
+
+func (s *chunkStore) listChunks(ctx context.Context, do *lsv1alpha1.DataObject) ([]client.Object, error) {
+	opts := []client.ListOption{
+		client.InNamespace(do.Namespace),
+		client.MatchingLabels{lsv1alpha1.DataObjectBlobOwnerLabel: ownerLabelValue(do.Name)},
+	}
+	var chunks []client.Object
+	if s.storeType == lsv1alpha1.SecretDataObjectBlobStoreType {
+		secrets := &corev1.SecretList{}
+		if err := s.client.List(ctx, secrets, opts...); err != nil {
+			return nil, err
+		}
+		for i := range secrets.Items {
+			chunks = append(chunks, &secrets.Items[i])
+		}
+		return chunks, nil
+	}
+	configMaps := &corev1.ConfigMapList{}
+	if err := s.client.List(ctx, configMaps, opts...); err != nil {
+		return nil, err
+	}
+	for i := range configMaps.Items {
+		chunks = append(chunks, &configMaps.Items[i])
+	}
+	return chunks, nil
+}
+
+func (s *chunkStore) newChunk(name, namespace string) client.Object {
+	if s.storeType == lsv1alpha1.SecretDataObjectBlobStoreType {
+		secret := &corev1.Secret{}
+		secret.Name = name
+		secret.Namespace = namespace
+		return secret
+	}
+	configMap := &corev1.ConfigMap{}
+	configMap.Name = name
+	configMap.Namespace = namespace
+	return configMap
+}
+
+func setChunkData(chunk client.Object, data []byte) {
+	switch c := chunk.(type) {
+	case *corev1.Secret:
+		c.Data = map[string][]byte{chunkDataKey: data}
+	case *corev1.ConfigMap:
+		c.BinaryData = map[string][]byte{chunkDataKey: data}
+	}
+}
+
+func getChunkData(chunk client.Object) []byte {
+	switch c := chunk.(type) {
+	case *corev1.Secret:
+		return c.Data[chunkDataKey]
+	case *corev1.ConfigMap:
+		return c.BinaryData[chunkDataKey]
+	}
+	return nil
+}
+
+// ownerLabelValue returns the value of the owner label for the chunks of a DataObject.
+// The name of the DataObject is hashed as it may exceed the maximal length of a label value.
+func ownerLabelValue(doName string) string {
+	h := sha256.Sum256([]byte(doName))
+	return hex.EncodeToString(h[:])[:32]
+}
+
+// chunkPrefix returns the name prefix of the chunks of a DataObject with data of the given digest.
+func chunkPrefix(doName string, dig digest.Digest) string {
+	return fmt.Sprintf("do-%s-%s", ownerLabelValue(doName)[:16], dig.Encoded()[:16])
+}
+
+func chunkName(prefix string, index int) string {
+	return fmt.Sprintf("%s-%d", prefix, index)
+}
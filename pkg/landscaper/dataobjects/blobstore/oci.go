@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/gardener/component-cli/ociclient"
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// DataObjectMediaType is the media type of the layer that contains the data of a DataObject.
+const DataObjectMediaType = "application/vnd.gardener.landscaper.dataobject.v1+json"
+
+// ociStore stores the data of DataObjects as oci artifacts with a single layer.
+// The artifacts are tagged with the digest of the data so that equal data is only pushed once.
+// Artifacts are not deleted from the registry as deleting is not supported by all registries.
+type ociStore struct {
+	client     ociclient.Client
+	repository string
+}
+
+// NewOCIStore creates a store that pushes the data of DataObjects to the given oci repository.
+func NewOCIStore(ociClient ociclient.Client, repository string) Store {
+	return &ociStore{
+		client:     ociClient,
+		repository: repository,
+	}
+}
+
+func (s *ociStore) Put(ctx context.Context, do *lsv1alpha1.DataObject, data []byte) (*lsv1alpha1.DataObjectBlobReference, error) {
+	layer := ocispecv1.Descriptor{
+		MediaType: DataObjectMediaType,
+		Digest:    digest.FromBytes(data),
+		Size:      int64(len(data)),
+	}
+	manifest := &ocispecv1.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		Layers:    []ocispecv1.Descriptor{layer},
+	}
+	ref := fmt.Sprintf("%s:%s", s.repository, layer.Digest.Encoded())
+	if err := s.client.PushManifest(ctx, ref, manifest, ociclient.WithStore(blobStore{layer.Digest: data})); err != nil {
+		return nil, fmt.Errorf("unable to push data to %s: %w", ref, err)
+	}
+	return &lsv1alpha1.DataObjectBlobReference{
+		Type:      lsv1alpha1.OCIDataObjectBlobStoreType,
+		Reference: ref,
+		Digest:    layer.Digest.String(),
+		Size:      layer.Size,
+	}, nil
+}
+
+func (s *ociStore) Get(ctx context.Context, do *lsv1alpha1.DataObject) ([]byte, error) {
+	ref := do.BlobRef.Reference
+	manifest, err := s.client.GetManifest(ctx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get manifest of %s: %w", ref, err)
+	}
+	for _, layer := range manifest.Layers {
+		if layer.MediaType != DataObjectMediaType {
+			continue
+		}
+		var data bytes.Buffer
+		if err := s.client.Fetch(ctx, ref, layer, &data); err != nil {
+			return nil, fmt.Errorf("unable to fetch data from %s: %w", ref, err)
+		}
+		return data.Bytes(), nil
+	}
+	return nil, fmt.Errorf("artifact %s has no layer of media type %s", ref, DataObjectMediaType)
+}
+
+func (s *ociStore) Cleanup(_ context.Context, _ *lsv1alpha1.DataObject) error {
+	return nil
+}
+
+// blobStore provides the blobs that are pushed by the oci client.
+type blobStore map[digest.Digest][]byte
+
+func (s blobStore) Get(desc ocispecv1.Descriptor) (io.ReadCloser, error) {
+	data, ok := s[desc.Digest]
+	if !ok {
+		return nil, fmt.Errorf("blob %s not found", desc.Digest)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
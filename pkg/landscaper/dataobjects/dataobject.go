@@ -5,7 +5,9 @@
 package dataobjects
 
 import (
+	"crypto/hmac"
 	"crypto/sha1"
+	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -27,6 +29,8 @@ var _ ImportedBase = &DataObject{}
 type DataObject struct {
 	Raw  *lsv1alpha1.DataObject
 	Data interface{}
+	// Sensitive defines whether the data is sensitive and therefore stored in secrets.
+	Sensitive bool
 
 	FieldValue *lsv1alpha1.FieldValueDefinition
 	Metadata   Metadata
@@ -52,6 +56,16 @@ func generateHash(data []byte) string {
 	return hex.EncodeToString(h.Sum(nil))
 }
 
+// generateSensitiveHash returns the data generation of sensitive dataobjects.
+// The name of the dataobject is used as key, so that equal values of different dataobjects have different hashes.
+// As the name is not secret, the hash can still be compared with the hashes of guessed values
+// and must therefore not be exposed where the dataobject itself is not readable.
+func generateSensitiveHash(name string, data []byte) string {
+	h := hmac.New(sha256.New, []byte(name))
+	_, _ = h.Write(data) // will never throw an error
+	return hex.EncodeToString(h.Sum(nil))
+}
+
 // New creates a new internal dataobject.
 func New() *DataObject {
 	return &DataObject{}
@@ -64,9 +78,10 @@ func NewFromDataObject(do *lsv1alpha1.DataObject) (*DataObject, error) {
 		return nil, err
 	}
 	return &DataObject{
-		Raw:      do,
-		Data:     data,
-		Metadata: GetMetadataFromObject(do, do.Data.RawMessage),
+		Raw:       do,
+		Data:      data,
+		Sensitive: do.Sensitive,
+		Metadata:  GetMetadataFromObject(do, do.Data.RawMessage),
 	}, nil
 }
 
@@ -158,6 +173,12 @@ func (do *DataObject) SetData(data interface{}) *DataObject {
 	return do
 }
 
+// SetSensitive marks the data of the given object as sensitive.
+func (do *DataObject) SetSensitive(sensitive bool) *DataObject {
+	do.Sensitive = sensitive
+	return do
+}
+
 // SetContext sets the installation context for the given data object.
 func (do *DataObject) SetContext(ctx string) *DataObject {
 	do.Metadata.Context = ctx
@@ -200,7 +221,12 @@ func (do DataObject) Build() (*lsv1alpha1.DataObject, error) {
 	if err != nil {
 		return nil, err
 	}
-	do.Metadata.Hash = generateHash(raw.Data.RawMessage)
+	raw.Sensitive = do.Sensitive
+	if do.Sensitive {
+		do.Metadata.Hash = generateSensitiveHash(raw.Name, raw.Data.RawMessage)
+	} else {
+		do.Metadata.Hash = generateHash(raw.Data.RawMessage)
+	}
 	SetMetadataFromObject(raw, do.Metadata)
 	return raw, nil
 }
@@ -216,7 +242,12 @@ func (do DataObject) Apply(raw *lsv1alpha1.DataObject) error {
 	if err != nil {
 		return err
 	}
-	do.Metadata.Hash = generateHash(raw.Data.RawMessage)
+	raw.Sensitive = do.Sensitive
+	if do.Sensitive {
+		do.Metadata.Hash = generateSensitiveHash(raw.Name, raw.Data.RawMessage)
+	} else {
+		do.Metadata.Hash = generateHash(raw.Data.RawMessage)
+	}
 	SetMetadataFromObject(raw, do.Metadata)
 	return nil
 }
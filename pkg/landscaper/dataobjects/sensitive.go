@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dataobjects
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// RedactedValue replaces sensitive values in data that is shown to users.
+const RedactedValue = "(redacted)"
+
+// SensitiveValues collects the scalar values of sensitive data,
+// so that templating results that use these values can be marked as sensitive as well.
+type SensitiveValues struct {
+	strings sets.String
+	numbers sets.String
+}
+
+// NewSensitiveValues creates a new empty set of sensitive values.
+func NewSensitiveValues() *SensitiveValues {
+	return &SensitiveValues{
+		strings: sets.NewString(),
+		numbers: sets.NewString(),
+	}
+}
+
+// Add adds all scalar values of the given data.
+// Strings are also added in their base64 encoding, as sensitive values are often encoded by templates.
+func (s *SensitiveValues) Add(data interface{}) {
+	walkScalars(data, func(value interface{}) bool {
+		switch v := value.(type) {
+		case string:
+			if len(v) != 0 {
+				s.strings.Insert(v, base64.StdEncoding.EncodeToString([]byte(v)))
+			}
+		case bool, nil:
+		default:
+			s.numbers.Insert(fmt.Sprint(v))
+		}
+		return false
+	})
+}
+
+// Len returns the number of collected sensitive values.
+func (s *SensitiveValues) Len() int {
+	return s.strings.Len() + s.numbers.Len()
+}
+
+// IsUsedBy checks if the given data uses a sensitive value, i.e. if one of its strings contains a sensitive string
+// or if one of its scalar values equals a sensitive number.
+func (s *SensitiveValues) IsUsedBy(data interface{}) bool {
+	if s.Len() == 0 {
+		return false
+	}
+	return walkScalars(data, func(value interface{}) bool {
+		switch v := value.(type) {
+		case string:
+			if s.numbers.Has(v) {
+				return true
+			}
+			for sensitive := range s.strings {
+				if strings.Contains(v, sensitive) {
+					return true
+				}
+			}
+		case bool, nil:
+		default:
+			return s.numbers.Has(fmt.Sprint(v))
+		}
+		return false
+	})
+}
+
+// Redact returns a copy of the given data in which all scalar values that use a sensitive value
+// are replaced by RedactedValue.
+func (s *SensitiveValues) Redact(data interface{}) interface{} {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			res[key] = s.Redact(elem)
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(v))
+		for i, elem := range v {
+			res[i] = s.Redact(elem)
+		}
+		return res
+	default:
+		if s.IsUsedBy(v) {
+			return RedactedValue
+		}
+		return v
+	}
+}
+
+// walkScalars calls the given function for all scalar values of the given data
+// and returns true as soon as the function returns true for a value.
+func walkScalars(data interface{}, f func(value interface{}) bool) bool {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for _, elem := range v {
+			if walkScalars(elem, f) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, elem := range v {
+			if walkScalars(elem, f) {
+				return true
+			}
+		}
+	default:
+		return f(v)
+	}
+	return false
+}
@@ -106,8 +106,8 @@ func (t *TargetExtension) Apply(target *lsv1alpha1.Target) error {
 }
 
 // GetHashableContent returns the value of the Target based on which its hash can be computed.
-// This is either .Spec.Configuration.RawMessage or a json representation of .Spec.SecretRef.
-// If neither is set (or the given target is nil), nil is returned.
+// This is either .Spec.Configuration.RawMessage or a json representation of .Spec.SecretRef or .Spec.ExternalSecretRef.
+// If none is set (or the given target is nil), nil is returned.
 func GetHashableContent(t *lsv1alpha1.Target) []byte {
 	if t == nil {
 		return nil
@@ -116,6 +116,9 @@ func GetHashableContent(t *lsv1alpha1.Target) []byte {
 		return t.Spec.Configuration.RawMessage
 	} else if t.Spec.SecretRef != nil {
 		return []byte(fmt.Sprintf(`{"secretRef": {"name": "%s", "key": "%s"}}`, t.Spec.SecretRef.Name, t.Spec.SecretRef.Key))
+	} else if t.Spec.ExternalSecretRef != nil {
+		return []byte(fmt.Sprintf(`{"externalSecretRef": {"resolver": "%s", "path": "%s", "key": "%s"}}`,
+			t.Spec.ExternalSecretRef.Resolver, t.Spec.ExternalSecretRef.Path, t.Spec.ExternalSecretRef.Key))
 	}
 	return nil
 }
@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// Build creates the graph of an installation, all its subinstallations, their executions and deploy items and
+// the data objects and targets that are imported and exported by the installations.
+func Build(ctx context.Context, kubeClient client.Client, root *lsv1alpha1.Installation) (*Graph, error) {
+	g := New()
+	if _, err := g.addInstallation(ctx, kubeClient, root); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (g *Graph) addInstallation(ctx context.Context, kubeClient client.Client, inst *lsv1alpha1.Installation) (string, error) {
+	instID := NodeID(InstallationNode, inst.Namespace, "", inst.Name)
+	g.AddNode(&Node{
+		ID:        instID,
+		Kind:      InstallationNode,
+		Name:      inst.Name,
+		Namespace: inst.Namespace,
+		Phase:     string(inst.Status.InstallationPhase),
+	})
+
+	// the imports and exports of an installation refer to the context of its parent installation,
+	// or to the objects in the namespace for root installations.
+	parentContext := inst.Labels[lsv1alpha1.EncompassedByLabel]
+	for _, imp := range inst.Spec.Imports.Data {
+		if len(imp.DataRef) == 0 {
+			continue
+		}
+		g.AddEdge(g.addContextNode(DataObjectNode, inst.Namespace, parentContext, imp.DataRef), instID, ImportEdge, imp.Name)
+	}
+	for _, imp := range inst.Spec.Imports.Targets {
+		for _, target := range importedTargets(imp) {
+			g.AddEdge(g.addContextNode(TargetNode, inst.Namespace, parentContext, target), instID, ImportEdge, imp.Name)
+		}
+	}
+	for _, exp := range inst.Spec.Exports.Data {
+		g.AddEdge(instID, g.addContextNode(DataObjectNode, inst.Namespace, parentContext, exp.DataRef), ExportEdge, exp.Name)
+	}
+	for _, exp := range inst.Spec.Exports.Targets {
+		g.AddEdge(instID, g.addContextNode(TargetNode, inst.Namespace, parentContext, exp.Target), ExportEdge, exp.Name)
+	}
+
+	exec, err := executions.GetExecutionForInstallation(ctx, kubeClient, inst)
+	if err != nil {
+		return "", err
+	}
+	if exec != nil {
+		execID, err := g.addExecution(ctx, kubeClient, exec)
+		if err != nil {
+			return "", err
+		}
+		g.AddEdge(instID, execID, OwnsEdge, "")
+	}
+
+	subinsts, err := installations.ListSubinstallations(ctx, kubeClient, inst)
+	if err != nil {
+		return "", err
+	}
+	for _, subinst := range subinsts {
+		subinstID, err := g.addInstallation(ctx, kubeClient, subinst)
+		if err != nil {
+			return "", err
+		}
+		g.AddEdge(instID, subinstID, OwnsEdge, "")
+	}
+
+	// the imports of an installation are available for its subinstallations under the name of the import
+	for _, imp := range inst.Spec.Imports.Data {
+		if len(imp.DataRef) == 0 {
+			continue
+		}
+		if forwardedID := NodeID(DataObjectNode, inst.Namespace, inst.Name, imp.Name); g.GetNode(forwardedID) != nil {
+			g.AddEdge(NodeID(DataObjectNode, inst.Namespace, parentContext, imp.DataRef), forwardedID, ForwardEdge, "")
+		}
+	}
+	for _, imp := range inst.Spec.Imports.Targets {
+		if forwardedID := NodeID(TargetNode, inst.Namespace, inst.Name, imp.Name); g.GetNode(forwardedID) != nil {
+			for _, target := range importedTargets(imp) {
+				g.AddEdge(NodeID(TargetNode, inst.Namespace, parentContext, target), forwardedID, ForwardEdge, "")
+			}
+		}
+	}
+
+	return instID, nil
+}
+
+func (g *Graph) addExecution(ctx context.Context, kubeClient client.Client, exec *lsv1alpha1.Execution) (string, error) {
+	execID := NodeID(ExecutionNode, exec.Namespace, "", exec.Name)
+	g.AddNode(&Node{
+		ID:        execID,
+		Kind:      ExecutionNode,
+		Name:      exec.Name,
+		Namespace: exec.Namespace,
+		Phase:     string(exec.Status.ExecutionPhase),
+	})
+
+	deployItemList := &lsv1alpha1.DeployItemList{}
+	if err := read_write_layer.ListDeployItems(ctx, kubeClient, deployItemList,
+		client.MatchingLabels{lsv1alpha1.ExecutionManagedByLabel: exec.Name}, client.InNamespace(exec.Namespace)); err != nil {
+		return "", err
+	}
+	managedItems := map[string]*lsv1alpha1.DeployItem{}
+	for i := range deployItemList.Items {
+		item := &deployItemList.Items[i]
+		managedItems[item.Labels[lsv1alpha1.ExecutionManagedNameLabel]] = item
+	}
+
+	// deploy items that have not been created yet are identified by the name of their template
+	itemIDs := map[string]string{}
+	for _, tmpl := range exec.Spec.DeployItems {
+		node := &Node{
+			ID:        NodeID(DeployItemNode, exec.Namespace, exec.Name, tmpl.Name),
+			Kind:      DeployItemNode,
+			Name:      tmpl.Name,
+			Namespace: exec.Namespace,
+			Type:      string(tmpl.Type),
+		}
+		if item, ok := managedItems[tmpl.Name]; ok {
+			node.ID = NodeID(DeployItemNode, item.Namespace, "", item.Name)
+			node.Name = item.Name
+			node.Phase = string(item.Status.DeployItemPhase)
+			delete(managedItems, tmpl.Name)
+		}
+		g.AddNode(node)
+		g.AddEdge(execID, node.ID, OwnsEdge, "")
+		itemIDs[tmpl.Name] = node.ID
+
+		if tmpl.Target != nil {
+			targetNamespace := tmpl.Target.Namespace
+			if len(targetNamespace) == 0 {
+				targetNamespace = exec.Namespace
+			}
+			g.AddEdge(g.addContextNode(TargetNode, targetNamespace, "", tmpl.Target.Name), node.ID, ImportEdge, "target")
+		}
+	}
+
+	for _, tmpl := range exec.Spec.DeployItems {
+		for _, dependency := range tmpl.DependsOn {
+			if dependencyID, ok := itemIDs[dependency]; ok {
+				g.AddEdge(dependencyID, itemIDs[tmpl.Name], DependsOnEdge, "")
+			}
+		}
+	}
+
+	// deploy items that are not defined by the execution anymore but have not been deleted yet
+	for _, item := range deployItemList.Items {
+		if _, ok := managedItems[item.Labels[lsv1alpha1.ExecutionManagedNameLabel]]; !ok {
+			continue
+		}
+		itemID := NodeID(DeployItemNode, item.Namespace, "", item.Name)
+		g.AddNode(&Node{
+			ID:        itemID,
+			Kind:      DeployItemNode,
+			Name:      item.Name,
+			Namespace: item.Namespace,
+			Type:      string(item.Spec.Type),
+			Phase:     string(item.Status.DeployItemPhase),
+		})
+		g.AddEdge(execID, itemID, OwnsEdge, "")
+	}
+
+	return execID, nil
+}
+
+// addContextNode adds the node of a data object or target of a context and returns its id.
+func (g *Graph) addContextNode(kind NodeKind, namespace, contextName, name string) string {
+	return g.AddNode(&Node{
+		ID:        NodeID(kind, namespace, contextName, name),
+		Kind:      kind,
+		Name:      name,
+		Namespace: namespace,
+		Context:   contextName,
+	}).ID
+}
+
+// importedTargets returns the names of the targets or target lists of a target import.
+func importedTargets(imp lsv1alpha1.TargetImport) []string {
+	if len(imp.Target) != 0 {
+		return []string{imp.Target}
+	}
+	if len(imp.TargetListReference) != 0 {
+		return []string{imp.TargetListReference}
+	}
+	return imp.Targets
+}
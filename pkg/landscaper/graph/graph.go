@@ -0,0 +1,198 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// NodeKind is the kind of the object that is represented by a node.
+type NodeKind string
+
+const (
+	InstallationNode NodeKind = "Installation"
+	ExecutionNode    NodeKind = "Execution"
+	DeployItemNode   NodeKind = "DeployItem"
+	DataObjectNode   NodeKind = "DataObject"
+	TargetNode       NodeKind = "Target"
+)
+
+// EdgeType is the type of the relation between two nodes.
+type EdgeType string
+
+const (
+	// OwnsEdge points from an object to its subinstallations, its execution or its deploy items.
+	OwnsEdge EdgeType = "owns"
+	// ImportEdge points from a data object or target to the installation that imports it.
+	ImportEdge EdgeType = "import"
+	// ExportEdge points from an installation to the data object or target that it exports.
+	ExportEdge EdgeType = "export"
+	// ForwardEdge points from a data object or target that is imported by an installation to the data object or
+	// target with the name of the import, which is available for the subinstallations of the installation.
+	ForwardEdge EdgeType = "forward"
+	// DependsOnEdge points from a deploy item to the deploy items that depend on it.
+	DependsOnEdge EdgeType = "dependsOn"
+)
+
+// Node is an object of the landscape.
+type Node struct {
+	// ID uniquely identifies the node in the graph.
+	ID string `json:"id"`
+	// Kind is the kind of the represented object.
+	Kind NodeKind `json:"kind"`
+	// Name is the name of the represented object.
+	// For data objects and targets, it is the name that is used in the imports and exports of the installations.
+	Name string `json:"name"`
+	// Namespace is the namespace of the represented object.
+	Namespace string `json:"namespace"`
+	// Context is the name of the installation whose subinstallations share the data object or target.
+	// It is empty for data objects and targets that are shared by root installations.
+	Context string `json:"context,omitempty"`
+	// Type is the type of a deploy item.
+	Type string `json:"type,omitempty"`
+	// Phase is the current phase of an installation, execution or deploy item.
+	Phase string `json:"phase,omitempty"`
+}
+
+// Edge is a directed relation between two nodes.
+type Edge struct {
+	From string   `json:"from"`
+	To   string   `json:"to"`
+	Type EdgeType `json:"type"`
+	// Label is the name of the import or export for import and export edges.
+	Label string `json:"label,omitempty"`
+}
+
+// Graph describes the installations of a landscape together with their executions and deploy items
+// and the data objects and targets that flow between them.
+type Graph struct {
+	Nodes []*Node `json:"nodes"`
+	Edges []*Edge `json:"edges"`
+
+	nodes map[string]*Node
+	edges map[Edge]bool
+}
+
+// New creates an empty graph.
+func New() *Graph {
+	return &Graph{
+		Nodes: []*Node{},
+		Edges: []*Edge{},
+		nodes: map[string]*Node{},
+		edges: map[Edge]bool{},
+	}
+}
+
+// NodeID returns the id of the node for an object of the given kind.
+func NodeID(kind NodeKind, namespace, context, name string) string {
+	if len(context) != 0 {
+		return fmt.Sprintf("%s/%s/%s/%s", kind, namespace, context, name)
+	}
+	return fmt.Sprintf("%s/%s/%s", kind, namespace, name)
+}
+
+// AddNode adds a node to the graph and returns it.
+// If the graph already contains a node with the same id, the existing node is returned.
+func (g *Graph) AddNode(node *Node) *Node {
+	if existing, ok := g.nodes[node.ID]; ok {
+		return existing
+	}
+	g.nodes[node.ID] = node
+	g.Nodes = append(g.Nodes, node)
+	return node
+}
+
+// GetNode returns the node with the given id or nil if the graph does not contain it.
+func (g *Graph) GetNode(id string) *Node {
+	return g.nodes[id]
+}
+
+// AddEdge adds an edge between two nodes. Duplicate edges are ignored.
+func (g *Graph) AddEdge(from, to string, edgeType EdgeType, label string) {
+	edge := Edge{From: from, To: to, Type: edgeType, Label: label}
+	if g.edges[edge] {
+		return
+	}
+	g.edges[edge] = true
+	g.Edges = append(g.Edges, &edge)
+}
+
+// WriteJSON writes the graph as json.
+func (g *Graph) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(g)
+}
+
+// WriteDOT writes the graph in the Graphviz DOT language.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	builder := strings.Builder{}
+	builder.WriteString("digraph landscape {\n")
+	builder.WriteString("  rankdir=LR;\n")
+	builder.WriteString("  node [fontsize=10];\n")
+	builder.WriteString("  edge [fontsize=8];\n")
+
+	for _, node := range g.Nodes {
+		label := fmt.Sprintf("%s\n%s", node.Kind, node.Name)
+		if len(node.Type) != 0 {
+			label += fmt.Sprintf("\n%s", node.Type)
+		}
+		if len(node.Phase) != 0 {
+			label += fmt.Sprintf("\n[%s]", node.Phase)
+		}
+		builder.WriteString(fmt.Sprintf("  %s [label=%s, shape=%s];\n", quote(node.ID), quote(label), nodeShape(node.Kind)))
+	}
+
+	for _, edge := range g.Edges {
+		attributes := []string{fmt.Sprintf("style=%s", edgeStyle(edge.Type))}
+		if len(edge.Label) != 0 {
+			attributes = append(attributes, fmt.Sprintf("label=%s", quote(edge.Label)))
+		}
+		builder.WriteString(fmt.Sprintf("  %s -> %s [%s];\n", quote(edge.From), quote(edge.To), strings.Join(attributes, ", ")))
+	}
+
+	builder.WriteString("}\n")
+	_, err := io.WriteString(w, builder.String())
+	return err
+}
+
+func nodeShape(kind NodeKind) string {
+	switch kind {
+	case InstallationNode:
+		return "box"
+	case ExecutionNode:
+		return "hexagon"
+	case DeployItemNode:
+		return "component"
+	case DataObjectNode:
+		return "note"
+	case TargetNode:
+		return "cylinder"
+	default:
+		return "ellipse"
+	}
+}
+
+func edgeStyle(edgeType EdgeType) string {
+	switch edgeType {
+	case OwnsEdge:
+		return "dashed"
+	case DependsOnEdge:
+		return "dotted"
+	default:
+		return "solid"
+	}
+}
+
+// quote returns the given string as quoted DOT id.
+func quote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return fmt.Sprintf(`"%s"`, s)
+}
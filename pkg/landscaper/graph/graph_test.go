@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graph_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/graph"
+)
+
+var _ = Describe("Graph", func() {
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+	)
+
+	newInstallation := func(name, parent string, imports lsv1alpha1.InstallationImports, exports lsv1alpha1.InstallationExports) *lsv1alpha1.Installation {
+		inst := &lsv1alpha1.Installation{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+			Spec: lsv1alpha1.InstallationSpec{
+				Imports: imports,
+				Exports: exports,
+			},
+		}
+		if len(parent) != 0 {
+			inst.Labels = map[string]string{lsv1alpha1.EncompassedByLabel: parent}
+		}
+		return inst
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		root := newInstallation("root", "", lsv1alpha1.InstallationImports{
+			Data:    []lsv1alpha1.DataImport{{Name: "config", DataRef: "root-config"}},
+			Targets: []lsv1alpha1.TargetImport{{Name: "cluster", Target: "my-cluster"}},
+		}, lsv1alpha1.InstallationExports{})
+		root.Status.InstallationPhase = lsv1alpha1.InstallationPhaseSucceeded
+
+		producer := newInstallation("producer", "root", lsv1alpha1.InstallationImports{
+			Data:    []lsv1alpha1.DataImport{{Name: "producer-config", DataRef: "config"}},
+			Targets: []lsv1alpha1.TargetImport{{Name: "producer-cluster", Target: "cluster"}},
+		}, lsv1alpha1.InstallationExports{
+			Data: []lsv1alpha1.DataExport{{Name: "endpoint", DataRef: "endpoint"}},
+		})
+		consumer := newInstallation("consumer", "root", lsv1alpha1.InstallationImports{
+			Data: []lsv1alpha1.DataImport{{Name: "consumer-endpoint", DataRef: "endpoint"}},
+		}, lsv1alpha1.InstallationExports{})
+
+		exec := &lsv1alpha1.Execution{
+			ObjectMeta: metav1.ObjectMeta{Name: "producer", Namespace: "test"},
+			Spec: lsv1alpha1.ExecutionSpec{
+				DeployItems: lsv1alpha1.DeployItemTemplateList{
+					{Name: "first", Type: "landscaper.gardener.cloud/mock", Target: &lsv1alpha1.ObjectReference{Name: "my-target"}},
+					{Name: "second", Type: "landscaper.gardener.cloud/mock", DependsOn: []string{"first"}},
+				},
+			},
+		}
+		exec.Status.ExecutionPhase = lsv1alpha1.ExecPhaseProgressing
+
+		di := &lsv1alpha1.DeployItem{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "producer-first-abcde",
+				Namespace: "test",
+				Labels: map[string]string{
+					lsv1alpha1.ExecutionManagedByLabel:   "producer",
+					lsv1alpha1.ExecutionManagedNameLabel: "first",
+				},
+			},
+			Spec: lsv1alpha1.DeployItemSpec{Type: "landscaper.gardener.cloud/mock"},
+		}
+		di.Status.DeployItemPhase = lsv1alpha1.DeployItemPhaseSucceeded
+
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).
+			WithObjects(root, producer, consumer, exec, di).Build()
+	})
+
+	build := func() *graph.Graph {
+		root := &lsv1alpha1.Installation{}
+		Expect(kubeClient.Get(ctx, client.ObjectKey{Name: "root", Namespace: "test"}, root)).To(Succeed())
+		g, err := graph.Build(ctx, kubeClient, root)
+		Expect(err).ToNot(HaveOccurred())
+		return g
+	}
+
+	It("should build the graph of installations, executions, deploy items, data objects and targets", func() {
+		g := build()
+
+		rootID := graph.NodeID(graph.InstallationNode, "test", "", "root")
+		producerID := graph.NodeID(graph.InstallationNode, "test", "", "producer")
+		consumerID := graph.NodeID(graph.InstallationNode, "test", "", "consumer")
+		execID := graph.NodeID(graph.ExecutionNode, "test", "", "producer")
+		firstID := graph.NodeID(graph.DeployItemNode, "test", "", "producer-first-abcde")
+		secondID := graph.NodeID(graph.DeployItemNode, "test", "producer", "second")
+		rootConfigID := graph.NodeID(graph.DataObjectNode, "test", "", "root-config")
+		configID := graph.NodeID(graph.DataObjectNode, "test", "root", "config")
+		endpointID := graph.NodeID(graph.DataObjectNode, "test", "root", "endpoint")
+		myClusterID := graph.NodeID(graph.TargetNode, "test", "", "my-cluster")
+		clusterID := graph.NodeID(graph.TargetNode, "test", "root", "cluster")
+		myTargetID := graph.NodeID(graph.TargetNode, "test", "", "my-target")
+
+		Expect(g.GetNode(rootID).Phase).To(Equal(string(lsv1alpha1.InstallationPhaseSucceeded)))
+		Expect(g.GetNode(execID).Phase).To(Equal(string(lsv1alpha1.ExecPhaseProgressing)))
+		Expect(g.GetNode(firstID).Phase).To(Equal(string(lsv1alpha1.DeployItemPhaseSucceeded)))
+		Expect(g.GetNode(secondID).Phase).To(BeEmpty())
+
+		Expect(g.Edges).To(ConsistOf(
+			&graph.Edge{From: rootConfigID, To: rootID, Type: graph.ImportEdge, Label: "config"},
+			&graph.Edge{From: myClusterID, To: rootID, Type: graph.ImportEdge, Label: "cluster"},
+			&graph.Edge{From: rootID, To: producerID, Type: graph.OwnsEdge},
+			&graph.Edge{From: rootID, To: consumerID, Type: graph.OwnsEdge},
+			&graph.Edge{From: configID, To: producerID, Type: graph.ImportEdge, Label: "producer-config"},
+			&graph.Edge{From: clusterID, To: producerID, Type: graph.ImportEdge, Label: "producer-cluster"},
+			&graph.Edge{From: producerID, To: endpointID, Type: graph.ExportEdge, Label: "endpoint"},
+			&graph.Edge{From: endpointID, To: consumerID, Type: graph.ImportEdge, Label: "consumer-endpoint"},
+			&graph.Edge{From: rootConfigID, To: configID, Type: graph.ForwardEdge},
+			&graph.Edge{From: myClusterID, To: clusterID, Type: graph.ForwardEdge},
+			&graph.Edge{From: producerID, To: execID, Type: graph.OwnsEdge},
+			&graph.Edge{From: execID, To: firstID, Type: graph.OwnsEdge},
+			&graph.Edge{From: execID, To: secondID, Type: graph.OwnsEdge},
+			&graph.Edge{From: myTargetID, To: firstID, Type: graph.ImportEdge, Label: "target"},
+			&graph.Edge{From: firstID, To: secondID, Type: graph.DependsOnEdge},
+		))
+	})
+
+	It("should write the graph as json", func() {
+		g := build()
+
+		buf := &bytes.Buffer{}
+		Expect(g.WriteJSON(buf)).To(Succeed())
+
+		decoded := &graph.Graph{}
+		Expect(json.Unmarshal(buf.Bytes(), decoded)).To(Succeed())
+		Expect(decoded.Nodes).To(Equal(g.Nodes))
+		Expect(decoded.Edges).To(Equal(g.Edges))
+	})
+
+	It("should write the graph as dot", func() {
+		g := graph.New()
+		g.AddNode(&graph.Node{ID: "a", Kind: graph.InstallationNode, Name: "a", Phase: "Succeeded"})
+		g.AddNode(&graph.Node{ID: "b", Kind: graph.DataObjectNode, Name: `b"1`})
+		g.AddEdge("a", "b", graph.ExportEdge, "out")
+		g.AddEdge("a", "b", graph.ExportEdge, "out")
+
+		buf := &bytes.Buffer{}
+		Expect(g.WriteDOT(buf)).To(Succeed())
+		Expect(buf.String()).To(Equal(`digraph landscape {
+  rankdir=LR;
+  node [fontsize=10];
+  edge [fontsize=8];
+  "a" [label="Installation\na\n[Succeeded]", shape=box];
+  "b" [label="DataObject\nb\"1", shape=note];
+  "a" -> "b" [style=solid, label="out"];
+}
+`))
+	})
+
+})
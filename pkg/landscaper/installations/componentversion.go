@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/gardener/component-spec/bindings-go/ctf"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	componentsregistry "github.com/gardener/landscaper/pkg/landscaper/registry/components"
+)
+
+// GetComponentVersionConstraint returns the version constraint of the component reference of an installation.
+// It returns an empty string if the installation references an exact component version.
+func GetComponentVersionConstraint(inst *lsv1alpha1.Installation) string {
+	if inst.Spec.ComponentDescriptor == nil || inst.Spec.ComponentDescriptor.Reference == nil {
+		return ""
+	}
+	return inst.Spec.ComponentDescriptor.Reference.VersionConstraint
+}
+
+// GetResolvedComponentVersion returns the version that has been resolved for the current version constraint
+// of the component reference of an installation, or an empty string if the constraint has not been resolved yet.
+func GetResolvedComponentVersion(inst *lsv1alpha1.Installation) string {
+	resolved := inst.Status.ResolvedComponentVersion
+	if resolved == nil || resolved.Constraint != GetComponentVersionConstraint(inst) {
+		return ""
+	}
+	return resolved.Version
+}
+
+// ComponentVersionResolutionRequired returns whether the version constraint of the component reference of an
+// installation has to be resolved, which is the case once per job of the installation.
+func ComponentVersionResolutionRequired(inst *lsv1alpha1.Installation) bool {
+	if len(GetComponentVersionConstraint(inst)) == 0 {
+		return false
+	}
+	return len(GetResolvedComponentVersion(inst)) == 0 || inst.Status.ResolvedComponentVersion.JobID != inst.Status.JobID
+}
+
+// GetLatestComponentVersion returns the highest version of a component that satisfies the version constraint
+// of the component reference.
+func GetLatestComponentVersion(ctx context.Context, compResolver ctf.ComponentResolver, cdRef *lsv1alpha1.ComponentDescriptorReference) (string, error) {
+	if cdRef.RepositoryContext == nil {
+		return "", MissingRepositoryContextError
+	}
+	lister, ok := compResolver.(componentsregistry.ComponentVersionLister)
+	if !ok {
+		return "", fmt.Errorf("the component registry does not support listing component versions")
+	}
+	versions, err := lister.ListComponentVersions(ctx, cdRef.RepositoryContext, cdRef.ComponentName)
+	if err != nil {
+		return "", err
+	}
+	version, err := ResolveVersionConstraint(cdRef.VersionConstraint, versions)
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve version of component %s: %w", cdRef.ComponentName, err)
+	}
+	return version, nil
+}
+
+// ResolveVersionConstraint returns the highest of the given versions that satisfies a semantic version constraint.
+// Versions that are not valid semantic versions are ignored.
+func ResolveVersionConstraint(constraint string, versions []string) (string, error) {
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return "", fmt.Errorf("invalid version constraint %q: %w", constraint, err)
+	}
+
+	var (
+		latest        *semver.Version
+		latestVersion string
+	)
+	for _, version := range versions {
+		v, err := semver.NewVersion(version)
+		if err != nil || !c.Check(v) {
+			continue
+		}
+		if latest == nil || v.GreaterThan(latest) {
+			latest = v
+			latestVersion = version
+		}
+	}
+	if latest == nil {
+		return "", fmt.Errorf("no version satisfies the constraint %q", constraint)
+	}
+	return latestVersion, nil
+}
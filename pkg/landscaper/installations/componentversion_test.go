@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+)
+
+var _ = Describe("ComponentVersion", func() {
+
+	Context("ResolveVersionConstraint", func() {
+		versions := []string{"v1.3.0", "v1.4.0", "v1.4.2", "v1.5.0", "v2.0.0", "v2.1.0-rc.1", "v3.0.0", "latest"}
+
+		It("should return the highest version that satisfies a tilde constraint", func() {
+			version, err := installations.ResolveVersionConstraint("~1.4", versions)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(version).To(Equal("v1.4.2"))
+		})
+
+		It("should return the highest version that satisfies a range constraint", func() {
+			version, err := installations.ResolveVersionConstraint(">=2.0 <3.0", versions)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(version).To(Equal("v2.0.0"))
+		})
+
+		It("should consider prerelease versions only if the constraint contains a prerelease", func() {
+			version, err := installations.ResolveVersionConstraint("~2.1.0-0", versions)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(version).To(Equal("v2.1.0-rc.1"))
+		})
+
+		It("should return an error if no version satisfies the constraint", func() {
+			_, err := installations.ResolveVersionConstraint("^4", versions)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return an error for an invalid constraint", func() {
+			_, err := installations.ResolveVersionConstraint("not a constraint", versions)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("ComponentVersionResolutionRequired", func() {
+		var inst *lsv1alpha1.Installation
+
+		BeforeEach(func() {
+			inst = &lsv1alpha1.Installation{}
+			inst.Spec.ComponentDescriptor = &lsv1alpha1.ComponentDescriptorDefinition{
+				Reference: &lsv1alpha1.ComponentDescriptorReference{
+					ComponentName:     "example.com/a",
+					VersionConstraint: "~1.4",
+				},
+			}
+			inst.Status.JobID = "job1"
+		})
+
+		It("should not require a resolution for an exact version", func() {
+			inst.Spec.ComponentDescriptor.Reference.VersionConstraint = ""
+			inst.Spec.ComponentDescriptor.Reference.Version = "v1.4.0"
+			Expect(installations.ComponentVersionResolutionRequired(inst)).To(BeFalse())
+		})
+
+		It("should require a resolution if the constraint has not been resolved yet", func() {
+			Expect(installations.ComponentVersionResolutionRequired(inst)).To(BeTrue())
+			Expect(installations.GetResolvedComponentVersion(inst)).To(BeEmpty())
+		})
+
+		It("should require a resolution once per job", func() {
+			inst.Status.ResolvedComponentVersion = &lsv1alpha1.ResolvedComponentVersion{
+				Constraint: "~1.4",
+				Version:    "v1.4.2",
+				JobID:      "job1",
+			}
+			Expect(installations.ComponentVersionResolutionRequired(inst)).To(BeFalse())
+			Expect(installations.GetResolvedComponentVersion(inst)).To(Equal("v1.4.2"))
+
+			inst.Status.JobID = "job2"
+			Expect(installations.ComponentVersionResolutionRequired(inst)).To(BeTrue())
+		})
+
+		It("should require a resolution if the constraint has changed", func() {
+			inst.Status.ResolvedComponentVersion = &lsv1alpha1.ResolvedComponentVersion{
+				Constraint: "~1.3",
+				Version:    "v1.3.0",
+				JobID:      "job1",
+			}
+			Expect(installations.ComponentVersionResolutionRequired(inst)).To(BeTrue())
+			Expect(installations.GetResolvedComponentVersion(inst)).To(BeEmpty())
+		})
+	})
+
+})
@@ -8,10 +8,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
@@ -102,6 +104,9 @@ type ExternalContext struct {
 	ComponentVersion string
 	// Overwriter is the component version overwriter used for this installation.
 	Overwriter componentoverwrites.Overwriter
+	// ConstrainedComponentRef is the component reference of the installation with the defaulted repository context
+	// before overwrites are applied, if the reference defines a version constraint.
+	ConstrainedComponentRef *lsv1alpha1.ComponentDescriptorReference
 }
 
 // ComponentDescriptorRef returns the component descriptor reference for the current installation
@@ -205,6 +210,69 @@ func (o *Operation) IsRoot() bool {
 // MissingRepositoryContextError defines a error when no repository context is defined.
 var MissingRepositoryContextError = errors.New("RepositoryContextMissing")
 
+// getComponentVersionOverwrites returns the ComponentVersionOverwrites that are referenced by a context,
+// or nil if the context does not reference any.
+func getComponentVersionOverwrites(ctx context.Context, kubeClient client.Client, lsCtx *lsv1alpha1.Context) (*lsv1alpha1.ComponentVersionOverwrites, error) {
+	if len(lsCtx.ComponentVersionOverwritesReference) == 0 {
+		return nil, nil
+	}
+	cvo := &lsv1alpha1.ComponentVersionOverwrites{}
+	if err := kubeClient.Get(ctx, kutil.ObjectKey(lsCtx.ComponentVersionOverwritesReference, lsCtx.Namespace), cvo); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, lserrors.NewWrappedError(err, "ComponentVersionOverwrites", "GetComponentVersionOverwrites", fmt.Sprintf("context '%s' references ComponentVersionOverwrites resource '%s', which cannot be found: %s", lsCtx.Name, lsCtx.ComponentVersionOverwritesReference, err.Error()))
+		}
+		return nil, lserrors.NewWrappedError(err, "ComponentVersionOverwrites", "GetComponentVersionOverwrites", err.Error())
+	}
+	return cvo, nil
+}
+
+// ComponentOverwritesEvaluationRequired returns whether the time windows of the component version overwrites
+// have to be evaluated for an installation, which is the case once per job of the installation.
+func ComponentOverwritesEvaluationRequired(inst *lsv1alpha1.Installation) bool {
+	evaluation := inst.Status.ComponentOverwritesEvaluation
+	return evaluation == nil || evaluation.JobID != inst.Status.JobID
+}
+
+// GetComponentOverwritesEvaluationTime returns the time at which the time windows of the component version overwrites
+// are evaluated for the current job of an installation.
+// The current time is returned if the overwrites have not been evaluated for the current job.
+func GetComponentOverwritesEvaluationTime(inst *lsv1alpha1.Installation) time.Time {
+	return componentoverwrites.EvaluationTime(inst, time.Now())
+}
+
+// EvaluateComponentOverwrites records the given time as evaluation time of the component version overwrites
+// for the current job of an installation, together with the next time at which an overwrite that selects
+// the installation starts or stops to apply.
+func EvaluateComponentOverwrites(ctx context.Context, kubeClient client.Client, inst *lsv1alpha1.Installation, now time.Time) error {
+	evaluation := &lsv1alpha1.ComponentOverwritesEvaluation{
+		JobID: inst.Status.JobID,
+		Time:  metav1.NewTime(now),
+	}
+
+	if len(inst.Spec.Context) != 0 {
+		lsCtx := &lsv1alpha1.Context{}
+		if err := kubeClient.Get(ctx, kutil.ObjectKey(inst.Spec.Context, inst.Namespace), lsCtx); err != nil {
+			return lserrors.NewWrappedError(err, "Context", "GetContext", err.Error())
+		}
+		cvo, err := getComponentVersionOverwrites(ctx, kubeClient, lsCtx)
+		if err != nil {
+			return err
+		}
+		if cvo != nil {
+			next, err := componentoverwrites.NextTransitionTimeForInstallation(cvo, inst, now)
+			if err != nil {
+				return lserrors.NewWrappedError(err, "ComponentVersionOverwrites", "GetComponentVersionOverwrites", err.Error())
+			}
+			if next != nil {
+				evaluation.NextTransitionTime = &metav1.Time{Time: *next}
+			}
+		}
+	}
+
+	inst.Status.ComponentOverwritesEvaluation = evaluation
+	return nil
+}
+
 // GetExternalContext resolves the context for an installation and applies defaults or overwrites if applicable.
 func GetExternalContext(ctx context.Context, kubeClient client.Client, inst *lsv1alpha1.Installation) (ExternalContext, error) {
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
@@ -218,19 +286,19 @@ func GetExternalContext(ctx context.Context, kubeClient client.Client, inst *lsv
 		}
 
 		// check for ComponentVersionOverwrites
-		if len(lsCtx.ComponentVersionOverwritesReference) > 0 {
-			cvo = &lsv1alpha1.ComponentVersionOverwrites{}
-			if err := kubeClient.Get(ctx, kutil.ObjectKey(lsCtx.ComponentVersionOverwritesReference, inst.Namespace), cvo); err != nil {
-				if apierrors.IsNotFound(err) {
-					return ExternalContext{}, lserrors.NewWrappedError(err, "ComponentVersionOverwrites", "GetComponentVersionOverwrites", fmt.Sprintf("context '%s' references ComponentVersionOverwrites resource '%s', which cannot be found: %s", lsCtx.Name, lsCtx.ComponentVersionOverwritesReference, err.Error()))
-				}
-				return ExternalContext{}, lserrors.NewWrappedError(err, "ComponentVersionOverwrites", "GetComponentVersionOverwrites", err.Error())
-			}
+		var err error
+		cvo, err = getComponentVersionOverwrites(ctx, kubeClient, lsCtx)
+		if err != nil {
+			return ExternalContext{}, err
 		}
 	}
 
 	if cvo != nil {
-		overwriter = componentoverwrites.NewSubstitutions(cvo.Overwrites)
+		subs, err := componentoverwrites.NewSubstitutionsForInstallation(cvo, inst, GetComponentOverwritesEvaluationTime(inst))
+		if err != nil {
+			return ExternalContext{}, lserrors.NewWrappedError(err, "ComponentVersionOverwrites", "GetComponentVersionOverwrites", err.Error())
+		}
+		overwriter = subs
 		logger.Debug("Found ComponentVersionOverwrites for context", "context", inst.Spec.Context, lc.KeyResource, lsCtx.ComponentVersionOverwritesReference, lc.KeyResourceKind, "ComponentVersionOverwrites")
 	}
 
@@ -243,6 +311,19 @@ func GetExternalContext(ctx context.Context, kubeClient client.Client, inst *lsv
 		}, nil
 	}
 
+	// a version constraint is replaced by the version that has been resolved for the current job,
+	// so that overwrites still take precedence.
+	var constrainedRef *lsv1alpha1.ComponentDescriptorReference
+	if len(cdRef.VersionConstraint) != 0 {
+		cdRef = cdRef.DeepCopy()
+		if cdRef.RepositoryContext == nil {
+			cdRef.RepositoryContext = lsCtx.RepositoryContext
+		}
+		constrainedRef = cdRef.DeepCopy()
+		cdRef.Version = GetResolvedComponentVersion(inst)
+		cdRef.VersionConstraint = ""
+	}
+
 	cond, err := ApplyComponentOverwrite(ctx, inst, overwriter, lsCtx, cdRef)
 	if err != nil {
 		return ExternalContext{}, lserrors.NewWrappedError(err,
@@ -256,10 +337,11 @@ func GetExternalContext(ctx context.Context, kubeClient client.Client, inst *lsv
 	}
 	lsCtx.RepositoryContext = cdRef.RepositoryContext
 	return ExternalContext{
-		Context:          *lsCtx,
-		ComponentName:    cdRef.ComponentName,
-		ComponentVersion: cdRef.Version,
-		Overwriter:       overwriter,
+		Context:                 *lsCtx,
+		ComponentName:           cdRef.ComponentName,
+		ComponentVersion:        cdRef.Version,
+		Overwriter:              overwriter,
+		ConstrainedComponentRef: constrainedRef,
 	}, nil
 }
 
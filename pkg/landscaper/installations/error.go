@@ -7,6 +7,7 @@ package installations
 import (
 	"fmt"
 
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lserror "github.com/gardener/landscaper/apis/errors"
 )
 
@@ -18,11 +19,19 @@ const (
 	ImportNotSatisfied     ErrorReason = "ImportNotSatisfied"
 	NotCompletedDependents ErrorReason = "NotCompletedDependents"
 	SchemaValidationFailed ErrorReason = "SchemaValidationFailed"
+	ValidationRuleFailed   ErrorReason = "ValidationRuleFailed"
 )
 
+// errorCodes defines the error codes of the errors with a specific reason.
+// Invalid imports can only be fixed by changing the imports, so they are not retried automatically by default.
+var errorCodes = map[ErrorReason][]lsv1alpha1.ErrorCode{
+	SchemaValidationFailed: {lsv1alpha1.ErrorConfigurationProblem},
+	ValidationRuleFailed:   {lsv1alpha1.ErrorConfigurationProblem},
+}
+
 // NewErrorf creates a new import error with a formated message
 func NewErrorf(reason ErrorReason, err error, format string, a ...interface{}) lserror.LsError {
-	return lserror.NewWrappedError(err, string(reason), string(reason), fmt.Sprintf(format, a...))
+	return lserror.NewWrappedError(err, string(reason), string(reason), fmt.Sprintf(format, a...), errorCodes[reason]...)
 }
 
 // NewImportNotFoundErrorf creates a new error that indicates that a import was not found with a formatted message
@@ -50,6 +59,11 @@ func IsSchemaValidationFailedError(err error) bool {
 	return IsErrorForReason(err, SchemaValidationFailed)
 }
 
+// IsValidationRuleFailedError checks if the provided error is of type ValidationRuleFailed
+func IsValidationRuleFailedError(err error) bool {
+	return IsErrorForReason(err, ValidationRuleFailed)
+}
+
 func IsImportNotFoundError(err error) bool {
 	return IsErrorForReason(err, ImportNotFound)
 }
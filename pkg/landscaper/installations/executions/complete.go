@@ -30,7 +30,7 @@ func (o *ExecutionOperation) GetExportedValues(ctx context.Context, inst *instal
 
 	doName := lsv1alpha1helper.GenerateDataObjectName(lsv1alpha1helper.DataObjectSourceFromExecution(exec), "")
 	rawDO := &lsv1alpha1.DataObject{}
-	if err := o.Client().Get(ctx, kutil.ObjectKey(doName, o.Inst.GetInstallation().Namespace), rawDO); err != nil {
+	if err := read_write_layer.GetDataObject(ctx, o.Client(), kutil.ObjectKey(doName, o.Inst.GetInstallation().Namespace), rawDO); err != nil {
 		return nil, err
 	}
 
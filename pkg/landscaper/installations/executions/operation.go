@@ -144,11 +144,6 @@ func (o *ExecutionOperation) Ensure(ctx context.Context, inst *installations.Ins
 
 	cond := lsv1alpha1helper.GetOrInitCondition(inst.GetInstallation().Status.Conditions, lsv1alpha1.ReconcileExecutionCondition)
 
-	exec := &lsv1alpha1.Execution{}
-	exec.Name = inst.GetInstallation().Name
-	exec.Namespace = inst.GetInstallation().Namespace
-	exec.Spec.RegistryPullSecrets = inst.GetInstallation().Spec.RegistryPullSecrets
-
 	versionedDeployItemTemplateList := lsv1alpha1.DeployItemTemplateList{}
 	if err := lsv1alpha1.Convert_core_DeployItemTemplateList_To_v1alpha1_DeployItemTemplateList(&execTemplates, &versionedDeployItemTemplateList, nil); err != nil {
 		err2 := fmt.Errorf("error converting internal representation of deployitem templates to versioned one: %w", err)
@@ -157,9 +152,34 @@ func (o *ExecutionOperation) Ensure(ctx context.Context, inst *installations.Ins
 		return err2
 	}
 
+	return o.ensureExecution(ctx, inst, versionedDeployItemTemplateList)
+}
+
+// EnsureRevision creates or updates the execution of the installation with the given deploy item templates,
+// which have been rendered by a previous revision of the installation.
+func (o *ExecutionOperation) EnsureRevision(ctx context.Context, inst *installations.InstallationImportsAndBlueprint,
+	deployItemTemplates lsv1alpha1.DeployItemTemplateList) error {
+	if len(deployItemTemplates) == 0 {
+		// in accordance with Ensure, no execution is created if there is nothing to deploy
+		return nil
+	}
+	return o.ensureExecution(ctx, inst, deployItemTemplates)
+}
+
+// ensureExecution creates or updates the execution of the installation with the given deploy item templates.
+func (o *ExecutionOperation) ensureExecution(ctx context.Context, inst *installations.InstallationImportsAndBlueprint,
+	versionedDeployItemTemplateList lsv1alpha1.DeployItemTemplateList) error {
+	cond := lsv1alpha1helper.GetOrInitCondition(inst.GetInstallation().Status.Conditions, lsv1alpha1.ReconcileExecutionCondition)
+
+	exec := &lsv1alpha1.Execution{}
+	exec.Name = inst.GetInstallation().Name
+	exec.Namespace = inst.GetInstallation().Namespace
+	exec.Spec.RegistryPullSecrets = inst.GetInstallation().Spec.RegistryPullSecrets
+
 	if _, err := o.Writer().CreateOrUpdateExecution(ctx, read_write_layer.W000022, exec, func() error {
 		exec.Spec.Context = inst.GetInstallation().Spec.Context
 		exec.Spec.DeployItems = versionedDeployItemTemplateList
+		exec.Spec.RequireApproval = inst.GetInstallation().Spec.RequireExecutionApproval
 
 		if lsv1alpha1helper.HasOperation(inst.GetInstallation().ObjectMeta, lsv1alpha1.ForceReconcileOperation) {
 			metav1.SetMetaDataAnnotation(&exec.ObjectMeta, lsv1alpha1.OperationAnnotation, string(lsv1alpha1.ForceReconcileOperation))
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package executions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+)
+
+// Plan renders the deploy item templates of the installation and compares them with the deploy item templates
+// of the existing execution. In contrast to Ensure, the execution is neither created nor updated.
+func (o *ExecutionOperation) Plan(ctx context.Context, inst *installations.InstallationImportsAndBlueprint) ([]lsv1alpha1.PlannedObject, error) {
+	execTemplates, err := o.RenderDeployItemTemplates(ctx, inst)
+	if execTemplates == nil || err != nil {
+		// the execution is not touched by Ensure if there is nothing to deploy
+		return nil, err
+	}
+
+	versionedDeployItemTemplateList := lsv1alpha1.DeployItemTemplateList{}
+	if err := lsv1alpha1.Convert_core_DeployItemTemplateList_To_v1alpha1_DeployItemTemplateList(&execTemplates, &versionedDeployItemTemplateList, nil); err != nil {
+		return nil, fmt.Errorf("error converting internal representation of deployitem templates to versioned one: %w", err)
+	}
+
+	exec, err := GetExecutionForInstallation(ctx, o.Client(), inst.GetInstallation())
+	if err != nil {
+		return nil, err
+	}
+
+	currentTemplates := map[string]lsv1alpha1.DeployItemTemplate{}
+	refs := map[string]*lsv1alpha1.ObjectReference{}
+	if exec != nil {
+		for _, tmpl := range exec.Spec.DeployItems {
+			currentTemplates[tmpl.Name] = tmpl
+		}
+		for _, ref := range exec.Status.DeployItemReferences {
+			refs[ref.Name] = ref.Reference.ObjectReference.DeepCopy()
+		}
+	}
+
+	sensitiveValues := inst.GetSensitiveImportValues()
+	planned := make([]lsv1alpha1.PlannedObject, 0, len(versionedDeployItemTemplateList))
+	renderedNames := map[string]bool{}
+	for _, tmpl := range versionedDeployItemTemplateList {
+		renderedNames[tmpl.Name] = true
+
+		var current interface{}
+		if currentTmpl, ok := currentTemplates[tmpl.Name]; ok {
+			current = currentTmpl
+		}
+
+		plannedObj, err := installations.NewPlannedObject(tmpl.Name, refs[tmpl.Name], current, tmpl, sensitiveValues)
+		if err != nil {
+			return nil, err
+		}
+		planned = append(planned, plannedObj)
+	}
+
+	for name, currentTmpl := range currentTemplates {
+		if renderedNames[name] {
+			continue
+		}
+		plannedObj, err := installations.NewPlannedObject(name, refs[name], currentTmpl, nil, sensitiveValues)
+		if err != nil {
+			return nil, err
+		}
+		planned = append(planned, plannedObj)
+	}
+
+	sort.Slice(planned, func(i, j int) bool {
+		return planned[i].Name < planned[j].Name
+	})
+	return planned, nil
+}
@@ -13,6 +13,7 @@ import (
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
@@ -30,6 +31,7 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
 // Constructor is a struct that contains all values
@@ -66,7 +68,15 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 		internalExports["deployitems"] = execDo.Data
 	}
 
-	dataObjectMap, err := c.aggregateDataObjectsInContext(ctx)
+	// sensitive values of the data objects in the context and of the imports are tracked,
+	// so that exports which use these values are stored as sensitive data objects as well.
+	sensitiveValues := dataobjects.NewSensitiveValues()
+	for name, value := range c.Inst.GetImports() {
+		if c.Inst.IsSensitiveImport(name) {
+			sensitiveValues.Add(value)
+		}
+	}
+	dataObjectMap, err := c.aggregateDataObjectsInContext(ctx, sensitiveValues)
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to aggregate data object: %w", err)
 	}
@@ -89,7 +99,15 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 		return nil, nil, err
 	}
 
+	// the values of exports that are defined as sensitive are sensitive in all other exports as well
+	for name, data := range exports {
+		if def, err := c.Inst.GetExportDefinition(name); err == nil && def.Sensitive {
+			sensitiveValues.Add(data)
+		}
+	}
+
 	// validate all exports
+	sensitiveExports := sets.NewString()
 	for name := range exports {
 		def, err := c.Inst.GetExportDefinition(name)
 		if err != nil {
@@ -99,6 +117,9 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 			continue
 		}
 		data := exports[name]
+		if def.Sensitive || sensitiveValues.IsUsedBy(data) {
+			sensitiveExports.Insert(name)
+		}
 
 		switch def.Type {
 		case lsv1alpha1.ExportTypeData:
@@ -107,6 +128,10 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 				return nil, nil, fmt.Errorf("%s: validator creation failed: %s", fldPath.String(), err.Error())
 			}
 			if err := validator.ValidateGoStruct(data); err != nil {
+				if sensitiveExports.Has(name) {
+					// the validation errors may contain parts of the sensitive value
+					return nil, nil, fmt.Errorf("%s: exported data of sensitive export %s does not satisfy the configured schema", fldPath.String(), name)
+				}
 				return nil, nil, fmt.Errorf("%s: exported data does not satisfy the configured schema: %s", fldPath.String(), err.Error())
 			}
 		case lsv1alpha1.ExportTypeTarget:
@@ -131,6 +156,12 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 		// add exportDataMappings to available exports, potentially overwriting existing exports with that name
 		for expName, expValue := range exportDataMappings {
 			exports[expName] = expValue
+			// only the mappings that use sensitive values or overwrite exports defined as sensitive are sensitive.
+			if def, err := c.Inst.GetExportDefinition(expName); (err == nil && def.Sensitive) || sensitiveValues.IsUsedBy(expValue) {
+				sensitiveExports.Insert(expName)
+			} else {
+				sensitiveExports.Delete(expName)
+			}
 		}
 	}
 
@@ -146,7 +177,8 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 		do := dataobjects.New().
 			SetSourceType(lsv1alpha1.ExportDataObjectSourceType).
 			SetKey(dataExport.DataRef).
-			SetData(data)
+			SetData(data).
+			SetSensitive(sensitiveExports.Has(dataExport.Name))
 		dataObjects[i] = do
 	}
 
@@ -170,10 +202,12 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 	return dataObjects, targets, nil
 }
 
-func (c *Constructor) aggregateDataObjectsInContext(ctx context.Context) (map[string]interface{}, error) {
+// aggregateDataObjectsInContext returns the data of all data objects in the context of the installation by their key.
+// The data of sensitive data objects is added to the given sensitive values.
+func (c *Constructor) aggregateDataObjectsInContext(ctx context.Context, sensitiveValues *dataobjects.SensitiveValues) (map[string]interface{}, error) {
 	installationContext := lsv1alpha1helper.DataObjectSourceFromInstallation(c.Inst.GetInstallation())
 	dataObjectList := &lsv1alpha1.DataObjectList{}
-	if err := c.Client().List(ctx, dataObjectList, client.InNamespace(c.Inst.GetInstallation().Namespace), client.MatchingLabels{lsv1alpha1.DataObjectContextLabel: installationContext}); err != nil {
+	if err := read_write_layer.ListDataObjects(ctx, c.Client(), dataObjectList, client.InNamespace(c.Inst.GetInstallation().Namespace), client.MatchingLabels{lsv1alpha1.DataObjectContextLabel: installationContext}); err != nil {
 		return nil, err
 	}
 
@@ -185,6 +219,9 @@ func (c *Constructor) aggregateDataObjectsInContext(ctx context.Context) (map[st
 			return nil, fmt.Errorf("error while decoding data object %s: %w", do.Name, err)
 		}
 		aggDataObjects[meta.Key] = data
+		if do.Sensitive {
+			sensitiveValues.Add(data)
+		}
 	}
 	return aggDataObjects, nil
 }
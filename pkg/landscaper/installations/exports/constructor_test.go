@@ -132,6 +132,130 @@ var _ = Describe("Constructor", func() {
 		}))
 	})
 
+	It("should mark data objects of sensitive exports as sensitive", func() {
+		ctx := context.Background()
+		inInstRoot, err := installations.CreateInternalInstallation(ctx, op.ComponentsRegistry(), fakeInstallations["test1/root"])
+		Expect(err).ToNot(HaveOccurred())
+		op.Inst = inInstRoot
+		Expect(op.SetInstallationContext(ctx)).To(Succeed())
+
+		op.Inst.GetBlueprint().Info.ExportExecutions = []lsv1alpha1.TemplateExecutor{
+			{
+				Type:     lsv1alpha1.GOTemplateType,
+				Template: lsv1alpha1.AnyJSON{RawMessage: []byte(`"exports:\n  root.y: {{ index .values.dataobjects \"root.y\" }}\n  root.z: {{ index .values.dataobjects \"root.z\" }}"`)},
+			},
+		}
+		for i, def := range op.Inst.GetBlueprint().Info.Exports {
+			if def.Name == "root.y" {
+				op.Inst.GetBlueprint().Info.Exports[i].Sensitive = true
+			}
+		}
+
+		c := exports.NewConstructor(op)
+		res, _, err := c.Construct(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(HaveLen(2))
+
+		id := func(element interface{}) string {
+			return element.(*dataobjects.DataObject).Metadata.Key
+		}
+		Expect(res).To(MatchAllElements(id, Elements{
+			"root.y": PointTo(MatchFields(IgnoreExtras, Fields{
+				"Data":      Equal("val-c"),
+				"Sensitive": BeTrue(),
+			})),
+			"root.z": PointTo(MatchFields(IgnoreExtras, Fields{
+				"Data":      Equal("val-b"),
+				"Sensitive": BeFalse(),
+			})),
+		}))
+	})
+
+	// markContextDataObjectSensitive marks the data object with the given key in the context of the root installation as sensitive.
+	markContextDataObjectSensitive := func(ctx context.Context, key string) {
+		doList := &lsv1alpha1.DataObjectList{}
+		Expect(fakeClient.List(ctx, doList, client.InNamespace("test1"), client.MatchingLabels{
+			lsv1alpha1.DataObjectContextLabel: "Inst.root",
+			lsv1alpha1.DataObjectKeyLabel:     key,
+		})).To(Succeed())
+		Expect(doList.Items).To(HaveLen(1))
+		doList.Items[0].Sensitive = true
+		Expect(fakeClient.Update(ctx, &doList.Items[0])).To(Succeed())
+	}
+
+	It("should mark exports of sensitive values of a child as sensitive", func() {
+		ctx := context.Background()
+		markContextDataObjectSensitive(ctx, "root.y")
+		inInstRoot, err := installations.CreateInternalInstallation(ctx, op.ComponentsRegistry(), fakeInstallations["test1/root"])
+		Expect(err).ToNot(HaveOccurred())
+		op.Inst = inInstRoot
+		Expect(op.SetInstallationContext(ctx)).To(Succeed())
+
+		op.Inst.GetBlueprint().Info.ExportExecutions = []lsv1alpha1.TemplateExecutor{
+			{
+				Type:     lsv1alpha1.GOTemplateType,
+				Template: lsv1alpha1.AnyJSON{RawMessage: []byte(`"exports:\n  root.y: prefix-{{ index .values.dataobjects \"root.y\" }}\n  root.z: {{ index .values.dataobjects \"root.z\" }}"`)},
+			},
+		}
+
+		c := exports.NewConstructor(op)
+		res, _, err := c.Construct(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		id := func(element interface{}) string {
+			return element.(*dataobjects.DataObject).Metadata.Key
+		}
+		Expect(res).To(MatchAllElements(id, Elements{
+			"root.y": PointTo(MatchFields(IgnoreExtras, Fields{
+				"Data":      Equal("prefix-val-c"),
+				"Sensitive": BeTrue(),
+			})),
+			"root.z": PointTo(MatchFields(IgnoreExtras, Fields{
+				"Data":      Equal("val-b"),
+				"Sensitive": BeFalse(),
+			})),
+		}))
+	})
+
+	It("should only mark export data mappings as sensitive that use sensitive values", func() {
+		ctx := context.Background()
+		markContextDataObjectSensitive(ctx, "root.y")
+		inst := fakeInstallations["test1/root"].DeepCopy()
+		inst.Spec.ExportDataMappings = map[string]lsv1alpha1.AnyJSON{
+			"root.y": lsv1alpha1.NewAnyJSON([]byte(`"(( \"mapped-\" exports[\"root.z\"] ))"`)),
+			"root.z": lsv1alpha1.NewAnyJSON([]byte(`"(( \"mapped-\" exports[\"root.y\"] ))"`)),
+		}
+		inInstRoot, err := installations.CreateInternalInstallation(ctx, op.ComponentsRegistry(), inst)
+		Expect(err).ToNot(HaveOccurred())
+		op.Inst = inInstRoot
+		Expect(op.SetInstallationContext(ctx)).To(Succeed())
+
+		op.Inst.GetBlueprint().Info.ExportExecutions = []lsv1alpha1.TemplateExecutor{
+			{
+				Type:     lsv1alpha1.GOTemplateType,
+				Template: lsv1alpha1.AnyJSON{RawMessage: []byte(`"exports:\n  root.y: {{ index .values.dataobjects \"root.y\" }}\n  root.z: {{ index .values.dataobjects \"root.z\" }}"`)},
+			},
+		}
+
+		c := exports.NewConstructor(op)
+		res, _, err := c.Construct(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		id := func(element interface{}) string {
+			return element.(*dataobjects.DataObject).Metadata.Key
+		}
+		Expect(res).To(MatchAllElements(id, Elements{
+			"root.y": PointTo(MatchFields(IgnoreExtras, Fields{
+				"Data":      Equal("mapped-val-b"),
+				"Sensitive": BeFalse(),
+			})),
+			"root.z": PointTo(MatchFields(IgnoreExtras, Fields{
+				"Data":      Equal("mapped-val-c"),
+				"Sensitive": BeTrue(),
+			})),
+		}))
+	})
+
 	It("should forbid the export from a child when the schema is not satisfied", func() {
 		ctx := context.Background()
 		inInstRoot, err := installations.CreateInternalInstallation(ctx, op.ComponentsRegistry(), fakeInstallations["test1/root"])
@@ -28,6 +28,7 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/registry/componentoverwrites"
 	"github.com/gardener/landscaper/pkg/landscaper/registry/components/cdutils"
 	lsutils "github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
 var componentInstallationGVK schema.GroupVersionKind
@@ -111,13 +112,24 @@ func CreateInternalInstallationWithContext(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	blue, err := blueprints.Resolve(ctx, compResolver, lsCtx.ComponentDescriptorRef(), inst.Spec.Blueprint)
+	blue, err := ResolveBlueprint(ctx, compResolver, &lsCtx, inst.Spec.Blueprint)
 	if err != nil {
 		return nil, fmt.Errorf("unable to resolve blueprint for %s/%s: %w", inst.Namespace, inst.Name, err)
 	}
 	return NewInstallationImportsAndBlueprint(inst, blue), nil
 }
 
+// ResolveBlueprint resolves the blueprint of an installation with the given context.
+// The digest of the blueprint is only verified if a verification is configured in the context,
+// as the digest can only be trusted if the signature of the component descriptor is verified.
+func ResolveBlueprint(ctx context.Context, compResolver ctf.ComponentResolver, lsCtx *ExternalContext,
+	bpDef lsv1alpha1.BlueprintDefinition) (*blueprints.Blueprint, error) {
+	if lsCtx.Verification != nil {
+		return blueprints.ResolveVerified(ctx, compResolver, lsCtx.ComponentDescriptorRef(), bpDef)
+	}
+	return blueprints.Resolve(ctx, compResolver, lsCtx.ComponentDescriptorRef(), bpDef)
+}
+
 // CreateInternalInstallationBase creates an internal installation base for an Installation
 func CreateInternalInstallationBase(inst *lsv1alpha1.Installation) *InstallationAndImports {
 	if inst == nil {
@@ -138,7 +150,7 @@ func GetDataImport(ctx context.Context,
 	if len(dataImport.DataRef) != 0 {
 		rawDataObject = &lsv1alpha1.DataObject{}
 		doName := lsv1alpha1helper.GenerateDataObjectName(contextName, dataImport.DataRef)
-		if err := kubeClient.Get(ctx, kubernetes.ObjectKey(doName, inst.GetInstallation().Namespace), rawDataObject); err != nil {
+		if err := read_write_layer.GetDataObject(ctx, kubeClient, kubernetes.ObjectKey(doName, inst.GetInstallation().Namespace), rawDataObject); err != nil {
 			return nil, nil, fmt.Errorf("unable to fetch data object %s (%s/%s): %w", doName, contextName, dataImport.DataRef, err)
 		}
 	}
@@ -162,6 +174,13 @@ func GetDataImport(ctx context.Context,
 		// set the generation as it is used to detect outdated imports.
 		rawDataObject.SetGeneration(gen)
 	}
+	if dataImport.SharedDataRef != nil {
+		var err error
+		rawDataObject, err = GetSharedDataObject(ctx, kubeClient, inst.GetInstallation(), *dataImport.SharedDataRef)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
 
 	do, err := dataobjects.NewFromDataObject(rawDataObject)
 	if err != nil {
@@ -181,13 +200,13 @@ func GetTargets(ctx context.Context,
 	targetImport lsv1alpha1.TargetImport) ([]*dataobjects.TargetExtension, []string, error) {
 	var targets []*dataobjects.TargetExtension
 	var targetImportReferences []string
-	if len(targetImport.Target) != 0 {
+	if len(targetImport.Target) != 0 || targetImport.SharedTarget != nil {
 		target, err := GetTargetImport(ctx, kubeClient, contextName, inst, targetImport)
 		if err != nil {
 			return nil, nil, fmt.Errorf("%s: unable to get target for '%s': %w", targetImport.Name, targetImport.Name, err)
 		}
 		targets = []*dataobjects.TargetExtension{target}
-		targetImportReferences = []string{targetImport.Target}
+		targetImportReferences = []string{SharedImportReference(targetImport.Target, targetImport.SharedTarget)}
 	} else if targetImport.Targets != nil {
 		tl, err := GetTargetListImportByNames(ctx, kubeClient, contextName, inst, targetImport)
 		if err != nil {
@@ -207,13 +226,20 @@ func GetTargets(ctx context.Context,
 		targets = tl.GetTargetExtensions()
 		targetImportReferences = []string{targetImport.TargetListReference}
 	} else {
-		return nil, nil, fmt.Errorf("invalid target import '%s': one of target, targets, or targetListRef must be specified", targetImport.Name)
+		return nil, nil, fmt.Errorf("invalid target import '%s': one of target, targets, targetListRef, or sharedTarget must be specified", targetImport.Name)
 	}
 	return targets, targetImportReferences, nil
 }
 
 // GetTargetImport fetches the target import from the cluster.
 func GetTargetImport(ctx context.Context, kubeClient client.Client, contextName string, inst *lsv1alpha1.Installation, targetImport lsv1alpha1.TargetImport) (*dataobjects.TargetExtension, error) {
+	if targetImport.SharedTarget != nil {
+		target, err := GetSharedTarget(ctx, kubeClient, inst, *targetImport.SharedTarget)
+		if err != nil {
+			return nil, err
+		}
+		return dataobjects.NewTargetExtension(target, &targetImport), nil
+	}
 	targetName := targetImport.Target
 	target := &lsv1alpha1.Target{}
 	targetName = lsv1alpha1helper.GenerateDataObjectName(contextName, targetName)
@@ -10,10 +10,12 @@ import (
 
 	"github.com/mandelsoft/spiff/spiffing"
 	spiffyaml "github.com/mandelsoft/spiff/yaml"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/yaml"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
 	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
 	"github.com/gardener/landscaper/pkg/landscaper/dataobjects/jsonpath"
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
@@ -95,11 +97,31 @@ func (c *Constructor) Construct(ctx context.Context, imps *Imports) error {
 		return err
 	}
 
+	sensitiveImports := sets.NewString()
+	sensitiveValues := dataobjects.NewSensitiveValues()
+	for name, do := range imps.DataObjects {
+		if do.Sensitive {
+			sensitiveImports.Insert(name)
+			sensitiveValues.Add(do.Data)
+		}
+	}
+	// only the data mappings that use sensitive values are sensitive.
+	for name, value := range templatedDataMappings {
+		if sensitiveValues.IsUsedBy(value) {
+			sensitiveImports.Insert(name)
+		}
+	}
+	inst.SetSensitiveImports(sensitiveImports)
+
 	// add additional imports and targets
 	imports, err := c.constructImports(inst.GetBlueprint().Info.Imports, imps.DataObjects, imps.Targets, imps.TargetLists, templatedDataMappings, fldPath)
 	if err != nil {
 		return err
 	}
+	if err := ValidateRules(fldPath.Child("importValidations"), inst.GetBlueprint().Info.ImportValidations, ImportsVariable, imports, sensitiveImports.Len() != 0); err != nil {
+		c.setValidationRuleFailedCondition(err)
+		return err
+	}
 
 	c.SetTargetImports(imps.Targets)
 	c.SetTargetListImports(imps.TargetLists)
@@ -142,8 +164,16 @@ func (c *Constructor) constructImports(
 				return imports, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: validator creation failed", defPath.String())
 			}
 			if err := validator.ValidateGoStruct(imports[def.Name]); err != nil {
+				if c.Inst.IsSensitiveImport(def.Name) {
+					// the validation errors may contain parts of the sensitive value
+					return imports, installations.NewErrorf(installations.SchemaValidationFailed, nil, "%s: imported sensitive datatype does not have the expected schema", defPath.String())
+				}
 				return imports, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: imported datatype does not have the expected schema", defPath.String())
 			}
+			if err := ValidateRules(defPath.Child("validations"), def.Validations, ImportValueVariable, imports[def.Name], c.Inst.IsSensitiveImport(def.Name)); err != nil {
+				c.setValidationRuleFailedCondition(err)
+				return imports, err
+			}
 			if len(def.ConditionalImports) > 0 {
 				// recursively check conditional imports
 				conditionalImports, err := c.constructImports(def.ConditionalImports, importedDataObjects, importedTargets, importedTargetLists, templatedDataMappings, defPath)
@@ -177,6 +207,10 @@ func (c *Constructor) constructImports(
 			if def.TargetType != targetType {
 				return nil, installations.NewErrorf(installations.SchemaValidationFailed, nil, "%s: imported target type is %s but expected %s", defPath.String(), targetType, def.TargetType)
 			}
+			if err := ValidateRules(defPath.Child("validations"), def.Validations, ImportValueVariable, data, false); err != nil {
+				c.setValidationRuleFailedCondition(err)
+				return nil, err
+			}
 			continue
 		case lsv1alpha1.ImportTypeTargetList:
 			if val, ok := importedTargetLists[def.Name]; ok {
@@ -206,6 +240,10 @@ func (c *Constructor) constructImports(
 					return nil, installations.NewErrorf(installations.SchemaValidationFailed, nil, "%s: type of the element at position %d of the imported targetlist is %s but expected %s", defPath.String(), i, targetType, def.TargetType)
 				}
 			}
+			if err := ValidateRules(defPath.Child("validations"), def.Validations, ImportValueVariable, data, false); err != nil {
+				c.setValidationRuleFailedCondition(err)
+				return nil, err
+			}
 			continue
 		default:
 			return nil, fmt.Errorf("%s: unknown import type '%s'", defPath.String(), string(def.Type))
@@ -215,6 +253,13 @@ func (c *Constructor) constructImports(
 	return imports, nil
 }
 
+// setValidationRuleFailedCondition reports a failed validation rule in the ValidateImports condition of the installation.
+func (c *Constructor) setValidationRuleFailedCondition(err error) {
+	cond := lsv1alpha1helper.GetOrInitCondition(c.Inst.GetInstallation().Status.Conditions, lsv1alpha1.ValidateImportsCondition)
+	c.Inst.MergeConditions(lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
+		ValidationRuleFailedReason, err.Error()))
+}
+
 func (c *Constructor) templateDataMappings(
 	fldPath *field.Path,
 	importedDataObjects map[string]*dataobjects.DataObject,
@@ -75,6 +75,38 @@ var _ = Describe("Constructor", func() {
 		Expect(inInstB.GetImports()).To(Equal(expectedConfig))
 	})
 
+	It("should mark imports of sensitive data objects as sensitive", func() {
+		ctx := context.Background()
+		doList := &lsv1alpha1.DataObjectList{}
+		Expect(fakeClient.List(ctx, doList, client.InNamespace("test2"))).To(Succeed())
+		for i := range doList.Items {
+			do := &doList.Items[i]
+			if string(do.Data.RawMessage) == `"val-a"` {
+				do.Sensitive = true
+				Expect(fakeClient.Update(ctx, do)).To(Succeed())
+			}
+		}
+
+		inst := fakeInstallations["test2/b"].DeepCopy()
+		inst.Spec.ImportDataMappings = map[string]lsv1alpha1.AnyJSON{
+			"mapped": lsv1alpha1.NewAnyJSON([]byte(`"(( \"user:\" \"val-a\" ))"`)),
+			"plain":  lsv1alpha1.NewAnyJSON([]byte(`"other"`)),
+		}
+		inInstB, err := installations.CreateInternalInstallation(ctx, op.ComponentsRegistry(), inst)
+		Expect(err).ToNot(HaveOccurred())
+		op.Inst = inInstB
+		Expect(op.ResolveComponentDescriptors(ctx)).To(Succeed())
+
+		Expect(op.SetInstallationContext(ctx)).To(Succeed())
+		c := imports.NewConstructor(op)
+		Expect(c.Construct(ctx, nil)).To(Succeed())
+		Expect(inInstB.GetImports()).To(HaveKeyWithValue("b.a", "val-a"))
+		Expect(inInstB.IsSensitiveImport("b.a")).To(BeTrue())
+		// only the data mappings whose values contain sensitive values are sensitive
+		Expect(inInstB.IsSensitiveImport("mapped")).To(BeTrue())
+		Expect(inInstB.IsSensitiveImport("plain")).To(BeFalse())
+	})
+
 	It("should construct the imported config from a sibling and the indirect parent import", func() {
 		ctx := context.Background()
 		inInstC, err := installations.CreateInternalInstallation(ctx, op.ComponentsRegistry(), fakeInstallations["test2/c"])
@@ -188,6 +220,58 @@ var _ = Describe("Constructor", func() {
 		})
 	})
 
+	Context("validation rules", func() {
+		var (
+			ctx        context.Context
+			inInstRoot *installations.InstallationImportsAndBlueprint
+		)
+
+		BeforeEach(func() {
+			var err error
+			ctx = context.Background()
+			inInstRoot, err = installations.CreateInternalInstallation(ctx, op.ComponentsRegistry(), fakeInstallations["test7/root"])
+			Expect(err).ToNot(HaveOccurred())
+			op.Inst = inInstRoot
+			Expect(op.ResolveComponentDescriptors(ctx)).To(Succeed())
+			Expect(op.SetInstallationContext(ctx)).To(Succeed())
+		})
+
+		It("should construct the imports if all validation rules are satisfied", func() {
+			inInstRoot.GetBlueprint().Info.Imports[0].Validations = []lsv1alpha1.ValidationRule{{Rule: `self.startsWith("val-")`}}
+			inInstRoot.GetBlueprint().Info.ImportValidations = []lsv1alpha1.ValidationRule{{Rule: `imports["root.a"] == "val-root-import"`}}
+
+			c := imports.NewConstructor(op)
+			Expect(c.Construct(ctx, nil)).To(Succeed())
+			Expect(inInstRoot.GetImports()).To(HaveKeyWithValue("root.a", "val-root-import"))
+		})
+
+		It("should forbid an import that does not satisfy a validation rule of its import definition", func() {
+			inInstRoot.GetBlueprint().Info.Imports[0].Validations = []lsv1alpha1.ValidationRule{
+				{Rule: `self.startsWith("val-")`},
+				{Rule: `size(self) < 5`, Message: "value is too long"},
+			}
+
+			c := imports.NewConstructor(op)
+			err := c.Construct(ctx, nil)
+			Expect(installations.IsValidationRuleFailedError(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("root.root.a.validations[1]: value is too long"))
+
+			cond := lsv1alpha1helper.GetOrInitCondition(inInstRoot.GetInstallation().Status.Conditions, lsv1alpha1.ValidateImportsCondition)
+			Expect(cond.Status).To(Equal(lsv1alpha1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(imports.ValidationRuleFailedReason))
+			Expect(cond.Message).To(ContainSubstring("value is too long"))
+		})
+
+		It("should forbid imports that do not satisfy an import validation rule of the blueprint", func() {
+			inInstRoot.GetBlueprint().Info.ImportValidations = []lsv1alpha1.ValidationRule{{Rule: `imports["root.a"] == "other"`}}
+
+			c := imports.NewConstructor(op)
+			err := c.Construct(ctx, nil)
+			Expect(installations.IsValidationRuleFailedError(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(`root.importValidations[0]: failed rule "imports[\"root.a\"] == \"other\""`))
+		})
+	})
+
 	Context("Targets", func() {
 		It("should construct import from a manually added target", func() {
 			ctx := context.Background()
@@ -21,6 +21,8 @@ import (
 const (
 	// TemplatingFailedReason is the reason that is defined during templating.
 	TemplatingFailedReason = "ImportValidationFailed"
+	// ValidationRuleFailedReason is the reason that is defined if a validation rule of the imports fails.
+	ValidationRuleFailedReason = string(installations.ValidationRuleFailed)
 )
 
 // ImportOperation templates the executions and handles the interaction with
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imports
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/google/cel-go/cel"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/validation"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+)
+
+const (
+	// ImportValueVariable is the name of the variable that contains the imported value in the validation rules of an import.
+	ImportValueVariable = validation.ImportValueVariable
+	// ImportsVariable is the name of the variable that contains all imports in the import validation rules of a blueprint.
+	ImportsVariable = validation.ImportsVariable
+
+	// validationRuleCostLimit limits the runtime cost of a single validation rule
+	// so that expensive rules cannot block the reconciliation.
+	validationRuleCostLimit = 1000000
+)
+
+// ValidateRules evaluates CEL validation rules against a value that is available in the rules under the given variable name.
+// An error is returned for the first rule that cannot be evaluated or that does not evaluate to true.
+// If the value is sensitive, the error does not contain details of the evaluation.
+func ValidateRules(fldPath *field.Path, rules []lsv1alpha1.ValidationRule, variable string, value interface{}, sensitive bool) error {
+	if len(rules) == 0 {
+		return nil
+	}
+	env, err := validation.NewValidationRuleEnv(variable)
+	if err != nil {
+		return installations.NewErrorf(installations.ValidationRuleFailed, err, "%s: unable to create CEL environment", fldPath.String())
+	}
+	input := map[string]interface{}{
+		variable: normalizeNumbers(value),
+	}
+
+	for i, rule := range rules {
+		rulePath := fldPath.Index(i)
+		ast, issues := env.Compile(rule.Rule)
+		if issues != nil && issues.Err() != nil {
+			return installations.NewErrorf(installations.ValidationRuleFailed, issues.Err(), "%s: unable to compile rule %q", rulePath.String(), rule.Rule)
+		}
+		prg, err := env.Program(ast, cel.CostLimit(validationRuleCostLimit))
+		if err != nil {
+			return installations.NewErrorf(installations.ValidationRuleFailed, err, "%s: unable to create program for rule %q", rulePath.String(), rule.Rule)
+		}
+		out, _, err := prg.Eval(input)
+		if err != nil {
+			if sensitive {
+				// the evaluation error may contain parts of the sensitive value
+				err = nil
+			}
+			return installations.NewErrorf(installations.ValidationRuleFailed, err, "%s: unable to evaluate rule %q", rulePath.String(), rule.Rule)
+		}
+		valid, ok := out.Value().(bool)
+		if !ok {
+			return installations.NewErrorf(installations.ValidationRuleFailed, nil, "%s: rule %q does not evaluate to a boolean", rulePath.String(), rule.Rule)
+		}
+		if !valid {
+			msg := rule.Message
+			if len(msg) == 0 {
+				msg = fmt.Sprintf("failed rule %q", rule.Rule)
+			}
+			return installations.NewErrorf(installations.ValidationRuleFailed, nil, "%s: %s", rulePath.String(), msg)
+		}
+	}
+	return nil
+}
+
+// normalizeNumbers converts whole numbers that are decoded as float from json into integers,
+// so that they can be compared to integer literals in the validation rules.
+func normalizeNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			res[key] = normalizeNumbers(elem)
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(v))
+		for i, elem := range v {
+			res[i] = normalizeNumbers(elem)
+		}
+		return res
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < math.MaxInt64 {
+			return int64(v)
+		}
+		return v
+	default:
+		return value
+	}
+}
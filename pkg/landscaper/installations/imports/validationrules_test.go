@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imports_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/imports"
+)
+
+var _ = Describe("ValidationRules", func() {
+
+	decode := func(data string) interface{} {
+		var val interface{}
+		Expect(json.Unmarshal([]byte(data), &val)).To(Succeed())
+		return val
+	}
+
+	It("should succeed if all rules are satisfied", func() {
+		rules := []lsv1alpha1.ValidationRule{
+			{Rule: "!self.ha || self.replicas >= 3"},
+			{Rule: "self.ratio > 0 && self.ratio <= 1"},
+			{Rule: `self.zones.all(z, z.startsWith("eu-"))`},
+		}
+		value := decode(`{"ha": true, "replicas": 3, "ratio": 0.5, "zones": ["eu-1", "eu-2"]}`)
+		Expect(imports.ValidateRules(field.NewPath("imp"), rules, imports.ImportValueVariable, value, false)).To(Succeed())
+	})
+
+	It("should allow to check whether optional imports are set", func() {
+		rules := []lsv1alpha1.ValidationRule{
+			{Rule: "!has(imports.b) || imports.b > imports.a"},
+			{Rule: `!("b" in imports) || imports.b > imports.a`},
+		}
+		Expect(imports.ValidateRules(field.NewPath("importValidations"), rules, imports.ImportsVariable, decode(`{"a": 1}`), false)).To(Succeed())
+		Expect(imports.ValidateRules(field.NewPath("importValidations"), rules, imports.ImportsVariable, decode(`{"a": 1, "b": 0}`), false)).ToNot(Succeed())
+	})
+
+	It("should return the field path and message of the failed rule", func() {
+		rules := []lsv1alpha1.ValidationRule{
+			{Rule: "has(self.replicas)"},
+			{Rule: "!self.ha || self.replicas >= 3", Message: "replicas must be at least 3 if ha is enabled"},
+		}
+		value := decode(`{"ha": true, "replicas": 2}`)
+		err := imports.ValidateRules(field.NewPath("imp"), rules, imports.ImportValueVariable, value, false)
+		Expect(installations.IsValidationRuleFailedError(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("imp[1]: replicas must be at least 3 if ha is enabled"))
+	})
+
+	It("should default the message of a failed rule", func() {
+		rules := []lsv1alpha1.ValidationRule{{Rule: "imports.a != imports.b"}}
+		value := decode(`{"a": "x", "b": "x"}`)
+		err := imports.ValidateRules(field.NewPath("importValidations"), rules, imports.ImportsVariable, value, false)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`importValidations[0]: failed rule "imports.a != imports.b"`))
+	})
+
+	It("should fail if a rule cannot be compiled", func() {
+		rules := []lsv1alpha1.ValidationRule{{Rule: "self.replicas >"}}
+		err := imports.ValidateRules(field.NewPath("imp"), rules, imports.ImportValueVariable, decode(`{"replicas": 1}`), false)
+		Expect(installations.IsValidationRuleFailedError(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("imp[0]: unable to compile rule"))
+	})
+
+	It("should fail if a rule does not evaluate to a boolean", func() {
+		rules := []lsv1alpha1.ValidationRule{{Rule: "self.replicas + 1"}}
+		err := imports.ValidateRules(field.NewPath("imp"), rules, imports.ImportValueVariable, decode(`{"replicas": 1}`), false)
+		Expect(installations.IsValidationRuleFailedError(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("does not evaluate to a boolean"))
+	})
+
+	It("should not return evaluation details for sensitive values", func() {
+		rules := []lsv1alpha1.ValidationRule{{Rule: "self.password.size() > 10"}}
+		err := imports.ValidateRules(field.NewPath("imp"), rules, imports.ImportValueVariable, decode(`{"user": "admin"}`), true)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(HaveSuffix(`imp[0]: unable to evaluate rule "self.password.size() > 10"`))
+	})
+})
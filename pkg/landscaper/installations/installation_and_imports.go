@@ -1,14 +1,19 @@
 package installations
 
 import (
+	"k8s.io/apimachinery/pkg/util/sets"
+
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
 )
 
 // InstallationBase is the internal representation of an installation without resolved blueprint.
 type InstallationAndImports struct {
 	imports      map[string]interface{}
 	installation *lsv1alpha1.Installation
+	// sensitiveImports contains the names of the imports with sensitive values
+	sensitiveImports sets.String
 	// indexes the import state with from/to as key
 	importsStatus ImportStatus
 }
@@ -43,6 +48,27 @@ func (i *InstallationAndImports) SetImports(imports map[string]interface{}) {
 	i.imports = imports
 }
 
+// SetSensitiveImports sets the names of the imports whose values are sensitive.
+func (i *InstallationAndImports) SetSensitiveImports(names sets.String) {
+	i.sensitiveImports = names
+}
+
+// IsSensitiveImport checks if the value of the import with the given name is sensitive.
+func (i *InstallationAndImports) IsSensitiveImport(name string) bool {
+	return i.sensitiveImports.Has(name)
+}
+
+// GetSensitiveImportValues returns the values of all sensitive imports.
+func (i *InstallationAndImports) GetSensitiveImportValues() *dataobjects.SensitiveValues {
+	values := dataobjects.NewSensitiveValues()
+	for name, value := range i.imports {
+		if i.IsSensitiveImport(name) {
+			values.Add(value)
+		}
+	}
+	return values
+}
+
 func (i *InstallationAndImports) GetInstallation() *lsv1alpha1.Installation {
 	return i.installation
 }
@@ -220,6 +220,8 @@ func (o *Operation) GetImportedDataObjects(ctx context.Context) (map[string]*dat
 		}
 		if len(def.DataRef) != 0 {
 			importStatus.DataRef = def.DataRef
+		} else if def.SharedDataRef != nil {
+			importStatus.DataRef = SharedImportReference(def.DataRef, def.SharedDataRef)
 		} else if def.SecretRef != nil {
 			importStatus.SecretRef = fmt.Sprintf("%s#%s", def.SecretRef.NamespacedName().String(), def.SecretRef.Key)
 		} else if def.ConfigMapRef != nil {
@@ -236,7 +238,7 @@ func (o *Operation) GetImportedDataObjects(ctx context.Context) (map[string]*dat
 func (o *Operation) GetImportedTargets(ctx context.Context) (map[string]*dataobjects.TargetExtension, error) {
 	targets := map[string]*dataobjects.TargetExtension{}
 	for _, def := range o.Inst.GetInstallation().Spec.Imports.Targets {
-		if len(def.Target) == 0 {
+		if len(def.Target) == 0 && def.SharedTarget == nil {
 			// It's a target list, skip it
 			continue
 		}
@@ -266,7 +268,7 @@ func (o *Operation) GetImportedTargets(ctx context.Context) (map[string]*dataobj
 		o.Inst.ImportStatus().Update(lsv1alpha1.ImportStatus{
 			Name:             def.Name,
 			Type:             lsv1alpha1.TargetImportStatusType,
-			Target:           def.Target,
+			Target:           SharedImportReference(def.Target, def.SharedTarget),
 			SourceRef:        sourceRef,
 			ConfigGeneration: configGen,
 		})
@@ -279,7 +281,7 @@ func (o *Operation) GetImportedTargets(ctx context.Context) (map[string]*dataobj
 func (o *Operation) GetImportedTargetLists(ctx context.Context) (map[string]*dataobjects.TargetExtensionList, error) {
 	targets := map[string]*dataobjects.TargetExtensionList{}
 	for _, def := range o.Inst.GetInstallation().Spec.Imports.Targets {
-		if len(def.Target) != 0 {
+		if len(def.Target) != 0 || def.SharedTarget != nil {
 			// It's a single target, skip it
 			continue
 		}
@@ -374,7 +376,8 @@ func GetRootInstallations(ctx context.Context, kubeClient client.Client, filter
 	return installations, nil
 }
 
-// NewTriggerDependents triggers all installations that depend on the current installation.
+// NewTriggerDependents triggers all installations that depend on the current installation,
+// including the root installations of other namespaces that import its shared exports.
 func (o *Operation) NewTriggerDependents(ctx context.Context) error {
 	for _, sibling := range o.Context().Siblings {
 		if !importsAnyExport(o.Inst, sibling) {
@@ -391,6 +394,19 @@ func (o *Operation) NewTriggerDependents(ctx context.Context) error {
 			return err
 		}
 	}
+
+	// root installations of other namespaces that import shared exports are not part of the context
+	importers, err := GetSharedExportImporters(ctx, o.Client(), o.Inst.GetInstallation())
+	if err != nil {
+		return err
+	}
+	for i := range importers {
+		importer := &importers[i]
+		metav1.SetMetaDataAnnotation(&importer.ObjectMeta, lsv1alpha1.OperationAnnotation, string(lsv1alpha1.ReconcileOperation))
+		if err := o.Writer().UpdateInstallation(ctx, read_write_layer.W000174, importer); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -527,7 +543,8 @@ func (o *Operation) createOrUpdateDataImport(ctx context.Context, src string, im
 		SetNamespace(o.Inst.GetInstallation().Namespace).SetSource(src).
 		SetContext(src).
 		SetKey(importDef.Name).SetSourceType(lsv1alpha1.ImportDataObjectSourceType).
-		SetData(importData)
+		SetData(importData).
+		SetSensitive(o.Inst.IsSensitiveImport(importDef.Name))
 	raw, err := do.Build()
 	if err != nil {
 		o.Inst.GetInstallation().Status.Conditions = lsv1alpha1helper.MergeConditions(o.Inst.GetInstallation().Status.Conditions,
@@ -647,7 +664,7 @@ func (o *Operation) createOrUpdateTargetListImport(ctx context.Context, src stri
 func (o *Operation) GetExportForKey(ctx context.Context, key string) (*dataobjects.DataObject, error) {
 	doName := lsv1alpha1helper.GenerateDataObjectName(o.context.Name, key)
 	rawDO := &lsv1alpha1.DataObject{}
-	if err := o.Client().Get(ctx, kutil.ObjectKey(doName, o.Inst.GetInstallation().Namespace), rawDO); err != nil {
+	if err := read_write_layer.GetDataObject(ctx, o.Client(), kutil.ObjectKey(doName, o.Inst.GetInstallation().Namespace), rawDO); err != nil {
 		return nil, err
 	}
 	return dataobjects.NewFromDataObject(rawDO)
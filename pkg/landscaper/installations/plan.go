@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
+	lsutil "github.com/gardener/landscaper/pkg/utils"
+)
+
+// NewPlannedObject compares the current specification of an object with its rendered specification
+// and returns the planned change of the object.
+// The current specification is nil if the object does not exist yet, the rendered specification is nil
+// if the object would be deleted.
+// Values that use one of the given sensitive values are redacted in the rendered specification and the changes.
+func NewPlannedObject(name string, ref *lsv1alpha1.ObjectReference, current, rendered interface{}, sensitiveValues *dataobjects.SensitiveValues) (lsv1alpha1.PlannedObject, error) {
+	planned := lsv1alpha1.PlannedObject{
+		Name:      name,
+		Reference: ref,
+	}
+
+	if rendered != nil {
+		data, err := redactJSON(rendered, sensitiveValues)
+		if err != nil {
+			return planned, fmt.Errorf("unable to marshal rendered specification of %q: %w", name, err)
+		}
+		planned.Rendered = lsv1alpha1.NewAnyJSONPointer(data)
+	}
+
+	switch {
+	case current == nil:
+		planned.Action = lsv1alpha1.PlanActionCreate
+		return planned, nil
+	case rendered == nil:
+		planned.Action = lsv1alpha1.PlanActionDelete
+		return planned, nil
+	}
+
+	changes, err := lsutil.ComputeJSONChanges(current, rendered)
+	if err != nil {
+		return planned, fmt.Errorf("unable to compute changes of %q: %w", name, err)
+	}
+	if len(changes) == 0 {
+		planned.Action = lsv1alpha1.PlanActionUnchanged
+		return planned, nil
+	}
+
+	planned.Action = lsv1alpha1.PlanActionUpdate
+	planned.NumberOfChanges = len(changes)
+	planned.Changes = make([]lsv1alpha1.PlannedChange, len(changes))
+	for i, change := range changes {
+		planned.Changes[i] = lsv1alpha1.PlannedChange{Path: change.Path}
+		oldValue, newValue := change.Old, change.New
+		// a changed sensitive value is redacted on both sides, as the old value is most likely sensitive as well
+		if usesSensitiveValue(oldValue, sensitiveValues) || usesSensitiveValue(newValue, sensitiveValues) {
+			oldValue, newValue = redactedChangeValue(oldValue), redactedChangeValue(newValue)
+		}
+		if oldValue != nil {
+			planned.Changes[i].Old = lsv1alpha1.NewAnyJSONPointer(oldValue)
+		}
+		if newValue != nil {
+			planned.Changes[i].New = lsv1alpha1.NewAnyJSONPointer(newValue)
+		}
+	}
+	return planned, nil
+}
+
+// redactJSON marshals the given object and redacts all values that use one of the given sensitive values.
+func redactJSON(obj interface{}, sensitiveValues *dataobjects.SensitiveValues) ([]byte, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	if sensitiveValues.Len() == 0 {
+		return data, nil
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return json.Marshal(sensitiveValues.Redact(value))
+}
+
+// usesSensitiveValue checks if the given json value uses one of the given sensitive values.
+func usesSensitiveValue(data []byte, sensitiveValues *dataobjects.SensitiveValues) bool {
+	if data == nil || sensitiveValues.Len() == 0 {
+		return false
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		// values that cannot be checked are considered as sensitive
+		return true
+	}
+	return sensitiveValues.IsUsedBy(value)
+}
+
+// redactedChangeValue replaces the given json value of a change by the redacted value.
+func redactedChangeValue(data []byte) []byte {
+	if data == nil {
+		return nil
+	}
+	return []byte(strconv.Quote(dataobjects.RedactedValue))
+}
@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// GetSharedDataObject fetches the data object of a data export of a root installation in another namespace.
+// The export has to be shared with the given installation by an ExportGrant in the namespace of the export.
+func GetSharedDataObject(ctx context.Context, kubeClient client.Client, inst *lsv1alpha1.Installation, ref lsv1alpha1.SharedExportReference) (*lsv1alpha1.DataObject, error) {
+	if err := CheckExportGrant(ctx, kubeClient, inst, ref, lsv1alpha1.ImportTypeData); err != nil {
+		return nil, err
+	}
+	do := &lsv1alpha1.DataObject{}
+	doName := lsv1alpha1helper.GenerateDataObjectName("", ref.Name)
+	if err := read_write_layer.GetDataObject(ctx, kubeClient, kubernetes.ObjectKey(doName, ref.Namespace), do); err != nil {
+		return nil, fmt.Errorf("unable to fetch shared data object %s/%s (%s): %w", ref.Namespace, doName, ref.Name, err)
+	}
+	// the exporting installation is not part of the context of the importing installation,
+	// so the shared object is handled like a manually created object.
+	do.OwnerReferences = nil
+	return do, nil
+}
+
+// GetSharedTarget fetches the target of a target export of a root installation in another namespace.
+// The export has to be shared with the given installation by an ExportGrant in the namespace of the export.
+func GetSharedTarget(ctx context.Context, kubeClient client.Client, inst *lsv1alpha1.Installation, ref lsv1alpha1.SharedExportReference) (*lsv1alpha1.Target, error) {
+	if err := CheckExportGrant(ctx, kubeClient, inst, ref, lsv1alpha1.ImportTypeTarget); err != nil {
+		return nil, err
+	}
+	target := &lsv1alpha1.Target{}
+	targetName := lsv1alpha1helper.GenerateDataObjectName("", ref.Name)
+	if err := kubeClient.Get(ctx, kubernetes.ObjectKey(targetName, ref.Namespace), target); err != nil {
+		return nil, fmt.Errorf("unable to fetch shared target %s/%s (%s): %w", ref.Namespace, targetName, ref.Name, err)
+	}
+	if target.Spec.SecretRef != nil || target.Spec.ExternalSecretRef != nil {
+		return nil, fmt.Errorf("shared target %s/%s (%s) references a secret and cannot be imported by other namespaces", ref.Namespace, targetName, ref.Name)
+	}
+	// the exporting installation is not part of the context of the importing installation,
+	// so the shared object is handled like a manually created object.
+	target.OwnerReferences = nil
+	return target, nil
+}
+
+// CheckExportGrant checks whether the referenced export is shared with the given root installation
+// by an ExportGrant in the namespace of the export.
+func CheckExportGrant(ctx context.Context, kubeClient client.Client, inst *lsv1alpha1.Installation, ref lsv1alpha1.SharedExportReference, importType lsv1alpha1.ImportType) error {
+	if !IsRootInstallation(inst) {
+		return fmt.Errorf("shared export %s/%s can only be imported by root installations", ref.Namespace, ref.Name)
+	}
+	grants := &lsv1alpha1.ExportGrantList{}
+	if err := kubeClient.List(ctx, grants, client.InNamespace(ref.Namespace)); err != nil {
+		return fmt.Errorf("unable to list export grants in namespace %s: %w", ref.Namespace, err)
+	}
+	for _, grant := range grants.Items {
+		if ExportGrantAllows(&grant, inst, ref.Name, importType) {
+			return nil
+		}
+	}
+	return NewImportNotFoundErrorf(nil, "%s export %q of namespace %s is not shared with installation %s/%s",
+		importType, ref.Name, ref.Namespace, inst.Namespace, inst.Name)
+}
+
+// ExportGrantAllows checks whether the grant shares the export with the given name and type with the installation.
+func ExportGrantAllows(grant *lsv1alpha1.ExportGrant, inst *lsv1alpha1.Installation, name string, importType lsv1alpha1.ImportType) bool {
+	names := grant.Spec.DataRefs
+	if importType == lsv1alpha1.ImportTypeTarget {
+		names = grant.Spec.Targets
+	}
+	if !containsString(names, name) {
+		return false
+	}
+	for _, consumer := range grant.Spec.Consumers {
+		if consumer.Namespace != inst.Namespace {
+			continue
+		}
+		if len(consumer.Installations) == 0 || containsString(consumer.Installations, inst.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSharedExportImporters returns the root installations in other namespaces that import an export of the given
+// root installation, which is shared with them by an ExportGrant in the namespace of the installation.
+func GetSharedExportImporters(ctx context.Context, kubeClient client.Client, inst *lsv1alpha1.Installation) ([]lsv1alpha1.Installation, error) {
+	if !IsRootInstallation(inst) {
+		return nil, nil
+	}
+	grants := &lsv1alpha1.ExportGrantList{}
+	if err := kubeClient.List(ctx, grants, client.InNamespace(inst.Namespace)); err != nil {
+		return nil, fmt.Errorf("unable to list export grants in namespace %s: %w", inst.Namespace, err)
+	}
+
+	var (
+		importers                []lsv1alpha1.Installation
+		found                    = map[client.ObjectKey]bool{}
+		installationsByNamespace = map[string][]lsv1alpha1.Installation{}
+	)
+	for i := range grants.Items {
+		grant := &grants.Items[i]
+		for _, consumer := range grant.Spec.Consumers {
+			consumerInsts, ok := installationsByNamespace[consumer.Namespace]
+			if !ok {
+				instList := &lsv1alpha1.InstallationList{}
+				if err := read_write_layer.ListInstallations(ctx, kubeClient, instList, client.InNamespace(consumer.Namespace)); err != nil {
+					return nil, fmt.Errorf("unable to list installations in namespace %s: %w", consumer.Namespace, err)
+				}
+				consumerInsts = instList.Items
+				installationsByNamespace[consumer.Namespace] = consumerInsts
+			}
+
+			for j := range consumerInsts {
+				consumerInst := &consumerInsts[j]
+				key := client.ObjectKeyFromObject(consumerInst)
+				if !found[key] && IsRootInstallation(consumerInst) && importsSharedExport(consumerInst, inst, grant) {
+					found[key] = true
+					importers = append(importers, *consumerInst)
+				}
+			}
+		}
+	}
+	return importers, nil
+}
+
+// importsSharedExport checks whether the consumer imports an export of the given installation
+// that is shared with the consumer by the given grant.
+func importsSharedExport(consumer, inst *lsv1alpha1.Installation, grant *lsv1alpha1.ExportGrant) bool {
+	for _, imp := range consumer.Spec.Imports.Data {
+		ref := imp.SharedDataRef
+		if ref == nil || ref.Namespace != inst.Namespace || !ExportGrantAllows(grant, consumer, ref.Name, lsv1alpha1.ImportTypeData) {
+			continue
+		}
+		for _, export := range inst.Spec.Exports.Data {
+			if export.DataRef == ref.Name {
+				return true
+			}
+		}
+	}
+	for _, imp := range consumer.Spec.Imports.Targets {
+		ref := imp.SharedTarget
+		if ref == nil || ref.Namespace != inst.Namespace || !ExportGrantAllows(grant, consumer, ref.Name, lsv1alpha1.ImportTypeTarget) {
+			continue
+		}
+		for _, export := range inst.Spec.Exports.Targets {
+			if export.Target == ref.Name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SharedImportReference returns the reference of an import that is shown in the import status.
+// Shared exports are referenced as <namespace>/<name>.
+func SharedImportReference(ref string, sharedRef *lsv1alpha1.SharedExportReference) string {
+	if sharedRef == nil {
+		return ref
+	}
+	return fmt.Sprintf("%s/%s", sharedRef.Namespace, sharedRef.Name)
+}
+
+func containsString(list []string, value string) bool {
+	for _, elem := range list {
+		if elem == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,255 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/operation"
+	"github.com/gardener/landscaper/test/utils/envtest"
+)
+
+var _ = Describe("SharedExports", func() {
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+		inst       *installations.InstallationAndImports
+	)
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		kubeClient, _, err = envtest.NewFakeClientFromPath("")
+		Expect(err).ToNot(HaveOccurred())
+
+		exporter := &lsv1alpha1.Installation{}
+		exporter.Name = "exporter"
+		exporter.Namespace = "platform"
+		exporter.APIVersion = lsv1alpha1.SchemeGroupVersion.String()
+		exporter.Kind = "Installation"
+
+		do := &lsv1alpha1.DataObject{}
+		do.Name = lsv1alpha1helper.GenerateDataObjectName("", "network")
+		do.Namespace = "platform"
+		do.Data = lsv1alpha1.NewAnyJSON([]byte(`"10.0.0.0/8"`))
+		Expect(controllerutil.SetOwnerReference(exporter, do, api.LandscaperScheme)).To(Succeed())
+		Expect(kubeClient.Create(ctx, do)).To(Succeed())
+
+		target := &lsv1alpha1.Target{}
+		target.Name = lsv1alpha1helper.GenerateDataObjectName("", "cluster")
+		target.Namespace = "platform"
+		target.Spec.Type = targettypes.KubernetesClusterTargetType
+		target.Spec.Configuration = lsv1alpha1.NewAnyJSONPointer([]byte(`{"kubeconfig": "abc"}`))
+		Expect(kubeClient.Create(ctx, target)).To(Succeed())
+
+		inst = installations.NewInstallationAndImports(&lsv1alpha1.Installation{
+			ObjectMeta: metav1.ObjectMeta{Name: "consumer", Namespace: "tenant-a"},
+		})
+	})
+
+	createGrant := func(consumers ...lsv1alpha1.ExportGrantConsumer) {
+		grant := &lsv1alpha1.ExportGrant{}
+		grant.Name = "shared-infra"
+		grant.Namespace = "platform"
+		grant.Spec.DataRefs = []string{"network"}
+		grant.Spec.Targets = []string{"cluster"}
+		grant.Spec.Consumers = consumers
+		Expect(kubeClient.Create(ctx, grant)).To(Succeed())
+	}
+
+	It("should import a shared data object that is granted to the namespace of the installation", func() {
+		createGrant(lsv1alpha1.ExportGrantConsumer{Namespace: "tenant-a"})
+
+		do, owner, err := installations.GetDataImport(ctx, kubeClient, "", inst, lsv1alpha1.DataImport{
+			Name:          "imp",
+			SharedDataRef: &lsv1alpha1.SharedExportReference{Namespace: "platform", Name: "network"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(owner).To(BeNil(), "the exporting installation should not be handled as sibling")
+		Expect(do.Data).To(Equal("10.0.0.0/8"))
+	})
+
+	It("should import a shared target that is granted to the installation", func() {
+		createGrant(lsv1alpha1.ExportGrantConsumer{Namespace: "tenant-a", Installations: []string{"consumer"}})
+
+		targets, refs, err := installations.GetTargets(ctx, kubeClient, "", inst.GetInstallation(), lsv1alpha1.TargetImport{
+			Name:         "imp",
+			SharedTarget: &lsv1alpha1.SharedExportReference{Namespace: "platform", Name: "cluster"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(targets).To(HaveLen(1))
+		Expect(targets[0].GetTarget().Spec.Type).To(Equal(targettypes.KubernetesClusterTargetType))
+		Expect(refs).To(ConsistOf("platform/cluster"))
+	})
+
+	It("should not import a shared target that references a secret", func() {
+		createGrant(lsv1alpha1.ExportGrantConsumer{Namespace: "tenant-a"})
+		target := &lsv1alpha1.Target{}
+		Expect(kubeClient.Get(ctx, client.ObjectKey{Namespace: "platform", Name: lsv1alpha1helper.GenerateDataObjectName("", "cluster")}, target)).To(Succeed())
+		target.Spec.Configuration = nil
+		target.Spec.ExternalSecretRef = &lsv1alpha1.ExternalSecretReference{Resolver: "vault", Path: "clusters/platform"}
+		Expect(kubeClient.Update(ctx, target)).To(Succeed())
+
+		_, _, err := installations.GetTargets(ctx, kubeClient, "", inst.GetInstallation(), lsv1alpha1.TargetImport{
+			Name:         "imp",
+			SharedTarget: &lsv1alpha1.SharedExportReference{Namespace: "platform", Name: "cluster"},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should not import a shared data object that is not granted to the namespace of the installation", func() {
+		createGrant(lsv1alpha1.ExportGrantConsumer{Namespace: "tenant-b"})
+
+		_, _, err := installations.GetDataImport(ctx, kubeClient, "", inst, lsv1alpha1.DataImport{
+			Name:          "imp",
+			SharedDataRef: &lsv1alpha1.SharedExportReference{Namespace: "platform", Name: "network"},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should not import a shared data object that is only granted to other installations of the namespace", func() {
+		createGrant(lsv1alpha1.ExportGrantConsumer{Namespace: "tenant-a", Installations: []string{"other"}})
+
+		_, _, err := installations.GetDataImport(ctx, kubeClient, "", inst, lsv1alpha1.DataImport{
+			Name:          "imp",
+			SharedDataRef: &lsv1alpha1.SharedExportReference{Namespace: "platform", Name: "network"},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should not import a shared target if only its data export is granted", func() {
+		grant := &lsv1alpha1.ExportGrant{}
+		grant.Name = "data-only"
+		grant.Namespace = "platform"
+		grant.Spec.DataRefs = []string{"cluster"}
+		grant.Spec.Consumers = []lsv1alpha1.ExportGrantConsumer{{Namespace: "tenant-a"}}
+		Expect(kubeClient.Create(ctx, grant)).To(Succeed())
+
+		_, _, err := installations.GetTargets(ctx, kubeClient, "", inst.GetInstallation(), lsv1alpha1.TargetImport{
+			Name:         "imp",
+			SharedTarget: &lsv1alpha1.SharedExportReference{Namespace: "platform", Name: "cluster"},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should not import shared exports into subinstallations", func() {
+		createGrant(lsv1alpha1.ExportGrantConsumer{Namespace: "tenant-a"})
+		parent := &lsv1alpha1.Installation{}
+		parent.Name = "parent"
+		parent.Namespace = "tenant-a"
+		Expect(controllerutil.SetOwnerReference(parent, inst.GetInstallation(), api.LandscaperScheme)).To(Succeed())
+
+		_, _, err := installations.GetDataImport(ctx, kubeClient, "", inst, lsv1alpha1.DataImport{
+			Name:          "imp",
+			SharedDataRef: &lsv1alpha1.SharedExportReference{Namespace: "platform", Name: "network"},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("GetSharedExportImporters", func() {
+
+		var exporter *lsv1alpha1.Installation
+
+		BeforeEach(func() {
+			exporter = &lsv1alpha1.Installation{}
+			exporter.Name = "exporter"
+			exporter.Namespace = "platform"
+			exporter.Spec.Exports.Data = []lsv1alpha1.DataExport{{Name: "network", DataRef: "network"}}
+			exporter.Spec.Exports.Targets = []lsv1alpha1.TargetExport{{Name: "cluster", Target: "cluster"}}
+		})
+
+		createConsumer := func(name, namespace string, imports lsv1alpha1.InstallationImports) {
+			consumer := &lsv1alpha1.Installation{}
+			consumer.Name = name
+			consumer.Namespace = namespace
+			consumer.Spec.Imports = imports
+			Expect(kubeClient.Create(ctx, consumer)).To(Succeed())
+		}
+
+		It("should return the installations of other namespaces that import a granted export", func() {
+			createGrant(lsv1alpha1.ExportGrantConsumer{Namespace: "tenant-a"},
+				lsv1alpha1.ExportGrantConsumer{Namespace: "tenant-b", Installations: []string{"app"}})
+			createConsumer("data-consumer", "tenant-a", lsv1alpha1.InstallationImports{
+				Data: []lsv1alpha1.DataImport{{
+					Name:          "network",
+					SharedDataRef: &lsv1alpha1.SharedExportReference{Namespace: "platform", Name: "network"},
+				}},
+			})
+			createConsumer("app", "tenant-b", lsv1alpha1.InstallationImports{
+				Targets: []lsv1alpha1.TargetImport{{
+					Name:         "cluster",
+					SharedTarget: &lsv1alpha1.SharedExportReference{Namespace: "platform", Name: "cluster"},
+				}},
+			})
+			// not granted
+			createConsumer("other", "tenant-b", lsv1alpha1.InstallationImports{
+				Targets: []lsv1alpha1.TargetImport{{
+					Name:         "cluster",
+					SharedTarget: &lsv1alpha1.SharedExportReference{Namespace: "platform", Name: "cluster"},
+				}},
+			})
+			// no shared import
+			createConsumer("unrelated", "tenant-a", lsv1alpha1.InstallationImports{})
+
+			importers, err := installations.GetSharedExportImporters(ctx, kubeClient, exporter)
+			Expect(err).ToNot(HaveOccurred())
+			names := make([]string, 0, len(importers))
+			for _, importer := range importers {
+				names = append(names, importer.Namespace+"/"+importer.Name)
+			}
+			Expect(names).To(ConsistOf("tenant-a/data-consumer", "tenant-b/app"))
+		})
+
+		It("should trigger the installations of other namespaces that import a granted export", func() {
+			createGrant(lsv1alpha1.ExportGrantConsumer{Namespace: "tenant-a"})
+			createConsumer("data-consumer", "tenant-a", lsv1alpha1.InstallationImports{
+				Data: []lsv1alpha1.DataImport{{
+					Name:          "network",
+					SharedDataRef: &lsv1alpha1.SharedExportReference{Namespace: "platform", Name: "network"},
+				}},
+			})
+
+			op := &installations.Operation{
+				Operation: operation.NewOperation(kubeClient, api.LandscaperScheme, record.NewFakeRecorder(1024)),
+				Inst:      installations.NewInstallationImportsAndBlueprint(exporter, nil),
+			}
+			Expect(op.NewTriggerDependents(ctx)).To(Succeed())
+
+			consumer := &lsv1alpha1.Installation{}
+			Expect(kubeClient.Get(ctx, client.ObjectKey{Namespace: "tenant-a", Name: "data-consumer"}, consumer)).To(Succeed())
+			Expect(lsv1alpha1helper.HasOperation(consumer.ObjectMeta, lsv1alpha1.ReconcileOperation)).To(BeTrue())
+		})
+
+		It("should not return importers of exports that the installation does not export", func() {
+			createGrant(lsv1alpha1.ExportGrantConsumer{Namespace: "tenant-a"})
+			createConsumer("data-consumer", "tenant-a", lsv1alpha1.InstallationImports{
+				Data: []lsv1alpha1.DataImport{{
+					Name:          "network",
+					SharedDataRef: &lsv1alpha1.SharedExportReference{Namespace: "platform", Name: "network"},
+				}},
+			})
+			exporter.Spec.Exports.Data = nil
+
+			importers, err := installations.GetSharedExportImporters(ctx, kubeClient, exporter)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(importers).To(BeEmpty())
+		})
+
+	})
+
+})
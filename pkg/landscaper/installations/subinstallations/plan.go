@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package subinstallations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/utils/dependencies"
+)
+
+// Plan renders the subinstallations of the installation and compares them with the existing subinstallations.
+// In contrast to Ensure, no subinstallation is created, updated or deleted.
+func (o *Operation) Plan(ctx context.Context) ([]lsv1alpha1.PlannedObject, error) {
+	inst := o.Inst.GetInstallation()
+
+	subInstallations, err := o.GetSubInstallations(ctx, inst)
+	if err != nil {
+		return nil, err
+	}
+
+	installationTmpl, err := o.getInstallationTemplates()
+	if err != nil {
+		err = fmt.Errorf("unable to get installation templates of blueprint: %w", err)
+		return nil, o.NewError(err, "GetInstallationTemplates", err.Error())
+	}
+
+	o.removeUnsatisfiedOptionalImports(installationTmpl)
+
+	if err := o.ValidateSubinstallations(installationTmpl); err != nil {
+		return nil, err
+	}
+
+	if _, err := dependencies.CheckForCyclesAndDuplicateExports(installationTmpl, false); err != nil {
+		return nil, err
+	}
+
+	sensitiveValues := o.Inst.GetSensitiveImportValues()
+	planned := make([]lsv1alpha1.PlannedObject, 0, len(installationTmpl))
+	for _, subInstTmpl := range installationTmpl {
+		subInstSpec, err := o.getSubinstallationSpec(inst, subInstTmpl)
+		if err != nil {
+			return nil, err
+		}
+
+		// default the rendered specification in the same way as it is done when the subinstallation is written
+		rendered := &lsv1alpha1.Installation{Spec: *subInstSpec}
+		o.Scheme().Default(rendered)
+
+		var (
+			current interface{}
+			ref     *lsv1alpha1.ObjectReference
+		)
+		if subInst, ok := subInstallations[subInstTmpl.Name]; ok {
+			current = subInst.Spec
+			ref = &lsv1alpha1.ObjectReference{Name: subInst.Name, Namespace: subInst.Namespace}
+		}
+
+		plannedObj, err := installations.NewPlannedObject(subInstTmpl.Name, ref, current, rendered.Spec, sensitiveValues)
+		if err != nil {
+			return nil, err
+		}
+		planned = append(planned, plannedObj)
+	}
+
+	for name, subInst := range subInstallations {
+		if _, ok := getInstallationTemplate(installationTmpl, name); ok {
+			continue
+		}
+		ref := &lsv1alpha1.ObjectReference{Name: subInst.Name, Namespace: subInst.Namespace}
+		plannedObj, err := installations.NewPlannedObject(name, ref, subInst.Spec, nil, sensitiveValues)
+		if err != nil {
+			return nil, err
+		}
+		planned = append(planned, plannedObj)
+	}
+
+	sort.Slice(planned, func(i, j int) bool {
+		return planned[i].Name < planned[j].Name
+	})
+	return planned, nil
+}
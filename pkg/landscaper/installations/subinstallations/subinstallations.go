@@ -55,17 +55,7 @@ func (o *Operation) Ensure(ctx context.Context) error {
 		return o.NewError(err, "GetInstallationTemplates", err.Error())
 	}
 
-	for _, instT := range installationTmpl {
-		// remove imports based on optional and conditional imports which are not satisfied in the parent
-		imports := []lsv1alpha1.DataImport{}
-		for _, imp := range instT.Imports.Data {
-			_, ok := o.Inst.GetImports()[imp.DataRef]
-			if ok || !isOptionalParentImport(imp.DataRef, o.Inst.GetBlueprint().Info.Imports, false) {
-				imports = append(imports, imp)
-			}
-		}
-		instT.Imports.Data = imports
-	}
+	o.removeUnsatisfiedOptionalImports(installationTmpl)
 
 	// validate all installation templates before do any follow up actions
 	if err := o.ValidateSubinstallations(installationTmpl); err != nil {
@@ -87,6 +77,21 @@ func (o *Operation) Ensure(ctx context.Context) error {
 	return o.UpdateInstallationStatus(ctx, inst, cond)
 }
 
+// removeUnsatisfiedOptionalImports removes imports based on optional and conditional imports
+// which are not satisfied in the parent.
+func (o *Operation) removeUnsatisfiedOptionalImports(installationTmpl []*lsv1alpha1.InstallationTemplate) {
+	for _, instT := range installationTmpl {
+		imports := []lsv1alpha1.DataImport{}
+		for _, imp := range instT.Imports.Data {
+			_, ok := o.Inst.GetImports()[imp.DataRef]
+			if ok || !isOptionalParentImport(imp.DataRef, o.Inst.GetBlueprint().Info.Imports, false) {
+				imports = append(imports, imp)
+			}
+		}
+		instT.Imports.Data = imports
+	}
+}
+
 // isOptionalParentImport returns true if the specified import data reference
 // - exists in the parents blueprint (= in the given import definition list) AND
 //   - is optional (required: false) OR
@@ -272,12 +277,7 @@ func (o *Operation) createOrUpdateNewInstallation(ctx context.Context,
 		subInst.Namespace = inst.Namespace
 	}
 
-	subBlueprint, subCdDef, err := GetBlueprintDefinitionFromInstallationTemplate(inst,
-		subInstTmpl,
-		o.ComponentDescriptor,
-		o.ComponentsRegistry(),
-		o.Context().External.RepositoryContext,
-		o.Context().External.Overwriter)
+	subInstSpec, err := o.getSubinstallationSpec(inst, subInstTmpl)
 	if err != nil {
 		return nil, err
 	}
@@ -292,16 +292,7 @@ func (o *Operation) createOrUpdateNewInstallation(ctx context.Context,
 		if err := controllerutil.SetControllerReference(inst, subInst, o.Scheme()); err != nil {
 			return errors.Wrapf(err, "unable to set owner reference")
 		}
-		subInst.Spec = lsv1alpha1.InstallationSpec{
-			Context:             inst.Spec.Context,
-			RegistryPullSecrets: inst.Spec.RegistryPullSecrets,
-			ComponentDescriptor: subCdDef,
-			Blueprint:           *subBlueprint,
-			Imports:             subInstTmpl.Imports,
-			ImportDataMappings:  subInstTmpl.ImportDataMappings,
-			Exports:             subInstTmpl.Exports,
-			ExportDataMappings:  subInstTmpl.ExportDataMappings,
-		}
+		subInst.Spec = *subInstSpec
 
 		o.Scheme().Default(subInst)
 		return nil
@@ -340,6 +331,34 @@ func (o *Operation) createOrUpdateNewInstallation(ctx context.Context,
 	return subInst, nil
 }
 
+// getSubinstallationSpec returns the specification of the subinstallation that is defined by the given installation template.
+func (o *Operation) getSubinstallationSpec(inst *lsv1alpha1.Installation,
+	subInstTmpl *lsv1alpha1.InstallationTemplate) (*lsv1alpha1.InstallationSpec, error) {
+
+	subBlueprint, subCdDef, err := GetBlueprintDefinitionFromInstallationTemplate(inst,
+		subInstTmpl,
+		o.ComponentDescriptor,
+		o.ComponentsRegistry(),
+		o.Context().External.RepositoryContext,
+		o.Context().External.Overwriter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lsv1alpha1.InstallationSpec{
+		Context:             inst.Spec.Context,
+		RegistryPullSecrets: inst.Spec.RegistryPullSecrets,
+		ComponentDescriptor: subCdDef,
+		Blueprint:           *subBlueprint,
+		Imports:             subInstTmpl.Imports,
+		ImportDataMappings:  subInstTmpl.ImportDataMappings,
+		Exports:             subInstTmpl.Exports,
+		ExportDataMappings:  subInstTmpl.ExportDataMappings,
+
+		RequireExecutionApproval: inst.Spec.RequireExecutionApproval,
+	}, nil
+}
+
 // getSubinstallationNameByReference returns the name of subinstallation by the refernce
 func getSubinstallationNameByReference(refs []lsv1alpha1.NamedObjectReference, namespace, name string) (string, bool) {
 	for _, ref := range refs {
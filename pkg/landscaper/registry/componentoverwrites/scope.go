@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentoverwrites
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// IsApplicable returns whether an overwrite applies to an installation with the given labels at the given time.
+func IsApplicable(overwrite *lsv1alpha1.ComponentVersionOverwrite, instLabels map[string]string, now time.Time) (bool, error) {
+	if overwrite.ValidFrom != nil && now.Before(overwrite.ValidFrom.Time) {
+		return false, nil
+	}
+	if overwrite.ValidUntil != nil && !now.Before(overwrite.ValidUntil.Time) {
+		return false, nil
+	}
+	if overwrite.InstallationSelector == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(overwrite.InstallationSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid installation selector: %w", err)
+	}
+	return selector.Matches(labels.Set(instLabels)), nil
+}
+
+// NewSubstitutionsForInstallation creates substitutions that only contain the overwrites
+// which apply to the given installation at the given time.
+func NewSubstitutionsForInstallation(cvo *lsv1alpha1.ComponentVersionOverwrites, inst *lsv1alpha1.Installation, now time.Time) (*Substitutions, error) {
+	subs := make([]lsv1alpha1.ComponentVersionOverwrite, 0, len(cvo.Overwrites))
+	for i := range cvo.Overwrites {
+		applicable, err := IsApplicable(&cvo.Overwrites[i], inst.GetLabels(), now)
+		if err != nil {
+			return nil, fmt.Errorf("overwrite %d of ComponentVersionOverwrites %s: %w", i, cvo.Name, err)
+		}
+		if applicable {
+			subs = append(subs, cvo.Overwrites[i])
+		}
+	}
+	return NewSubstitutions(subs), nil
+}
+
+// NextTransitionTime returns the next time after the given time at which an overwrite starts or stops to apply.
+// Nil is returned if there is no such time.
+func NextTransitionTime(cvo *lsv1alpha1.ComponentVersionOverwrites, now time.Time) *time.Time {
+	return nextTransitionTime(cvo.Overwrites, now)
+}
+
+// NextTransitionTimeForInstallation returns the next time after the given time at which an overwrite
+// that selects the given installation starts or stops to apply.
+// Nil is returned if there is no such time.
+func NextTransitionTimeForInstallation(cvo *lsv1alpha1.ComponentVersionOverwrites, inst *lsv1alpha1.Installation, now time.Time) (*time.Time, error) {
+	selected := make([]lsv1alpha1.ComponentVersionOverwrite, 0, len(cvo.Overwrites))
+	for i := range cvo.Overwrites {
+		overwrite := cvo.Overwrites[i].DeepCopy()
+		overwrite.ValidFrom = nil
+		overwrite.ValidUntil = nil
+		applicable, err := IsApplicable(overwrite, inst.GetLabels(), now)
+		if err != nil {
+			return nil, fmt.Errorf("overwrite %d of ComponentVersionOverwrites %s: %w", i, cvo.Name, err)
+		}
+		if applicable {
+			selected = append(selected, cvo.Overwrites[i])
+		}
+	}
+	return nextTransitionTime(selected, now), nil
+}
+
+func nextTransitionTime(overwrites []lsv1alpha1.ComponentVersionOverwrite, now time.Time) *time.Time {
+	var next *time.Time
+	for _, overwrite := range overwrites {
+		for _, t := range []*metav1.Time{overwrite.ValidFrom, overwrite.ValidUntil} {
+			if t == nil || !t.Time.After(now) {
+				continue
+			}
+			if next == nil || t.Time.Before(*next) {
+				tt := t.Time
+				next = &tt
+			}
+		}
+	}
+	return next
+}
+
+// EvaluationTime returns the time at which the overwrites have been evaluated for the current job of an installation.
+// The given time is returned if the overwrites have not yet been evaluated for the current job.
+func EvaluationTime(inst *lsv1alpha1.Installation, now time.Time) time.Time {
+	evaluation := inst.Status.ComponentOverwritesEvaluation
+	if evaluation == nil || evaluation.JobID != inst.Status.JobID {
+		return now
+	}
+	return evaluation.Time.Time
+}
+
+// GetAffectedInstallations returns the installations whose component reference is overwritten by the given overwrites.
+// Only installations with a context that references the overwrites are considered.
+// The overwrites are evaluated at the same time as in the current job of an installation, see EvaluationTime.
+// The repository context of an installation's component reference is defaulted by the repository context of its context.
+func GetAffectedInstallations(cvo *lsv1alpha1.ComponentVersionOverwrites, contexts []lsv1alpha1.Context,
+	installations []lsv1alpha1.Installation, now time.Time) ([]lsv1alpha1.AffectedInstallation, error) {
+	referencingContexts := map[string]*lsv1alpha1.Context{}
+	for i := range contexts {
+		if contexts[i].Namespace == cvo.Namespace && contexts[i].ComponentVersionOverwritesReference == cvo.Name {
+			referencingContexts[contexts[i].Name] = &contexts[i]
+		}
+	}
+
+	var affected []lsv1alpha1.AffectedInstallation
+	for i := range installations {
+		inst := &installations[i]
+		lsCtx, ok := referencingContexts[inst.Spec.Context]
+		if !ok || inst.Namespace != cvo.Namespace {
+			continue
+		}
+		ref := getComponentReference(inst)
+		if ref == nil {
+			continue
+		}
+		if ref.RepositoryContext == nil {
+			ref.RepositoryContext = lsCtx.RepositoryContext
+		}
+
+		subs, err := NewSubstitutionsForInstallation(cvo, inst, EvaluationTime(inst, now))
+		if err != nil {
+			return nil, err
+		}
+		original := ref.DeepCopy()
+		if !subs.Replace(ref) {
+			continue
+		}
+		affected = append(affected, lsv1alpha1.AffectedInstallation{
+			Name:         inst.Name,
+			Context:      lsCtx.Name,
+			Original:     toOverwriteReference(original),
+			Substitution: toOverwriteReference(ref),
+		})
+	}
+
+	sort.Slice(affected, func(i, j int) bool {
+		return affected[i].Name < affected[j].Name
+	})
+	return affected, nil
+}
+
+// getComponentReference returns a copy of the component reference of an installation.
+// A version constraint is replaced by the version that has been resolved for it.
+// Nil is returned if the installation has no component reference or its version constraint is not yet resolved.
+func getComponentReference(inst *lsv1alpha1.Installation) *lsv1alpha1.ComponentDescriptorReference {
+	if inst.Spec.ComponentDescriptor == nil || inst.Spec.ComponentDescriptor.Reference == nil {
+		return nil
+	}
+	ref := inst.Spec.ComponentDescriptor.Reference.DeepCopy()
+	if len(ref.VersionConstraint) == 0 {
+		return ref
+	}
+	resolved := inst.Status.ResolvedComponentVersion
+	if resolved == nil || resolved.Constraint != ref.VersionConstraint {
+		return nil
+	}
+	ref.Version = resolved.Version
+	ref.VersionConstraint = ""
+	return ref
+}
+
+func toOverwriteReference(ref *lsv1alpha1.ComponentDescriptorReference) lsv1alpha1.ComponentVersionOverwriteReference {
+	return lsv1alpha1.ComponentVersionOverwriteReference{
+		RepositoryContext: ref.RepositoryContext,
+		ComponentName:     ref.ComponentName,
+		Version:           ref.Version,
+	}
+}
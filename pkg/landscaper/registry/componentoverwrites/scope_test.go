@@ -0,0 +1,212 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentoverwrites_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/registry/componentoverwrites"
+	testutils "github.com/gardener/landscaper/test/utils"
+)
+
+var _ = Describe("Scoped ComponentVersionOverwrites", func() {
+
+	var (
+		now time.Time
+		cvo *lsv1alpha1.ComponentVersionOverwrites
+	)
+
+	newInstallation := func(name, lsCtx string, labels map[string]string) lsv1alpha1.Installation {
+		inst := lsv1alpha1.Installation{}
+		inst.Name = name
+		inst.Namespace = "test"
+		inst.Labels = labels
+		inst.Spec.Context = lsCtx
+		inst.Spec.ComponentDescriptor = &lsv1alpha1.ComponentDescriptorDefinition{
+			Reference: &lsv1alpha1.ComponentDescriptorReference{
+				ComponentName: "component.example.com",
+				Version:       "v1.0.0",
+			},
+		}
+		return inst
+	}
+
+	BeforeEach(func() {
+		now = time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+		cvo = &lsv1alpha1.ComponentVersionOverwrites{}
+		cvo.Name = "overwrites"
+		cvo.Namespace = "test"
+		cvo.Overwrites = lsv1alpha1.ComponentVersionOverwriteList{
+			{
+				Source: lsv1alpha1.ComponentVersionOverwriteReference{
+					ComponentName: "component.example.com",
+					Version:       "v1.0.0",
+				},
+				Substitution: lsv1alpha1.ComponentVersionOverwriteReference{
+					Version: "v1.0.1",
+				},
+			},
+		}
+	})
+
+	Context("IsApplicable", func() {
+
+		It("should apply an overwrite without selector and time window", func() {
+			Expect(componentoverwrites.IsApplicable(&cvo.Overwrites[0], nil, now)).To(BeTrue())
+		})
+
+		It("should only apply an overwrite to installations matching the selector", func() {
+			cvo.Overwrites[0].InstallationSelector = &metav1.LabelSelector{
+				MatchLabels: map[string]string{"tenant": "a"},
+			}
+			Expect(componentoverwrites.IsApplicable(&cvo.Overwrites[0], map[string]string{"tenant": "a"}, now)).To(BeTrue())
+			Expect(componentoverwrites.IsApplicable(&cvo.Overwrites[0], map[string]string{"tenant": "b"}, now)).To(BeFalse())
+			Expect(componentoverwrites.IsApplicable(&cvo.Overwrites[0], nil, now)).To(BeFalse())
+		})
+
+		It("should only apply an overwrite within its time window", func() {
+			cvo.Overwrites[0].ValidFrom = &metav1.Time{Time: now.Add(-time.Hour)}
+			cvo.Overwrites[0].ValidUntil = &metav1.Time{Time: now.Add(time.Hour)}
+			Expect(componentoverwrites.IsApplicable(&cvo.Overwrites[0], nil, now)).To(BeTrue())
+			Expect(componentoverwrites.IsApplicable(&cvo.Overwrites[0], nil, now.Add(-2*time.Hour))).To(BeFalse())
+			Expect(componentoverwrites.IsApplicable(&cvo.Overwrites[0], nil, now.Add(time.Hour))).To(BeFalse())
+		})
+
+		It("should return an error for an invalid selector", func() {
+			cvo.Overwrites[0].InstallationSelector = &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tenant", Operator: "invalid"}},
+			}
+			_, err := componentoverwrites.IsApplicable(&cvo.Overwrites[0], nil, now)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	It("should only create substitutions for applicable overwrites", func() {
+		cvo.Overwrites[0].InstallationSelector = &metav1.LabelSelector{
+			MatchLabels: map[string]string{"tenant": "a"},
+		}
+		inst := newInstallation("a", "default", map[string]string{"tenant": "b"})
+		subs, err := componentoverwrites.NewSubstitutionsForInstallation(cvo, &inst, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(subs.Replace(inst.Spec.ComponentDescriptor.Reference)).To(BeFalse())
+
+		inst.Labels["tenant"] = "a"
+		subs, err = componentoverwrites.NewSubstitutionsForInstallation(cvo, &inst, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(subs.Replace(inst.Spec.ComponentDescriptor.Reference)).To(BeTrue())
+		Expect(inst.Spec.ComponentDescriptor.Reference.Version).To(Equal("v1.0.1"))
+	})
+
+	It("should return the next time an overwrite starts or stops to apply", func() {
+		Expect(componentoverwrites.NextTransitionTime(cvo, now)).To(BeNil())
+
+		cvo.Overwrites[0].ValidFrom = &metav1.Time{Time: now.Add(-time.Hour)}
+		cvo.Overwrites[0].ValidUntil = &metav1.Time{Time: now.Add(2 * time.Hour)}
+		cvo.Overwrites = append(cvo.Overwrites, lsv1alpha1.ComponentVersionOverwrite{
+			ValidFrom: &metav1.Time{Time: now.Add(time.Hour)},
+		})
+		next := componentoverwrites.NextTransitionTime(cvo, now)
+		Expect(next).ToNot(BeNil())
+		Expect(*next).To(Equal(now.Add(time.Hour)))
+	})
+
+	It("should only consider overwrites that select the installation for the next transition time", func() {
+		cvo.Overwrites[0].ValidUntil = &metav1.Time{Time: now.Add(2 * time.Hour)}
+		cvo.Overwrites = append(cvo.Overwrites, lsv1alpha1.ComponentVersionOverwrite{
+			ValidFrom: &metav1.Time{Time: now.Add(time.Hour)},
+			InstallationSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"tenant": "b"},
+			},
+		})
+
+		inst := newInstallation("a", "default", map[string]string{"tenant": "a"})
+		next, err := componentoverwrites.NextTransitionTimeForInstallation(cvo, &inst, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(next).ToNot(BeNil())
+		Expect(*next).To(Equal(now.Add(2 * time.Hour)))
+
+		inst = newInstallation("b", "default", map[string]string{"tenant": "b"})
+		next, err = componentoverwrites.NextTransitionTimeForInstallation(cvo, &inst, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(next).ToNot(BeNil())
+		Expect(*next).To(Equal(now.Add(time.Hour)))
+	})
+
+	Context("GetAffectedInstallations", func() {
+
+		var contexts []lsv1alpha1.Context
+
+		BeforeEach(func() {
+			referencing := lsv1alpha1.Context{}
+			referencing.Name = "default"
+			referencing.Namespace = "test"
+			referencing.RepositoryContext = testutils.ExampleRepositoryContext()
+			referencing.ComponentVersionOverwritesReference = cvo.Name
+
+			other := lsv1alpha1.Context{}
+			other.Name = "other"
+			other.Namespace = "test"
+			other.RepositoryContext = testutils.ExampleRepositoryContext()
+
+			contexts = []lsv1alpha1.Context{referencing, other}
+		})
+
+		It("should list the installations whose component reference is overwritten", func() {
+			cvo.Overwrites[0].InstallationSelector = &metav1.LabelSelector{
+				MatchLabels: map[string]string{"tenant": "a"},
+			}
+			installations := []lsv1alpha1.Installation{
+				newInstallation("b", "default", map[string]string{"tenant": "a"}),
+				newInstallation("a", "default", map[string]string{"tenant": "a"}),
+				newInstallation("other-tenant", "default", map[string]string{"tenant": "b"}),
+				newInstallation("other-context", "other", map[string]string{"tenant": "a"}),
+			}
+
+			affected, err := componentoverwrites.GetAffectedInstallations(cvo, contexts, installations, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(affected).To(HaveLen(2))
+			Expect(affected[0].Name).To(Equal("a"))
+			Expect(affected[0].Context).To(Equal("default"))
+			Expect(affected[0].Original.Version).To(Equal("v1.0.0"))
+			Expect(affected[0].Original.RepositoryContext).To(Equal(testutils.ExampleRepositoryContext()))
+			Expect(affected[0].Substitution.Version).To(Equal("v1.0.1"))
+			Expect(affected[0].Substitution.ComponentName).To(Equal("component.example.com"))
+			Expect(affected[1].Name).To(Equal("b"))
+		})
+
+		It("should use the resolved version of a version constraint", func() {
+			inst := newInstallation("a", "default", nil)
+			inst.Spec.ComponentDescriptor.Reference.Version = ""
+			inst.Spec.ComponentDescriptor.Reference.VersionConstraint = "~1.0"
+
+			affected, err := componentoverwrites.GetAffectedInstallations(cvo, contexts, []lsv1alpha1.Installation{inst}, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(affected).To(BeEmpty())
+
+			inst.Status.ResolvedComponentVersion = &lsv1alpha1.ResolvedComponentVersion{
+				Constraint: "~1.0",
+				Version:    "v1.0.0",
+			}
+			affected, err = componentoverwrites.GetAffectedInstallations(cvo, contexts, []lsv1alpha1.Installation{inst}, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(affected).To(HaveLen(1))
+			Expect(affected[0].Original.Version).To(Equal("v1.0.0"))
+		})
+
+		It("should not list installations outside of the time window", func() {
+			cvo.Overwrites[0].ValidUntil = &metav1.Time{Time: now}
+			affected, err := componentoverwrites.GetAffectedInstallations(cvo, contexts,
+				[]lsv1alpha1.Installation{newInstallation("a", "default", nil)}, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(affected).To(BeEmpty())
+		})
+	})
+
+})
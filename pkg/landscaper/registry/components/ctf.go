@@ -0,0 +1,335 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentsregistry
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/gardener/component-spec/bindings-go/ctf"
+	"github.com/golang/groupcache/lru"
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/projectionfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+)
+
+// CTFRepositoryType defines the repository context type of component archives
+// and common transport format (CTF) archives.
+const CTFRepositoryType = "ctf"
+
+// CTFRepository describes a repository that consists of a component archive or a CTF archive.
+type CTFRepository struct {
+	cdv2.ObjectType `json:",inline"`
+	// FilePath is the path to the archive relative to the root path of the ctf registry.
+	// The archive can be
+	// - a component archive as directory, tar or gzipped tar,
+	// - a CTF archive, i.e. a tar that contains component archives as tar or gzipped tar,
+	// - a directory that contains component archives as directories, tars or gzipped tars.
+	FilePath string `json:"filePath"`
+}
+
+// NewCTFRepository creates a new ctf repository context.
+func NewCTFRepository(filePath string) *CTFRepository {
+	return &CTFRepository{
+		ObjectType: cdv2.ObjectType{
+			Type: CTFRepositoryType,
+		},
+		FilePath: filePath,
+	}
+}
+
+// maxCachedArchives is the maximum number of archive paths whose component archives are kept in memory.
+const maxCachedArchives = 16
+
+// ctfClient is a component descriptor repository implementation that resolves component descriptors
+// and their blobs from component archives and CTF archives in a filesystem.
+// The archives of the most recently used paths are kept in memory until the files of a path change.
+type ctfClient struct {
+	fs vfs.FileSystem
+
+	mux      sync.Mutex
+	archives *lru.Cache
+}
+
+// cachedArchives are the component archives that have been read from a path.
+// The archives can be used as soon as done is closed.
+type cachedArchives struct {
+	// version identifies the state of the files from which the archives are read.
+	version  string
+	done     chan struct{}
+	archives []*ctf.ComponentArchive
+	err      error
+}
+
+var _ TypedRegistry = &ctfClient{}
+
+// NewCTFClient creates a new ctf registry that reads the archives relative to the given root path.
+func NewCTFClient(rootPath string) (TypedRegistry, error) {
+	fs, err := projectionfs.New(osfs.New(), rootPath)
+	if err != nil {
+		return nil, err
+	}
+	return NewCTFClientWithFilesystem(fs), nil
+}
+
+// NewCTFClientWithFilesystem creates a new ctf registry that reads the archives from the given filesystem.
+func NewCTFClientWithFilesystem(fs vfs.FileSystem) TypedRegistry {
+	return &ctfClient{
+		fs:       fs,
+		archives: lru.New(maxCachedArchives),
+	}
+}
+
+// Type returns the repository type that can be handled by this client.
+func (c *ctfClient) Type() string {
+	return CTFRepositoryType
+}
+
+// Resolve resolves a reference and returns the component descriptor.
+func (c *ctfClient) Resolve(ctx context.Context, repoCtx cdv2.Repository, name, version string) (*cdv2.ComponentDescriptor, error) {
+	cd, _, err := c.ResolveWithBlobResolver(ctx, repoCtx, name, version)
+	return cd, err
+}
+
+// ResolveWithBlobResolver resolves a reference and returns the component descriptor
+// together with a blob resolver for the blobs of its component archive.
+func (c *ctfClient) ResolveWithBlobResolver(ctx context.Context, repoCtx cdv2.Repository, name, version string) (*cdv2.ComponentDescriptor, ctf.BlobResolver, error) {
+	archives, err := c.getArchives(ctx, repoCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, ca := range archives {
+		if ca.ComponentDescriptor.GetName() == name && ca.ComponentDescriptor.GetVersion() == version {
+			return ca.ComponentDescriptor.DeepCopy(), ca.BlobResolver, nil
+		}
+	}
+	return nil, nil, cdv2.NotFound
+}
+
+// ListComponentVersions returns the versions of all component archives of the component with the given name.
+func (c *ctfClient) ListComponentVersions(ctx context.Context, repoCtx cdv2.Repository, name string) ([]string, error) {
+	archives, err := c.getArchives(ctx, repoCtx)
+	if err != nil {
+		return nil, err
+	}
+	versions := sets.NewString()
+	for _, ca := range archives {
+		if ca.ComponentDescriptor.GetName() == name {
+			versions.Insert(ca.ComponentDescriptor.GetVersion())
+		}
+	}
+	return versions.List(), nil
+}
+
+// getArchives returns the component archives of the archive that is referenced by the repository context.
+func (c *ctfClient) getArchives(ctx context.Context, repoCtx cdv2.Repository) ([]*ctf.ComponentArchive, error) {
+	repo := &CTFRepository{}
+	switch r := repoCtx.(type) {
+	case *cdv2.UnstructuredTypedObject:
+		if err := r.DecodeInto(repo); err != nil {
+			return nil, err
+		}
+	case *CTFRepository:
+		repo = r
+	default:
+		return nil, fmt.Errorf("unsupported type %s expected %s", repoCtx.GetType(), CTFRepositoryType)
+	}
+	if repo.GetType() != CTFRepositoryType {
+		return nil, fmt.Errorf("unsupported type %s expected %s", repo.GetType(), CTFRepositoryType)
+	}
+
+	path := filepath.Join("/", repo.FilePath)
+	version, err := archiveVersion(c.fs, path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read component archives from %s: %w", repo.FilePath, err)
+	}
+
+	// The archives are read outside of the lock, so that reading a large archive does not block other paths.
+	// Concurrent calls for the same path and version wait for the same read.
+	c.mux.Lock()
+	entry, ok := c.archives.Get(path)
+	if !ok || entry.(*cachedArchives).version != version {
+		cached := &cachedArchives{version: version, done: make(chan struct{})}
+		c.archives.Add(path, cached)
+		c.mux.Unlock()
+		c.loadArchives(ctx, path, cached)
+		entry = cached
+	} else {
+		c.mux.Unlock()
+	}
+
+	cached := entry.(*cachedArchives)
+	select {
+	case <-cached.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if cached.err != nil {
+		return nil, fmt.Errorf("unable to read component archives from %s: %w", repo.FilePath, cached.err)
+	}
+	return cached.archives, nil
+}
+
+// loadArchives reads the component archives of the given path into the given cache entry.
+// Failed reads are removed from the cache, so that they are retried by the next call.
+func (c *ctfClient) loadArchives(ctx context.Context, path string, cached *cachedArchives) {
+	defer close(cached.done)
+	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "readArchives"}, "path", path)
+	logger.Debug("reading component archives")
+	cached.archives, cached.err = readArchives(logger, c.fs, path)
+	if cached.err == nil {
+		return
+	}
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if entry, ok := c.archives.Get(path); ok && entry == cached {
+		c.archives.Remove(path)
+	}
+}
+
+// archiveVersion computes a version of the files of the given path from their names, sizes and modification times,
+// so that changed archives are read again.
+func archiveVersion(fs vfs.FileSystem, path string) (string, error) {
+	hash := sha256.New()
+	err := vfs.Walk(fs, path, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(hash, "%s:%d:%d:%d\n", filePath, info.Mode(), info.Size(), info.ModTime().UnixNano())
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// readArchives reads the component archives of a component archive or CTF archive file or directory.
+func readArchives(logger logging.Logger, fs vfs.FileSystem, path string) ([]*ctf.ComponentArchive, error) {
+	info, err := fs.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		data, err := vfs.ReadFile(fs, path)
+		if err != nil {
+			return nil, err
+		}
+		archiveFs := memoryfs.New()
+		if err := extractArchive(archiveFs, data); err != nil {
+			return nil, err
+		}
+		return readArchives(logger, archiveFs, "/")
+	}
+
+	if ok, err := vfs.FileExists(fs, filepath.Join(path, ctf.ComponentDescriptorFileName)); err != nil {
+		return nil, err
+	} else if ok {
+		// the directory is a single component archive
+		caFs, err := projectionfs.New(fs, path)
+		if err != nil {
+			return nil, err
+		}
+		ca, err := ctf.NewComponentArchiveFromFilesystem(caFs)
+		if err != nil {
+			return nil, err
+		}
+		return []*ctf.ComponentArchive{ca}, nil
+	}
+
+	// the directory contains component archives
+	entries, err := vfs.ReadDir(fs, path)
+	if err != nil {
+		return nil, err
+	}
+	archives := make([]*ctf.ComponentArchive, 0, len(entries))
+	for _, entry := range entries {
+		entryPath := filepath.Join(path, entry.Name())
+		if ok, err := isArchive(fs, entryPath); err != nil {
+			return nil, err
+		} else if !ok {
+			logger.Debug("skipping entry that is no component archive", "entry", entryPath)
+			continue
+		}
+
+		entryArchives, err := readArchives(logger, fs, entryPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read component archive %s: %w", entry.Name(), err)
+		}
+		archives = append(archives, entryArchives...)
+	}
+	return archives, nil
+}
+
+// isArchive checks whether the given path is a directory or a tar or gzipped tar file,
+// i.e. whether it can contain component archives.
+func isArchive(fs vfs.FileSystem, path string) (bool, error) {
+	info, err := fs.Stat(path)
+	if err != nil {
+		return false, err
+	}
+	if info.IsDir() {
+		return true, nil
+	}
+	if !info.Mode().IsRegular() {
+		return false, nil
+	}
+
+	file, err := fs.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+	header := make([]byte, tarMagicOffset+len(tarMagic))
+	n, err := io.ReadFull(file, header)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return false, err
+	}
+	header = header[:n]
+	return isGzip(header) || isTar(header), nil
+}
+
+// extractArchive extracts a tar or gzipped tar to the given filesystem.
+func extractArchive(fs vfs.FileSystem, data []byte) error {
+	var reader io.Reader = bytes.NewReader(data)
+	if isGzip(data) {
+		gzipReader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+	return ctf.ExtractTarToFs(fs, reader)
+}
+
+func isGzip(data []byte) bool {
+	return len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b
+}
+
+const (
+	// tarMagic is the magic of ustar and gnu tar headers, which is located at tarMagicOffset.
+	tarMagic       = "ustar"
+	tarMagicOffset = 257
+)
+
+func isTar(data []byte) bool {
+	return len(data) >= tarMagicOffset+len(tarMagic) && string(data[tarMagicOffset:tarMagicOffset+len(tarMagic)]) == tarMagic
+}
@@ -0,0 +1,226 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentsregistry_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/gardener/component-spec/bindings-go/ctf"
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencontainers/go-digest"
+
+	componentsregistry "github.com/gardener/landscaper/pkg/landscaper/registry/components"
+	"github.com/gardener/landscaper/pkg/landscaper/registry/components/cdutils"
+)
+
+// newComponentArchive creates a component archive with a blob resource and the given component references.
+func newComponentArchive(name, version string, refs ...cdv2.ComponentReference) *ctf.ComponentArchive {
+	repoCtx, err := cdv2.NewUnstructured(cdv2.NewOCIRegistryRepository("example.com/components", ""))
+	Expect(err).ToNot(HaveOccurred())
+
+	cd := &cdv2.ComponentDescriptor{}
+	cd.Metadata.Version = cdv2.SchemaVersion
+	cd.Name = name
+	cd.Version = version
+	cd.Provider = cdv2.InternalProvider
+	cd.RepositoryContexts = []*cdv2.UnstructuredTypedObject{&repoCtx}
+	cd.ComponentReferences = refs
+	Expect(cdv2.DefaultComponent(cd)).To(Succeed())
+
+	ca := ctf.NewComponentArchive(cd, memoryfs.New())
+	data := []byte("blob of " + name)
+	res := &cdv2.Resource{
+		IdentityObjectMeta: cdv2.IdentityObjectMeta{
+			Name:    "blob",
+			Version: version,
+			Type:    "plain",
+		},
+		Relation: cdv2.LocalRelation,
+	}
+	Expect(ca.AddResource(res, ctf.BlobInfo{
+		MediaType: "text/plain",
+		Digest:    digest.FromBytes(data).String(),
+		Size:      int64(len(data)),
+	}, bytes.NewReader(data))).To(Succeed())
+	return ca
+}
+
+var _ = Describe("CTF Registry", func() {
+
+	var (
+		ctx      context.Context
+		rootPath string
+		rootCA   *ctf.ComponentArchive
+		childCA  *ctf.ComponentArchive
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		rootPath, err = os.MkdirTemp("", "ctf-registry")
+		Expect(err).ToNot(HaveOccurred())
+
+		childCA = newComponentArchive("example.com/child", "v1.0.0")
+		rootCA = newComponentArchive("example.com/root", "v2.0.0", cdv2.ComponentReference{
+			Name:          "child",
+			ComponentName: "example.com/child",
+			Version:       "v1.0.0",
+		})
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(rootPath)).To(Succeed())
+	})
+
+	writeCTF := func(path string) {
+		buf := &bytes.Buffer{}
+		tw := tar.NewWriter(buf)
+		for i, ca := range []*ctf.ComponentArchive{rootCA, childCA} {
+			caBuf := &bytes.Buffer{}
+			if i == 0 {
+				Expect(ca.WriteTar(caBuf)).To(Succeed())
+			} else {
+				Expect(ca.WriteTarGzip(caBuf)).To(Succeed())
+			}
+			Expect(tw.WriteHeader(&tar.Header{
+				Name:     ca.ComponentDescriptor.GetName()[len("example.com/"):],
+				Mode:     0644,
+				Size:     int64(caBuf.Len()),
+				Typeflag: tar.TypeReg,
+			})).To(Succeed())
+			_, err := tw.Write(caBuf.Bytes())
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(tw.Close()).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(rootPath, path), buf.Bytes(), os.ModePerm)).To(Succeed())
+	}
+
+	It("should resolve component descriptors and blobs from a ctf archive", func() {
+		writeCTF("transport.tar")
+		registry, err := componentsregistry.NewCTFClient(rootPath)
+		Expect(err).ToNot(HaveOccurred())
+		repoCtx, err := cdv2.NewUnstructured(componentsregistry.NewCTFRepository("transport.tar"))
+		Expect(err).ToNot(HaveOccurred())
+
+		cd, blobResolver, err := registry.ResolveWithBlobResolver(ctx, &repoCtx, "example.com/root", "v2.0.0")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cd.GetName()).To(Equal("example.com/root"))
+
+		blob := &bytes.Buffer{}
+		_, err = blobResolver.Resolve(ctx, cd.Resources[0], blob)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(blob.String()).To(Equal("blob of example.com/root"))
+
+		cdList, err := cdutils.ResolveToComponentDescriptorList(ctx, registry, *cd, &repoCtx, nil)
+		Expect(err).ToNot(HaveOccurred())
+		names := make([]string, 0, len(cdList.Components))
+		for _, component := range cdList.Components {
+			names = append(names, component.GetName())
+		}
+		Expect(names).To(ContainElements("example.com/root", "example.com/child"))
+
+		_, err = registry.Resolve(ctx, &repoCtx, "example.com/root", "v3.0.0")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should resolve component descriptors from a directory of component archives", func() {
+		Expect(rootCA.WriteToFilesystem(osfs.New(), filepath.Join(rootPath, "archives", "root"))).To(Succeed())
+		file, err := os.Create(filepath.Join(rootPath, "archives", "child.tgz"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(childCA.WriteTarGzip(file)).To(Succeed())
+		Expect(file.Close()).To(Succeed())
+		// entries that are no component archives are skipped
+		Expect(os.WriteFile(filepath.Join(rootPath, "archives", "README.md"), []byte("# Archives"), os.ModePerm)).To(Succeed())
+		Expect(os.MkdirAll(filepath.Join(rootPath, "archives", "empty"), os.ModePerm)).To(Succeed())
+
+		registry, err := componentsregistry.NewCTFClient(rootPath)
+		Expect(err).ToNot(HaveOccurred())
+		repoCtx := componentsregistry.NewCTFRepository("archives")
+
+		cd, blobResolver, err := registry.ResolveWithBlobResolver(ctx, repoCtx, "example.com/root", "v2.0.0")
+		Expect(err).ToNot(HaveOccurred())
+		blob := &bytes.Buffer{}
+		_, err = blobResolver.Resolve(ctx, cd.Resources[0], blob)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(blob.String()).To(Equal("blob of example.com/root"))
+
+		cd, err = registry.Resolve(ctx, repoCtx, "example.com/child", "v1.0.0")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cd.GetName()).To(Equal("example.com/child"))
+	})
+
+	It("should list the component versions of a ctf archive", func() {
+		writeCTF("transport.tar")
+		registry, err := componentsregistry.NewCTFClient(rootPath)
+		Expect(err).ToNot(HaveOccurred())
+
+		versions, err := registry.(componentsregistry.ComponentVersionLister).ListComponentVersions(ctx,
+			componentsregistry.NewCTFRepository("transport.tar"), "example.com/child")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(versions).To(ConsistOf("v1.0.0"))
+	})
+
+	It("should read an archive again after it has been modified", func() {
+		writeCTF("transport.tar")
+		registry, err := componentsregistry.NewCTFClient(rootPath)
+		Expect(err).ToNot(HaveOccurred())
+		repoCtx := componentsregistry.NewCTFRepository("transport.tar")
+
+		_, err = registry.Resolve(ctx, repoCtx, "example.com/root", "v2.0.0")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = registry.Resolve(ctx, repoCtx, "example.com/root", "v3.0.0")
+		Expect(err).To(HaveOccurred())
+
+		rootCA = newComponentArchive("example.com/root", "v3.0.0")
+		writeCTF("transport.tar")
+		modTime := time.Now().Add(time.Minute)
+		Expect(os.Chtimes(filepath.Join(rootPath, "transport.tar"), modTime, modTime)).To(Succeed())
+
+		_, err = registry.Resolve(ctx, repoCtx, "example.com/root", "v3.0.0")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should resolve component descriptors concurrently", func() {
+		writeCTF("transport.tar")
+		registry, err := componentsregistry.NewCTFClient(rootPath)
+		Expect(err).ToNot(HaveOccurred())
+		repoCtx := componentsregistry.NewCTFRepository("transport.tar")
+
+		var wg sync.WaitGroup
+		errs := make([]error, 10)
+		for i := range errs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, errs[i] = registry.Resolve(ctx, repoCtx, "example.com/child", "v1.0.0")
+			}(i)
+		}
+		wg.Wait()
+		for _, err := range errs {
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	It("should not read archives outside of the root path", func() {
+		Expect(os.MkdirAll(filepath.Join(rootPath, "root"), os.ModePerm)).To(Succeed())
+		writeCTF("transport.tar")
+		registry, err := componentsregistry.NewCTFClient(filepath.Join(rootPath, "root"))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = registry.Resolve(ctx, componentsregistry.NewCTFRepository("../transport.tar"), "example.com/root", "v2.0.0")
+		Expect(err).To(HaveOccurred())
+	})
+
+})
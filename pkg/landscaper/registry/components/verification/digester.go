@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package verification
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"reflect"
+	"strings"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/gardener/component-spec/bindings-go/apis/v2/signatures"
+	"github.com/opencontainers/go-digest"
+	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+)
+
+// ManifestResolver resolves the raw manifest of an oci artifact.
+type ManifestResolver interface {
+	// GetRawManifest returns the raw manifest for a reference.
+	GetRawManifest(ctx context.Context, ref string) (ocispecv1.Descriptor, []byte, error)
+}
+
+// BlobDigester calculates the digest of the blob of a resource while it is written
+// and verifies it against the digest that is defined for the resource in the component descriptor.
+type BlobDigester struct {
+	resource cdv2.Resource
+	hash     hash.Hash
+}
+
+// NewBlobDigester creates a new digester for the blob of the given resource.
+func NewBlobDigester(res cdv2.Resource) *BlobDigester {
+	return &BlobDigester{
+		resource: res,
+		hash:     sha256.New(),
+	}
+}
+
+// Write adds the data to the calculated digest.
+func (d *BlobDigester) Write(p []byte) (int, error) {
+	return d.hash.Write(p)
+}
+
+// Verify verifies the digest of the written blob against the digest of the resource.
+// Resources without a digest or with a digest that is excluded from the signature are not verified.
+// Oci artifact digests are calculated over the manifest of the artifact which is fetched with the manifest resolver.
+// If no manifest resolver is given, the digest is only verified if the image reference is pinned to the digest.
+func (d *BlobDigester) Verify(ctx context.Context, resolver ManifestResolver) error {
+	spec := d.resource.Digest
+	if spec == nil || reflect.DeepEqual(spec, cdv2.NewExcludeFromSignatureDigest()) {
+		return nil
+	}
+	if spec.HashAlgorithm != signatures.SHA256 {
+		return fmt.Errorf("hash algorithm %q of resource %q is not supported", spec.HashAlgorithm, d.resource.GetName())
+	}
+
+	blobDigest := hex.EncodeToString(d.hash.Sum(nil))
+	switch cdv2.NormalisationAlgorithm(spec.NormalisationAlgorithm) {
+	case cdv2.GenericBlobDigestV1:
+		if blobDigest != spec.Value {
+			return fmt.Errorf("digest %q of resource %q does not match the expected digest %q", blobDigest, d.resource.GetName(), spec.Value)
+		}
+		return nil
+	case cdv2.OciArtifactDigestV1:
+		return d.verifyOCIArtifact(ctx, resolver, digest.NewDigestFromEncoded(digest.SHA256, blobDigest))
+	default:
+		return fmt.Errorf("normalisation algorithm %q of resource %q is not supported", spec.NormalisationAlgorithm, d.resource.GetName())
+	}
+}
+
+func (d *BlobDigester) verifyOCIArtifact(ctx context.Context, resolver ManifestResolver, blobDigest digest.Digest) error {
+	if d.resource.Access == nil {
+		return fmt.Errorf("resource %q has no access defined", d.resource.GetName())
+	}
+	ociAccess := &cdv2.OCIRegistryAccess{}
+	if err := d.resource.Access.DecodeInto(ociAccess); err != nil {
+		return fmt.Errorf("unable to decode access of resource %q: %w", d.resource.GetName(), err)
+	}
+	expected := digest.NewDigestFromEncoded(digest.SHA256, d.resource.Digest.Value)
+
+	if resolver == nil {
+		if strings.HasSuffix(ociAccess.ImageReference, "@"+expected.String()) {
+			return nil
+		}
+		logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "verifyOCIArtifact"})
+		logger.Info("Digest of oci artifact is not verified as its image reference is not pinned to a digest",
+			"resourceName", d.resource.GetName(), "imageReference", ociAccess.ImageReference)
+		return nil
+	}
+
+	_, rawManifest, err := resolver.GetRawManifest(ctx, ociAccess.ImageReference)
+	if err != nil {
+		return fmt.Errorf("unable to get manifest of resource %q: %w", d.resource.GetName(), err)
+	}
+	if manifestDigest := digest.FromBytes(rawManifest); manifestDigest != expected {
+		return fmt.Errorf("digest %q of the manifest of resource %q does not match the expected digest %q",
+			manifestDigest.String(), d.resource.GetName(), expected.String())
+	}
+
+	manifest := &ocispecv1.Manifest{}
+	if err := json.Unmarshal(rawManifest, manifest); err != nil {
+		return fmt.Errorf("unable to decode manifest of resource %q: %w", d.resource.GetName(), err)
+	}
+	for _, layer := range manifest.Layers {
+		if layer.Digest == blobDigest {
+			return nil
+		}
+	}
+	return fmt.Errorf("digest %q of resource %q does not match any layer of its manifest", blobDigest.String(), d.resource.GetName())
+}
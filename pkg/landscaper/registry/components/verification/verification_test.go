@@ -0,0 +1,281 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package verification_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/gardener/component-spec/bindings-go/apis/v2/signatures"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencontainers/go-digest"
+	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/registry/components/verification"
+)
+
+const signatureName = "test-signature"
+
+// newKey generates a rsa key and returns it together with its pem encoded public key.
+func newKey() (*rsa.PrivateKey, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).ToNot(HaveOccurred())
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	Expect(err).ToNot(HaveOccurred())
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}))
+}
+
+// newCertificate creates a pem encoded self-signed certificate for the key with the given validity.
+func newCertificate(key *rsa.PrivateKey, notBefore, notAfter time.Time) string {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "landscaper"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).ToNot(HaveOccurred())
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}))
+}
+
+// signComponentDescriptor signs the component descriptor with the given key.
+func signComponentDescriptor(cd *cdv2.ComponentDescriptor, key *rsa.PrivateKey) {
+	privateKey, err := x509.MarshalPKCS8PrivateKey(key)
+	Expect(err).ToNot(HaveOccurred())
+	keyPath := filepath.Join(GinkgoT().TempDir(), "key.pem")
+	Expect(os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKey}), 0600)).To(Succeed())
+
+	signer, err := signatures.CreateRSASignerFromKeyFile(keyPath, cdv2.MediaTypePEM)
+	Expect(err).ToNot(HaveOccurred())
+	hasher, err := signatures.HasherForName(signatures.SHA256)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(signatures.SignComponentDescriptor(cd, signer, *hasher, signatureName)).To(Succeed())
+}
+
+func newComponentDescriptor() *cdv2.ComponentDescriptor {
+	access, err := cdv2.NewUnstructured(cdv2.NewOCIRegistryAccess("example.com/image:v1.0.0"))
+	Expect(err).ToNot(HaveOccurred())
+
+	cd := &cdv2.ComponentDescriptor{}
+	cd.Metadata.Version = cdv2.SchemaVersion
+	cd.Name = "example.com/component"
+	cd.Version = "v1.0.0"
+	cd.Provider = cdv2.InternalProvider
+	cd.Resources = []cdv2.Resource{
+		{
+			IdentityObjectMeta: cdv2.IdentityObjectMeta{
+				Name:    "image",
+				Version: "v1.0.0",
+				Type:    cdv2.OCIImageType,
+			},
+			Relation: cdv2.ExternalRelation,
+			Access:   &access,
+			Digest: &cdv2.DigestSpec{
+				HashAlgorithm:          signatures.SHA256,
+				NormalisationAlgorithm: string(cdv2.OciArtifactDigestV1),
+				Value:                  digest.FromString("image").Encoded(),
+			},
+		},
+	}
+	Expect(cdv2.DefaultComponent(cd)).To(Succeed())
+	return cd
+}
+
+type manifestResolver map[string][]byte
+
+func (r manifestResolver) GetRawManifest(_ context.Context, ref string) (ocispecv1.Descriptor, []byte, error) {
+	data := r[ref]
+	return ocispecv1.Descriptor{Digest: digest.FromBytes(data), Size: int64(len(data))}, data, nil
+}
+
+var _ = Describe("Verification", func() {
+
+	Context("Verifier", func() {
+
+		var (
+			key       *rsa.PrivateKey
+			publicKey string
+			cd        *cdv2.ComponentDescriptor
+		)
+
+		BeforeEach(func() {
+			key, publicKey = newKey()
+			cd = newComponentDescriptor()
+			signComponentDescriptor(cd, key)
+		})
+
+		It("should verify a signed component descriptor with a public key", func() {
+			verifier, err := verification.NewVerifier(&lsv1alpha1.ComponentVerification{
+				SignatureName: signatureName,
+				PublicKey:     publicKey,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(verifier.Verify(cd)).To(Succeed())
+		})
+
+		It("should verify a signed component descriptor with a certificate", func() {
+			verifier, err := verification.NewVerifier(&lsv1alpha1.ComponentVerification{
+				SignatureName: signatureName,
+				Certificate:   newCertificate(key, time.Now().Add(-time.Hour), time.Now().Add(time.Hour)),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(verifier.Verify(cd)).To(Succeed())
+		})
+
+		It("should fail if the certificate is expired", func() {
+			verifier, err := verification.NewVerifier(&lsv1alpha1.ComponentVerification{
+				SignatureName: signatureName,
+				Certificate:   newCertificate(key, time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour)),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(verifier.Verify(cd)).ToNot(Succeed())
+		})
+
+		It("should fail if the component descriptor has been tampered", func() {
+			verifier, err := verification.NewVerifier(&lsv1alpha1.ComponentVerification{
+				SignatureName: signatureName,
+				PublicKey:     publicKey,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			cd.Resources[0].Digest.Value = digest.FromString("tampered image").Encoded()
+			Expect(verifier.Verify(cd)).ToNot(Succeed())
+		})
+
+		It("should fail if the component descriptor is signed with another key", func() {
+			_, otherPublicKey := newKey()
+			verifier, err := verification.NewVerifier(&lsv1alpha1.ComponentVerification{
+				SignatureName: signatureName,
+				PublicKey:     otherPublicKey,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(verifier.Verify(cd)).ToNot(Succeed())
+		})
+
+		It("should fail if the component descriptor is not signed", func() {
+			verifier, err := verification.NewVerifier(&lsv1alpha1.ComponentVerification{
+				SignatureName: signatureName,
+				PublicKey:     publicKey,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(verifier.Verify(newComponentDescriptor())).ToNot(Succeed())
+		})
+
+		It("should not accept an invalid configuration", func() {
+			_, err := verification.NewVerifier(&lsv1alpha1.ComponentVerification{SignatureName: signatureName})
+			Expect(err).To(HaveOccurred())
+			_, err = verification.NewVerifier(&lsv1alpha1.ComponentVerification{PublicKey: publicKey})
+			Expect(err).To(HaveOccurred())
+			_, err = verification.NewVerifier(&lsv1alpha1.ComponentVerification{
+				SignatureName: signatureName,
+				PublicKey:     "invalid",
+			})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject public keys that are not RSA keys", func() {
+			key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).ToNot(HaveOccurred())
+			data, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = verification.NewVerifier(&lsv1alpha1.ComponentVerification{
+				SignatureName: signatureName,
+				PublicKey:     string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: data})),
+			})
+			Expect(err).To(MatchError(ContainSubstring("only RSA public keys are supported")))
+		})
+	})
+
+	Context("BlobDigester", func() {
+
+		var (
+			ctx  context.Context
+			blob []byte
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			blob = []byte("my blob")
+		})
+
+		newBlobResource := func(value string) cdv2.Resource {
+			return cdv2.Resource{
+				IdentityObjectMeta: cdv2.IdentityObjectMeta{Name: "blob"},
+				Digest: &cdv2.DigestSpec{
+					HashAlgorithm:          signatures.SHA256,
+					NormalisationAlgorithm: string(cdv2.GenericBlobDigestV1),
+					Value:                  value,
+				},
+			}
+		}
+
+		It("should verify the digest of a blob", func() {
+			sum := sha256.Sum256(blob)
+			digester := verification.NewBlobDigester(newBlobResource(hex.EncodeToString(sum[:])))
+			_, err := digester.Write(blob)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(digester.Verify(ctx, nil)).To(Succeed())
+		})
+
+		It("should fail if the blob has been tampered", func() {
+			sum := sha256.Sum256(blob)
+			digester := verification.NewBlobDigester(newBlobResource(hex.EncodeToString(sum[:])))
+			_, err := digester.Write([]byte("tampered blob"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(digester.Verify(ctx, nil)).ToNot(Succeed())
+		})
+
+		It("should not verify resources without a digest or a digest that is excluded from the signature", func() {
+			res := newBlobResource("")
+			res.Digest = nil
+			Expect(verification.NewBlobDigester(res).Verify(ctx, nil)).To(Succeed())
+			res.Digest = cdv2.NewExcludeFromSignatureDigest()
+			Expect(verification.NewBlobDigester(res).Verify(ctx, nil)).To(Succeed())
+		})
+
+		It("should verify the digest of an oci artifact with its manifest", func() {
+			manifest, err := json.Marshal(ocispecv1.Manifest{
+				Layers: []ocispecv1.Descriptor{{Digest: digest.FromBytes(blob), Size: int64(len(blob))}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			resolver := manifestResolver{"example.com/image:v1.0.0": manifest}
+
+			res := newComponentDescriptor().Resources[0]
+			res.Digest.Value = digest.FromBytes(manifest).Encoded()
+			digester := verification.NewBlobDigester(res)
+			_, err = digester.Write(blob)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(digester.Verify(ctx, resolver)).To(Succeed())
+
+			tampered := verification.NewBlobDigester(res)
+			_, err = tampered.Write([]byte("tampered blob"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tampered.Verify(ctx, resolver)).ToNot(Succeed())
+
+			res.Digest.Value = digest.FromString("other manifest").Encoded()
+			otherManifest := verification.NewBlobDigester(res)
+			_, err = otherManifest.Write(blob)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(otherManifest.Verify(ctx, resolver)).ToNot(Succeed())
+		})
+	})
+
+})
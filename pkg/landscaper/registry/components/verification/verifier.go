@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package verification
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/gardener/component-spec/bindings-go/apis/v2/signatures"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// Verifier verifies the signatures of component descriptors.
+type Verifier struct {
+	signatureName string
+	verifier      signatures.Verifier
+	// certificate is only set if the public key is read from a certificate.
+	certificate *x509.Certificate
+}
+
+// NewVerifier creates a new verifier for the given verification configuration.
+func NewVerifier(config *lsv1alpha1.ComponentVerification) (*Verifier, error) {
+	if config == nil {
+		return nil, errors.New("no verification configuration defined")
+	}
+	if len(config.SignatureName) == 0 {
+		return nil, errors.New("no signature name defined")
+	}
+	if len(config.PublicKey) != 0 && len(config.Certificate) != 0 {
+		return nil, errors.New("only one of public key and certificate may be defined")
+	}
+
+	v := &Verifier{
+		signatureName: config.SignatureName,
+	}
+	var publicKey interface{}
+	switch {
+	case len(config.PublicKey) != 0:
+		block, _ := pem.Decode([]byte(config.PublicKey))
+		if block == nil {
+			return nil, errors.New("unable to decode pem formatted public key")
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse public key: %w", err)
+		}
+		publicKey = key
+	case len(config.Certificate) != 0:
+		block, _ := pem.Decode([]byte(config.Certificate))
+		if block == nil {
+			return nil, errors.New("unable to decode pem formatted certificate")
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse certificate: %w", err)
+		}
+		v.certificate = cert
+		publicKey = cert.PublicKey
+	default:
+		return nil, errors.New("either a public key or a certificate has to be defined")
+	}
+
+	// the component descriptors are signed with RSA, other key types like ECDSA are not supported
+	rsaKey, ok := publicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("unsupported public key type %T: only RSA public keys are supported", publicKey)
+	}
+	verifier, err := signatures.CreateRSAVerifier(rsaKey)
+	if err != nil {
+		return nil, err
+	}
+	v.verifier = verifier
+	return v, nil
+}
+
+// Verify verifies the configured signature of the component descriptor.
+// An error is returned if the component descriptor is not signed or its signature does not match.
+func (v *Verifier) Verify(cd *cdv2.ComponentDescriptor) error {
+	if v.certificate != nil {
+		now := time.Now()
+		if now.Before(v.certificate.NotBefore) || now.After(v.certificate.NotAfter) {
+			return fmt.Errorf("certificate is only valid from %s until %s",
+				v.certificate.NotBefore.Format(time.RFC3339), v.certificate.NotAfter.Format(time.RFC3339))
+		}
+	}
+	if err := signatures.VerifySignedComponentDescriptor(cd, v.verifier, v.signatureName); err != nil {
+		return fmt.Errorf("unable to verify component descriptor %s:%s: %w", cd.GetName(), cd.GetVersion(), err)
+	}
+	return nil
+}
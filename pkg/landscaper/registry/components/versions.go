@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentsregistry
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/gardener/component-spec/bindings-go/codec"
+	"github.com/gardener/component-spec/bindings-go/ctf"
+	cdoci "github.com/gardener/component-spec/bindings-go/oci"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/component-cli/ociclient"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+)
+
+// ComponentVersionLister describes a registry that can list the available versions of a component.
+type ComponentVersionLister interface {
+	// ListComponentVersions returns all versions of the component with the given name in the repository context.
+	ListComponentVersions(ctx context.Context, repoCtx cdv2.Repository, name string) ([]string, error)
+}
+
+var (
+	_ ComponentVersionLister = &Manager{}
+	_ ComponentVersionLister = &ociClient{}
+	_ ComponentVersionLister = &localClient{}
+	_ ComponentVersionLister = &ctfClient{}
+)
+
+// ListComponentVersions lists the versions of a component with the registry of the type of the repository context.
+func (m *Manager) ListComponentVersions(ctx context.Context, repoCtx cdv2.Repository, name string) ([]string, error) {
+	client, ok := m.registries[repoCtx.GetType()]
+	if !ok {
+		return nil, fmt.Errorf("unknown repository type %s", repoCtx.GetType())
+	}
+	lister, ok := client.(ComponentVersionLister)
+	if !ok {
+		return nil, fmt.Errorf("listing component versions is not supported for repository type %s", repoCtx.GetType())
+	}
+	return lister.ListComponentVersions(ctx, repoCtx, name)
+}
+
+// ListComponentVersions lists the tags of the component descriptor repository of a component
+// together with the versions of matching predefined component descriptors.
+func (r *ociClient) ListComponentVersions(ctx context.Context, repoCtx cdv2.Repository, name string) ([]string, error) {
+	var repo cdv2.OCIRegistryRepository
+	switch rc := repoCtx.(type) {
+	case *cdv2.UnstructuredTypedObject:
+		if err := rc.DecodeInto(&repo); err != nil {
+			return nil, err
+		}
+	case *cdv2.OCIRegistryRepository:
+		repo = *rc
+	default:
+		return nil, fmt.Errorf("unknown repository context type %s", repoCtx.GetType())
+	}
+
+	versions := sets.NewString()
+	for _, cd := range r.cache.components {
+		if cd.Name == name && cdv2.TypedObjectEqual(&repo, cd.GetEffectiveRepositoryContext()) {
+			versions.Insert(cd.Version)
+		}
+	}
+
+	tags, err := r.listTags(ctx, repo, name)
+	if err != nil {
+		// predefined component descriptors are sufficient if the registry cannot be accessed
+		if versions.Len() != 0 {
+			return versions.List(), nil
+		}
+		return nil, fmt.Errorf("unable to list versions of component %s: %w", name, err)
+	}
+	versions.Insert(tags...)
+	return versions.List(), nil
+}
+
+// listTags lists the tags of the oci repository that contains the component descriptors of a component.
+func (r *ociClient) listTags(ctx context.Context, repo cdv2.OCIRegistryRepository, name string) ([]string, error) {
+	extendedClient, ok := r.ociClient.(ociclient.ExtendedClient)
+	if !ok {
+		return nil, fmt.Errorf("the oci client does not support listing tags")
+	}
+	// the tag of the reference is ignored when the tags are listed
+	ref, err := cdoci.OCIRef(repo, name, "latest")
+	if err != nil {
+		return nil, fmt.Errorf("unable to calculate oci reference: %w", err)
+	}
+	return extendedClient.ListTags(ctx, ref)
+}
+
+// ListComponentVersions returns the versions of all component descriptors with the given name in the filesystem.
+func (c *localClient) ListComponentVersions(ctx context.Context, repoCtx cdv2.Repository, name string) ([]string, error) {
+	if repoCtx.GetType() != LocalRepositoryType {
+		return nil, fmt.Errorf("unsupported type %s expected %s", repoCtx.GetType(), LocalRepositoryType)
+	}
+	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "ListComponentVersions"}, lc.KeyCDName, name)
+
+	versions := sets.NewString()
+	err := vfs.Walk(c.fs, "/", func(path string, info os.FileInfo, err error) error {
+		// ignore errors
+		if err != nil {
+			logger.Debug(err.Error())
+			return nil
+		}
+		if info.IsDir() || info.Name() != ctf.ComponentDescriptorFileName {
+			return nil
+		}
+
+		data, err := vfs.ReadFile(c.fs, path)
+		if err != nil {
+			return err
+		}
+		cd := &cdv2.ComponentDescriptor{}
+		if err := codec.Decode(data, cd); err != nil {
+			return fmt.Errorf("unable to decode component descriptor file %s: %w", path, err)
+		}
+		if cd.GetName() == name {
+			versions.Insert(cd.GetVersion())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return versions.List(), nil
+}
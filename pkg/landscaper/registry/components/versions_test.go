@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package componentsregistry_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/gardener/component-spec/bindings-go/ctf"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mock_oci "github.com/gardener/component-cli/ociclient/mock"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	componentsregistry "github.com/gardener/landscaper/pkg/landscaper/registry/components"
+)
+
+// extendedOCIClient adds the listing of tags to the mocked oci client.
+type extendedOCIClient struct {
+	*mock_oci.MockClient
+	tags map[string][]string
+}
+
+func (c *extendedOCIClient) ListTags(_ context.Context, ref string) ([]string, error) {
+	tags, ok := c.tags[ref]
+	if !ok {
+		return nil, fmt.Errorf("repository %s not found", ref)
+	}
+	return tags, nil
+}
+
+func (c *extendedOCIClient) ListRepositories(_ context.Context, _ string) ([]string, error) {
+	return nil, nil
+}
+
+var _ = Describe("ListComponentVersions", func() {
+
+	var (
+		ctx     context.Context
+		ctrl    *gomock.Controller
+		repoCtx *cdv2.OCIRegistryRepository
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+		repoCtx = cdv2.NewOCIRegistryRepository("example.com/components", "")
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should list the tags of the component descriptor repository", func() {
+		ociClient := &extendedOCIClient{
+			MockClient: mock_oci.NewMockClient(ctrl),
+			tags: map[string][]string{
+				"example.com/components/component-descriptors/example.com/a:latest": {"v1.0.0", "v1.1.0"},
+			},
+		}
+		registry, err := componentsregistry.NewOCIRegistryWithOCIClient(logging.Discard(), ociClient)
+		Expect(err).ToNot(HaveOccurred())
+
+		versions, err := registry.(componentsregistry.ComponentVersionLister).ListComponentVersions(ctx, repoCtx, "example.com/a")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(versions).To(ConsistOf("v1.0.0", "v1.1.0"))
+	})
+
+	It("should list the versions of predefined component descriptors if the tags cannot be listed", func() {
+		cd := &cdv2.ComponentDescriptor{}
+		cd.Name = "example.com/a"
+		cd.Version = "v1.2.0"
+		Expect(cdv2.InjectRepositoryContext(cd, repoCtx)).To(Succeed())
+
+		registry, err := componentsregistry.NewOCIRegistryWithOCIClient(logging.Discard(), mock_oci.NewMockClient(ctrl), cd)
+		Expect(err).ToNot(HaveOccurred())
+
+		versions, err := registry.(componentsregistry.ComponentVersionLister).ListComponentVersions(ctx, repoCtx, "example.com/a")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(versions).To(ConsistOf("v1.2.0"))
+
+		_, err = registry.(componentsregistry.ComponentVersionLister).ListComponentVersions(ctx, repoCtx, "example.com/b")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should list the versions of the component descriptors in a local registry", func() {
+		rootPath, err := os.MkdirTemp("", "local-registry")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(rootPath)
+
+		for _, component := range []struct{ name, version string }{
+			{"example.com/a", "v1.0.0"},
+			{"example.com/a", "v2.0.0"},
+			{"example.com/b", "v3.0.0"},
+		} {
+			dir := filepath.Join(rootPath, filepath.Base(component.name), component.version)
+			Expect(os.MkdirAll(dir, os.ModePerm)).To(Succeed())
+			data := fmt.Sprintf("meta:\n  schemaVersion: v2\ncomponent:\n  name: %s\n  version: %s\n  provider: internal\n"+
+				"  repositoryContexts: []\n  sources: []\n  componentReferences: []\n  resources: []\n", component.name, component.version)
+			Expect(os.WriteFile(filepath.Join(dir, ctf.ComponentDescriptorFileName), []byte(data), os.ModePerm)).To(Succeed())
+		}
+
+		registry, err := componentsregistry.NewLocalClient(rootPath)
+		Expect(err).ToNot(HaveOccurred())
+
+		versions, err := registry.(componentsregistry.ComponentVersionLister).ListComponentVersions(ctx,
+			componentsregistry.NewLocalRepository(rootPath), "example.com/a")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(versions).To(ConsistOf("v1.0.0", "v2.0.0"))
+	})
+
+})
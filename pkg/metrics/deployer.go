@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+)
+
+const (
+	// ReconcileOperation is the value of the operation label for reconciles of deploy items by a deployer.
+	ReconcileOperation = "reconcile"
+	// DeleteOperation is the value of the operation label for deletions of deploy items by a deployer.
+	DeleteOperation = "delete"
+)
+
+var (
+	// DeployerOperationDuration discloses the duration of the reconcile and delete operations
+	// of the deployers per deploy item type.
+	DeployerOperationDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: lifecycleNamespaceName,
+			Name:      "deployer_operation_duration_seconds",
+			Help:      "Duration of the reconcile and delete operations of the deployers per deploy item type.",
+			Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 300},
+		},
+		[]string{"type", "operation"},
+	)
+
+	// DeployerFailures discloses the number of failed reconcile and delete operations of the deployers
+	// per deploy item type and error code.
+	DeployerFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lifecycleNamespaceName,
+			Name:      "deployer_failures_total",
+			Help:      "Number of failed reconcile and delete operations of the deployers per deploy item type and error code.",
+		},
+		[]string{"type", "code"},
+	)
+)
+
+// RegisterDeployerMetrics allows to register the metrics of the deployer library with a given prometheus registerer.
+func RegisterDeployerMetrics(reg prometheus.Registerer) {
+	reg.MustRegister(DeployerOperationDuration)
+	reg.MustRegister(DeployerFailures)
+}
+
+// ObserveDeployerOperation records the duration of a reconcile or delete operation of a deployer that started
+// at the given time. If the operation has failed, the failure is counted with every error code of the error.
+func ObserveDeployerOperation(deployItemType lsv1alpha1.DeployItemType, operation string, start time.Time, err lserrors.LsError) {
+	DeployerOperationDuration.WithLabelValues(string(deployItemType), operation).Observe(time.Since(start).Seconds())
+	if err == nil {
+		return
+	}
+
+	codes := err.LandscaperError().Codes
+	if len(codes) == 0 {
+		DeployerFailures.WithLabelValues(string(deployItemType), noErrorCode).Inc()
+		return
+	}
+	for _, code := range codes {
+		DeployerFailures.WithLabelValues(string(deployItemType), string(code)).Inc()
+	}
+}
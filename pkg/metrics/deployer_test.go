@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+)
+
+var _ = Describe("Deployer Metrics", func() {
+
+	counterValue := func(counter prometheus.Counter) float64 {
+		m := &dto.Metric{}
+		Expect(counter.Write(m)).To(Succeed())
+		return m.GetCounter().GetValue()
+	}
+
+	sampleCount := func(deployItemType, operation string) uint64 {
+		m := &dto.Metric{}
+		observer, err := DeployerOperationDuration.GetMetricWithLabelValues(deployItemType, operation)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(observer.(prometheus.Histogram).Write(m)).To(Succeed())
+		return m.GetHistogram().GetSampleCount()
+	}
+
+	It("should record the duration of deployer operations and count failures per error code", func() {
+		const deployItemType = "test-deployer"
+
+		ObserveDeployerOperation(deployItemType, ReconcileOperation, time.Now(), nil)
+		Expect(sampleCount(deployItemType, ReconcileOperation)).To(Equal(uint64(1)))
+		Expect(counterValue(DeployerFailures.WithLabelValues(deployItemType, noErrorCode))).To(Equal(0.0))
+
+		err := lserrors.NewWrappedError(fmt.Errorf("test"), "Reconcile", "Apply", "failed",
+			lsv1alpha1.ErrorTimeout, lsv1alpha1.ErrorConfigurationProblem)
+		ObserveDeployerOperation(deployItemType, DeleteOperation, time.Now(), err)
+		Expect(sampleCount(deployItemType, DeleteOperation)).To(Equal(uint64(1)))
+		Expect(counterValue(DeployerFailures.WithLabelValues(deployItemType, string(lsv1alpha1.ErrorTimeout)))).To(Equal(1.0))
+		Expect(counterValue(DeployerFailures.WithLabelValues(deployItemType, string(lsv1alpha1.ErrorConfigurationProblem)))).To(Equal(1.0))
+
+		ObserveDeployerOperation(deployItemType, ReconcileOperation, time.Now(), lserrors.NewError("Reconcile", "Apply", "failed"))
+		Expect(counterValue(DeployerFailures.WithLabelValues(deployItemType, noErrorCode))).To(Equal(1.0))
+	})
+
+})
@@ -0,0 +1,222 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+const (
+	lifecycleNamespaceName = "landscaper"
+
+	// InstallationKind is the value of the kind and controller labels for installations.
+	InstallationKind = "installation"
+	// ExecutionKind is the value of the kind and controller labels for executions.
+	ExecutionKind = "execution"
+	// DeployItemKind is the value of the kind and controller labels for deploy items.
+	DeployItemKind = "deployitem"
+
+	// PickupTimeout is the value of the timeout label for pickup timeouts of deploy items.
+	PickupTimeout = "pickup"
+	// AbortingTimeout is the value of the timeout label for aborting timeouts of deploy items.
+	AbortingTimeout = "aborting"
+	// ProgressingTimeout is the value of the timeout label for progressing timeouts of deploy items.
+	ProgressingTimeout = "progressing"
+
+	// noErrorCode is the value of the code label for failures without an error code.
+	noErrorCode = "none"
+)
+
+var (
+	// InstallationPhases discloses the number of installations per phase.
+	InstallationPhases = newPhaseTracker(prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: lifecycleNamespaceName,
+			Name:      "installations",
+			Help:      "Number of installations per phase.",
+		},
+		[]string{"phase"},
+	), InstallationKind)
+
+	// ExecutionPhases discloses the number of executions per phase.
+	ExecutionPhases = newPhaseTracker(prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: lifecycleNamespaceName,
+			Name:      "executions",
+			Help:      "Number of executions per phase.",
+		},
+		[]string{"phase"},
+	), ExecutionKind)
+
+	// DeployItemPhases discloses the number of deploy items per phase and type.
+	DeployItemPhases = newPhaseTracker(prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: lifecycleNamespaceName,
+			Name:      "deployitems",
+			Help:      "Number of deploy items per phase and type.",
+		},
+		[]string{"phase", "type"},
+	), DeployItemKind)
+
+	// PendingJobs discloses the number of objects per kind whose current job has not been finished.
+	PendingJobs = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: lifecycleNamespaceName,
+			Name:      "pending_jobs",
+			Help:      "Number of objects per kind whose job id differs from their finished job id.",
+		},
+		[]string{"kind"},
+	)
+
+	// ReconcileDuration discloses the duration of reconciles per controller and deploy item type.
+	ReconcileDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: lifecycleNamespaceName,
+			Name:      "reconcile_duration_seconds",
+			Help:      "Duration of reconciles per controller and deploy item type.",
+			Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+		},
+		[]string{"controller", "type"},
+	)
+
+	// Failures discloses the number of failed jobs per kind and error code.
+	Failures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lifecycleNamespaceName,
+			Name:      "failures_total",
+			Help:      "Number of failed jobs per kind and error code.",
+		},
+		[]string{"kind", "code"},
+	)
+
+	// DeployItemTimeouts discloses the number of deploy item timeouts detected by the deploy item controller.
+	DeployItemTimeouts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lifecycleNamespaceName,
+			Name:      "deployitem_timeouts_total",
+			Help:      "Number of pickup, aborting and progressing timeouts of deploy items.",
+		},
+		[]string{"timeout"},
+	)
+
+	// DeployItemAborts discloses the number of deploy items that were aborted by the deploy item controller.
+	DeployItemAborts = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: lifecycleNamespaceName,
+			Name:      "deployitem_aborts_total",
+			Help:      "Number of deploy items that have been aborted by the landscaper.",
+		},
+	)
+)
+
+// RegisterLifecycleMetrics allows to register the installation, execution and deploy item metrics
+// with a given prometheus registerer.
+func RegisterLifecycleMetrics(reg prometheus.Registerer) {
+	reg.MustRegister(InstallationPhases.gauge)
+	reg.MustRegister(ExecutionPhases.gauge)
+	reg.MustRegister(DeployItemPhases.gauge)
+	reg.MustRegister(PendingJobs)
+	reg.MustRegister(ReconcileDuration)
+	reg.MustRegister(Failures)
+	reg.MustRegister(DeployItemTimeouts)
+	reg.MustRegister(DeployItemAborts)
+}
+
+// ObserveReconcileDuration records the duration of a reconcile that started at the given time.
+func ObserveReconcileDuration(controller, deployItemType string, start time.Time) {
+	ReconcileDuration.WithLabelValues(controller, deployItemType).Observe(time.Since(start).Seconds())
+}
+
+// RecordFailure counts a failed job with every given error code.
+func RecordFailure(kind string, codes ...lsv1alpha1.ErrorCode) {
+	if len(codes) == 0 {
+		Failures.WithLabelValues(kind, noErrorCode).Inc()
+		return
+	}
+	for _, code := range codes {
+		Failures.WithLabelValues(kind, string(code)).Inc()
+	}
+}
+
+// phaseTracker remembers the last observed phase of every object, so that the phase gauge
+// can be updated when the phase of an object changes or the object is removed.
+type phaseTracker struct {
+	gauge *prometheus.GaugeVec
+	kind  string
+
+	mux     sync.Mutex
+	labels  map[types.NamespacedName][]string
+	pending map[types.NamespacedName]bool
+}
+
+func newPhaseTracker(gauge *prometheus.GaugeVec, kind string) *phaseTracker {
+	return &phaseTracker{
+		gauge:   gauge,
+		kind:    kind,
+		labels:  map[types.NamespacedName][]string{},
+		pending: map[types.NamespacedName]bool{},
+	}
+}
+
+// Observe records the current phase and job state of an object.
+// The label values are the phase followed by the additional labels of the gauge.
+func (t *phaseTracker) Observe(key types.NamespacedName, pending bool, labelValues ...string) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	if old, ok := t.labels[key]; ok {
+		t.gauge.WithLabelValues(old...).Dec()
+	}
+	t.labels[key] = labelValues
+	t.gauge.WithLabelValues(labelValues...).Inc()
+
+	if t.pending[key] != pending {
+		if pending {
+			PendingJobs.WithLabelValues(t.kind).Inc()
+		} else {
+			PendingJobs.WithLabelValues(t.kind).Dec()
+		}
+	}
+	t.pending[key] = pending
+}
+
+// Forget removes an object that does not exist anymore.
+func (t *phaseTracker) Forget(key types.NamespacedName) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	if old, ok := t.labels[key]; ok {
+		t.gauge.WithLabelValues(old...).Dec()
+		delete(t.labels, key)
+	}
+	if t.pending[key] {
+		PendingJobs.WithLabelValues(t.kind).Dec()
+	}
+	delete(t.pending, key)
+}
+
+// ObserveInstallation records the phase and job state of an installation.
+func ObserveInstallation(inst *lsv1alpha1.Installation) {
+	InstallationPhases.Observe(types.NamespacedName{Name: inst.Name, Namespace: inst.Namespace},
+		inst.Status.JobID != inst.Status.JobIDFinished, string(inst.Status.InstallationPhase))
+}
+
+// ObserveExecution records the phase and job state of an execution.
+func ObserveExecution(exec *lsv1alpha1.Execution) {
+	ExecutionPhases.Observe(types.NamespacedName{Name: exec.Name, Namespace: exec.Namespace},
+		exec.Status.JobID != exec.Status.JobIDFinished, string(exec.Status.ExecutionPhase))
+}
+
+// ObserveDeployItem records the phase and job state of a deploy item.
+func ObserveDeployItem(di *lsv1alpha1.DeployItem) {
+	DeployItemPhases.Observe(types.NamespacedName{Name: di.Name, Namespace: di.Namespace},
+		di.Status.GetJobID() != di.Status.JobIDFinished, string(di.Status.DeployItemPhase), string(di.Spec.Type))
+}
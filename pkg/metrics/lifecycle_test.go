@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Lifecycle Metrics", func() {
+
+	gaugeValue := func(gauge prometheus.Gauge) float64 {
+		m := &dto.Metric{}
+		Expect(gauge.Write(m)).To(Succeed())
+		return m.GetGauge().GetValue()
+	}
+
+	It("should count the objects per phase and move them between phases", func() {
+		tracker := newPhaseTracker(prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "test_phases"}, []string{"phase"}), "test")
+		pending := PendingJobs.WithLabelValues("test")
+
+		a := types.NamespacedName{Name: "a", Namespace: "default"}
+		b := types.NamespacedName{Name: "b", Namespace: "default"}
+
+		tracker.Observe(a, true, "Progressing")
+		tracker.Observe(b, true, "Progressing")
+		Expect(gaugeValue(tracker.gauge.WithLabelValues("Progressing"))).To(Equal(2.0))
+		Expect(gaugeValue(pending)).To(Equal(2.0))
+
+		tracker.Observe(a, false, "Succeeded")
+		Expect(gaugeValue(tracker.gauge.WithLabelValues("Progressing"))).To(Equal(1.0))
+		Expect(gaugeValue(tracker.gauge.WithLabelValues("Succeeded"))).To(Equal(1.0))
+		Expect(gaugeValue(pending)).To(Equal(1.0))
+
+		tracker.Forget(b)
+		tracker.Forget(b)
+		Expect(gaugeValue(tracker.gauge.WithLabelValues("Progressing"))).To(Equal(0.0))
+		Expect(gaugeValue(tracker.gauge.WithLabelValues("Succeeded"))).To(Equal(1.0))
+		Expect(gaugeValue(pending)).To(Equal(0.0))
+	})
+
+})
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// JSONChange describes a difference between the json representations of two objects.
+type JSONChange struct {
+	// Path is the path of the changed field, e.g. "config.values[0].name".
+	// It is empty if the whole object differs.
+	Path string
+	// Old is the old value of the field. It is nil if the field has been added.
+	Old json.RawMessage
+	// New is the new value of the field. It is nil if the field has been removed.
+	New json.RawMessage
+}
+
+// ComputeJSONChanges computes the differences between the json representations of two objects.
+// Objects are compared field by field, lists are compared element by element.
+// The changes are sorted by their path.
+func ComputeJSONChanges(oldObj, newObj interface{}) ([]JSONChange, error) {
+	oldVal, err := toGenericJSON(oldObj)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert old object: %w", err)
+	}
+	newVal, err := toGenericJSON(newObj)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert new object: %w", err)
+	}
+
+	changes := []JSONChange{}
+	if err := collectJSONChanges("", oldVal, newVal, &changes); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes, nil
+}
+
+func toGenericJSON(obj interface{}) (interface{}, error) {
+	if obj == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var res interface{}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func collectJSONChanges(path string, oldVal, newVal interface{}, changes *[]JSONChange) error {
+	switch o := oldVal.(type) {
+	case map[string]interface{}:
+		if n, ok := newVal.(map[string]interface{}); ok {
+			keys := make([]string, 0, len(o)+len(n))
+			for key := range o {
+				keys = append(keys, key)
+			}
+			for key := range n {
+				if _, ok := o[key]; !ok {
+					keys = append(keys, key)
+				}
+			}
+			for _, key := range keys {
+				if err := collectJSONChanges(joinJSONPath(path, key), o[key], n[key], changes); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	case []interface{}:
+		if n, ok := newVal.([]interface{}); ok {
+			for i := 0; i < len(o) || i < len(n); i++ {
+				var oldElem, newElem interface{}
+				if i < len(o) {
+					oldElem = o[i]
+				}
+				if i < len(n) {
+					newElem = n[i]
+				}
+				if err := collectJSONChanges(fmt.Sprintf("%s[%d]", path, i), oldElem, newElem, changes); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	if reflect.DeepEqual(oldVal, newVal) {
+		return nil
+	}
+
+	change := JSONChange{Path: path}
+	if oldVal != nil {
+		data, err := json.Marshal(oldVal)
+		if err != nil {
+			return err
+		}
+		change.Old = data
+	}
+	if newVal != nil {
+		data, err := json.Marshal(newVal)
+		if err != nil {
+			return err
+		}
+		change.New = data
+	}
+	*changes = append(*changes, change)
+	return nil
+}
+
+func joinJSONPath(path, key string) string {
+	if len(path) == 0 {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsutils "github.com/gardener/landscaper/pkg/utils"
+)
+
+var _ = Describe("JSON Changes", func() {
+
+	It("should return no changes for equal objects", func() {
+		obj := map[string]interface{}{
+			"a": "b",
+			"c": []interface{}{1, 2},
+		}
+		changes, err := lsutils.ComputeJSONChanges(obj, obj)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+	})
+
+	It("should return changed, added and removed fields sorted by their path", func() {
+		oldObj := map[string]interface{}{
+			"name": "a",
+			"config": map[string]interface{}{
+				"replicas": 1,
+				"removed":  true,
+			},
+		}
+		newObj := map[string]interface{}{
+			"name": "a",
+			"config": map[string]interface{}{
+				"replicas": 3,
+				"added":    "x",
+			},
+		}
+		changes, err := lsutils.ComputeJSONChanges(oldObj, newObj)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(Equal([]lsutils.JSONChange{
+			{Path: "config.added", New: json.RawMessage(`"x"`)},
+			{Path: "config.removed", Old: json.RawMessage(`true`)},
+			{Path: "config.replicas", Old: json.RawMessage(`1`), New: json.RawMessage(`3`)},
+		}))
+	})
+
+	It("should compare lists element by element", func() {
+		oldObj := map[string]interface{}{
+			"items": []interface{}{"a", "b"},
+		}
+		newObj := map[string]interface{}{
+			"items": []interface{}{"a", "c", "d"},
+		}
+		changes, err := lsutils.ComputeJSONChanges(oldObj, newObj)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(Equal([]lsutils.JSONChange{
+			{Path: "items[1]", Old: json.RawMessage(`"b"`), New: json.RawMessage(`"c"`)},
+			{Path: "items[2]", New: json.RawMessage(`"d"`)},
+		}))
+	})
+
+	It("should report a type change of a field as a single change", func() {
+		oldObj := map[string]interface{}{
+			"value": map[string]interface{}{"a": 1},
+		}
+		newObj := map[string]interface{}{
+			"value": "a",
+		}
+		changes, err := lsutils.ComputeJSONChanges(oldObj, newObj)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(Equal([]lsutils.JSONChange{
+			{Path: "value", Old: json.RawMessage(`{"a":1}`), New: json.RawMessage(`"a"`)},
+		}))
+	})
+
+})
@@ -24,6 +24,7 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	lsimports "github.com/gardener/landscaper/pkg/landscaper/installations/imports"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/subinstallations"
 	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
 )
@@ -351,7 +352,7 @@ func (r *BlueprintRenderer) renderSubInstallations(input *ResolvedInstallation,
 	return subInstallations, templateStateHandler, nil
 }
 
-// validateImports validates the imports with the JSON schemas defined in the blueprint
+// validateImports validates the imports with the JSON schemas and validation rules defined in the blueprint
 func (r *BlueprintRenderer) validateImports(input *ResolvedInstallation, imports map[string]interface{}) error {
 
 	validatorConfig := &jsonschema.ReferenceContext{
@@ -389,6 +390,14 @@ func (r *BlueprintRenderer) validateImports(input *ResolvedInstallation, imports
 		default:
 			allErr = append(allErr, field.Invalid(fldPath, string(importDef.Type), "unknown import type"))
 		}
+
+		if err := lsimports.ValidateRules(fldPath.Child("validations"), importDef.Validations, lsimports.ImportValueVariable, value, false); err != nil {
+			allErr = append(allErr, field.Invalid(fldPath, value, err.Error()))
+		}
+	}
+
+	if err := lsimports.ValidateRules(field.NewPath("importValidations"), input.Blueprint.Info.ImportValidations, lsimports.ImportsVariable, imports, false); err != nil {
+		allErr = append(allErr, field.Forbidden(field.NewPath("importValidations"), err.Error()))
 	}
 
 	return allErr.ToAggregate()
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package read_write_layer
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// BlobStorage stores the data of large DataObjects in a blob store instead of the DataObject itself.
+type BlobStorage interface {
+	// Offload moves the data of the DataObject into the blob store if it is too large.
+	Offload(ctx context.Context, do *lsv1alpha1.DataObject) error
+	// Resolve reads the data of a DataObject with a blob reference from the blob store.
+	Resolve(ctx context.Context, do *lsv1alpha1.DataObject) error
+	// Cleanup removes the stored data of the DataObject that is not referenced anymore.
+	Cleanup(ctx context.Context, do *lsv1alpha1.DataObject) error
+}
+
+// defaultBlobStorage is the blob storage that is used to read and write DataObjects.
+// The data of DataObjects is always stored in the DataObject itself if no blob storage is set.
+var defaultBlobStorage BlobStorage
+
+// SetBlobStorage sets the blob storage that is used to read and write DataObjects.
+// It has to be called before the controllers are started.
+func SetBlobStorage(storage BlobStorage) {
+	defaultBlobStorage = storage
+}
+
+// resolveData reads the data of a DataObject from the blob store if it has been offloaded.
+func resolveData(ctx context.Context, do *lsv1alpha1.DataObject) error {
+	if do.BlobRef == nil {
+		return nil
+	}
+	if defaultBlobStorage == nil {
+		return fmt.Errorf("the data of DataObject %s/%s is stored in a blob store but no blob storage is configured", do.Namespace, do.Name)
+	}
+	return defaultBlobStorage.Resolve(ctx, do)
+}
+
+// dataObjectWrite offloads the data of a DataObject while it is written.
+type dataObjectWrite struct {
+	do   *lsv1alpha1.DataObject
+	data []byte
+}
+
+// mutate returns a mutate function that offloads the data of the DataObject after the given mutate function has been applied.
+// Sensitive DataObjects cannot be written without a blob storage as their data must not be stored in the DataObject.
+func (w *dataObjectWrite) mutate(ctx context.Context, f controllerutil.MutateFn) controllerutil.MutateFn {
+	return func() error {
+		if err := f(); err != nil {
+			return err
+		}
+		if defaultBlobStorage == nil {
+			if w.do.Sensitive {
+				return fmt.Errorf("the DataObject %s/%s is sensitive but no blob storage is configured", w.do.Namespace, w.do.Name)
+			}
+			return nil
+		}
+		w.data = w.do.Data.RawMessage
+		return defaultBlobStorage.Offload(ctx, w.do)
+	}
+}
+
+// finish removes data that is not referenced anymore from the blob store
+// and restores the offloaded data in the written DataObject.
+func (w *dataObjectWrite) finish(ctx context.Context) error {
+	if defaultBlobStorage == nil {
+		return nil
+	}
+	if err := defaultBlobStorage.Cleanup(ctx, w.do); err != nil {
+		return err
+	}
+	if len(w.do.Data.RawMessage) == 0 {
+		w.do.Data.RawMessage = w.data
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package read_write_layer_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects/blobstore"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+var _ = Describe("BlobStorage", func() {
+
+	var (
+		ctx    context.Context
+		c      client.Client
+		writer *read_write_layer.Writer
+		data   json.RawMessage
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		c = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+		storage, err := blobstore.New(100, lsv1alpha1.SecretDataObjectBlobStoreType, map[lsv1alpha1.DataObjectBlobStoreType]blobstore.Store{
+			lsv1alpha1.SecretDataObjectBlobStoreType: blobstore.NewSecretStore(c, 100),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		read_write_layer.SetBlobStorage(storage)
+		writer = read_write_layer.NewWriter(c)
+
+		data, err = json.Marshal(map[string]string{"key": strings.Repeat("a", 200)})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		read_write_layer.SetBlobStorage(nil)
+	})
+
+	It("should offload large data when a DataObject is written and resolve it when it is read", func() {
+		do := &lsv1alpha1.DataObject{}
+		do.Name = "my-do"
+		do.Namespace = "test"
+		_, err := writer.CreateOrUpdateDataObject(ctx, read_write_layer.W000075, do, func() error {
+			do.Data.RawMessage = data
+			return nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(do.BlobRef).ToNot(BeNil())
+		Expect(do.Data.RawMessage).To(Equal(data), "the written object should still contain the data")
+
+		stored := &lsv1alpha1.DataObject{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(do), stored)).To(Succeed())
+		Expect(stored.Data.RawMessage).To(BeEmpty())
+		Expect(stored.BlobRef).ToNot(BeNil())
+
+		resolved := &lsv1alpha1.DataObject{}
+		Expect(read_write_layer.GetDataObject(ctx, c, client.ObjectKeyFromObject(do), resolved)).To(Succeed())
+		Expect(resolved.Data.RawMessage).To(Equal(data))
+
+		list := &lsv1alpha1.DataObjectList{}
+		Expect(read_write_layer.ListDataObjects(ctx, c, list, client.InNamespace("test"))).To(Succeed())
+		Expect(list.Items).To(HaveLen(1))
+		Expect(list.Items[0].Data.RawMessage).To(Equal(data))
+
+		read_write_layer.SetBlobStorage(nil)
+		Expect(read_write_layer.GetDataObject(ctx, c, client.ObjectKeyFromObject(do), &lsv1alpha1.DataObject{})).ToNot(Succeed())
+	})
+
+	It("should not update an offloaded DataObject if its data did not change", func() {
+		do := &lsv1alpha1.DataObject{}
+		do.Name = "my-do"
+		do.Namespace = "test"
+		_, err := writer.CreateOrUpdateDataObject(ctx, read_write_layer.W000075, do, func() error {
+			do.Data.RawMessage = data
+			return nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+		resourceVersion := do.ResourceVersion
+
+		do = &lsv1alpha1.DataObject{}
+		do.Name = "my-do"
+		do.Namespace = "test"
+		_, err = writer.CreateOrUpdateDataObject(ctx, read_write_layer.W000075, do, func() error {
+			do.Data.RawMessage = data
+			return nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(do.ResourceVersion).To(Equal(resourceVersion))
+	})
+
+	It("should not write a sensitive DataObject if no blob storage is configured", func() {
+		read_write_layer.SetBlobStorage(nil)
+		do := &lsv1alpha1.DataObject{}
+		do.Name = "my-do"
+		do.Namespace = "test"
+		_, err := writer.CreateOrUpdateDataObject(ctx, read_write_layer.W000075, do, func() error {
+			do.Data.RawMessage = data
+			do.Sensitive = true
+			return nil
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(do), &lsv1alpha1.DataObject{})).ToNot(Succeed())
+	})
+})
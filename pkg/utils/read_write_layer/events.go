@@ -0,0 +1,261 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package read_write_layer
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/golang/groupcache/lru"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+)
+
+const (
+	// EventReasonError is the reason of error events whose error has no error code.
+	EventReasonError = "Error"
+	// EventReasonWriteFailed is the reason of events for failed write operations whose error has no error code.
+	EventReasonWriteFailed = "WriteFailed"
+	// EventReasonAbort is the reason of events for objects that got the abort operation annotation.
+	EventReasonAbort = "Abort"
+	// EventReasonInterrupt is the reason of events for objects that got the interrupt operation annotation.
+	EventReasonInterrupt = "Interrupt"
+	// EventReasonSyncFailed is the reason of events for target syncs whose last sync failed.
+	EventReasonSyncFailed = "SyncFailed"
+	// EventReasonSynced is the reason of events for target syncs that have been synced again after a failure.
+	EventReasonSynced = "Synced"
+)
+
+// defaultEventRecorder is the event recorder of all writers that are created with NewWriter.
+var defaultEventRecorder record.EventRecorder
+
+// SetEventRecorder sets the event recorder that is used by all writers created with NewWriter.
+// It has to be called before the controllers are started.
+func SetEventRecorder(recorder record.EventRecorder) {
+	defaultEventRecorder = recorder
+}
+
+// EventReason returns the reason of an event for an error with the given error codes.
+// The reason is derived from the first error code, e.g. ERR_READINESS_CHECK_TIMEOUT results in ReadinessCheckTimeout.
+func EventReason(codes []lsv1alpha1.ErrorCode, defaultReason string) string {
+	if len(codes) == 0 {
+		return defaultReason
+	}
+
+	words := strings.Split(strings.TrimPrefix(string(codes[0]), "ERR_"), "_")
+	reason := strings.Builder{}
+	for _, word := range words {
+		if len(word) == 0 {
+			continue
+		}
+		reason.WriteString(word[:1])
+		reason.WriteString(strings.ToLower(word[1:]))
+	}
+	return reason.String()
+}
+
+// objectState is the part of an object that is relevant for events.
+type objectState struct {
+	resourceVersion string
+	phase           string
+	failed          bool
+	lastError       *lsv1alpha1.Error
+	operation       lsv1alpha1.Operation
+	syncErrors      []string
+}
+
+// maxObjectStates is the maximal number of object states that are remembered.
+// If more objects are read or written, the states of the least recently used objects are dropped.
+const maxObjectStates = 10000
+
+// objectStates remembers the state of the objects after their last read or write,
+// so that the previous state is known without reading the object again before the next write.
+var objectStates = newObjectStateCache(maxObjectStates)
+
+// objectStateCache is a thread-safe least recently used cache of object states keyed by the uids of the objects.
+type objectStateCache struct {
+	lock  sync.Mutex
+	cache *lru.Cache
+}
+
+func newObjectStateCache(capacity int) *objectStateCache {
+	return &objectStateCache{cache: lru.New(capacity)}
+}
+
+func (c *objectStateCache) load(uid types.UID) (objectState, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	value, ok := c.cache.Get(uid)
+	if !ok {
+		return objectState{}, false
+	}
+	return value.(objectState), true
+}
+
+func (c *objectStateCache) store(uid types.UID, state objectState) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cache.Add(uid, state)
+}
+
+func (c *objectStateCache) remove(uid types.UID) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.cache.Remove(uid)
+}
+
+func (c *objectStateCache) len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.cache.Len()
+}
+
+func getObjectState(obj client.Object) (objectState, bool) {
+	state := objectState{resourceVersion: obj.GetResourceVersion()}
+
+	switch o := obj.(type) {
+	case *lsv1alpha1.Installation:
+		state.phase = string(o.Status.InstallationPhase)
+		state.failed = o.Status.InstallationPhase == lsv1alpha1.InstallationPhaseFailed ||
+			o.Status.InstallationPhase == lsv1alpha1.InstallationPhaseDeleteFailed
+		state.lastError = o.Status.LastError.DeepCopy()
+		state.operation = lsv1alpha1.Operation(lsv1alpha1helper.GetOperation(o.ObjectMeta))
+	case *lsv1alpha1.Execution:
+		state.phase = string(o.Status.ExecutionPhase)
+		state.failed = o.Status.ExecutionPhase == lsv1alpha1.ExecPhaseFailed ||
+			o.Status.ExecutionPhase == lsv1alpha1.ExecPhaseDeleteFailed
+		state.lastError = o.Status.LastError.DeepCopy()
+		state.operation = lsv1alpha1.Operation(lsv1alpha1helper.GetOperation(o.ObjectMeta))
+	case *lsv1alpha1.DeployItem:
+		state.phase = string(o.Status.DeployItemPhase)
+		state.failed = o.Status.DeployItemPhase == lsv1alpha1.DeployItemPhaseFailed
+		state.lastError = o.Status.GetLastError().DeepCopy()
+		state.operation = lsv1alpha1.Operation(lsv1alpha1helper.GetOperation(o.ObjectMeta))
+	case *lsv1alpha1.TargetSync:
+		state.syncErrors = append([]string(nil), o.Status.LastErrors...)
+	default:
+		return state, false
+	}
+
+	return state, true
+}
+
+// rememberObjectState remembers the state of an object that has been read,
+// so that it is known as previous state when the object is written.
+func rememberObjectState(obj client.Object) {
+	if len(obj.GetUID()) == 0 {
+		return
+	}
+	if state, ok := getObjectState(obj); ok {
+		objectStates.store(obj.GetUID(), state)
+	}
+}
+
+// getPreviousState returns the state of the object before the write operation.
+// The state of the last read or write is used if the object has not been modified since then.
+// Otherwise, the previous state is unknown and no events for state transitions are emitted.
+func (w *Writer) getPreviousState(obj client.Object) *objectState {
+	if w.eventRecorder == nil || len(obj.GetUID()) == 0 {
+		return nil
+	}
+
+	state, ok := objectStates.load(obj.GetUID())
+	if !ok || state.resourceVersion != obj.GetResourceVersion() {
+		return nil
+	}
+	return &state
+}
+
+// recordEvents emits the events for the differences between the previous and the written state of an object.
+func (w *Writer) recordEvents(obj client.Object, previous *objectState, err error) {
+	if w.eventRecorder == nil || len(obj.GetUID()) == 0 {
+		return
+	}
+
+	if err != nil {
+		if !apierrors.IsConflict(err) && !apierrors.IsNotFound(err) {
+			w.eventRecorder.Event(obj, corev1.EventTypeWarning,
+				EventReason(lserrors.CollectErrorCodes(err), EventReasonWriteFailed), err.Error())
+		}
+		return
+	}
+
+	current, ok := getObjectState(obj)
+	if !ok {
+		return
+	}
+
+	if obj.GetDeletionTimestamp() != nil && len(obj.GetFinalizers()) == 0 {
+		forgetObjectState(obj)
+	} else {
+		objectStates.store(obj.GetUID(), current)
+	}
+
+	if previous == nil {
+		return
+	}
+
+	if current.phase != previous.phase && len(current.phase) != 0 {
+		eventType := corev1.EventTypeNormal
+		if current.failed {
+			eventType = corev1.EventTypeWarning
+		}
+		w.eventRecorder.Eventf(obj, eventType, current.phase,
+			"phase changed from %q to %q", previous.phase, current.phase)
+	}
+
+	if current.lastError != nil && !isSameError(current.lastError, previous.lastError) {
+		w.eventRecorder.Eventf(obj, corev1.EventTypeWarning, EventReason(current.lastError.Codes, EventReasonError),
+			"%s: %s: %s", current.lastError.Operation, current.lastError.Reason, current.lastError.Message)
+	}
+
+	if current.operation != previous.operation {
+		switch current.operation {
+		case lsv1alpha1.AbortOperation:
+			w.eventRecorder.Event(obj, corev1.EventTypeWarning, EventReasonAbort, "abort operation has been requested")
+		case lsv1alpha1.InterruptOperation:
+			w.eventRecorder.Event(obj, corev1.EventTypeWarning, EventReasonInterrupt, "interrupt operation has been requested")
+		}
+	}
+
+	if !reflect.DeepEqual(current.syncErrors, previous.syncErrors) {
+		if len(current.syncErrors) != 0 {
+			w.eventRecorder.Event(obj, corev1.EventTypeWarning, EventReasonSyncFailed, strings.Join(current.syncErrors, "; "))
+		} else if len(previous.syncErrors) != 0 {
+			w.eventRecorder.Event(obj, corev1.EventTypeNormal, EventReasonSynced, "target sync succeeded")
+		}
+	}
+}
+
+// recordDeleteEvents emits an event if the deletion of an object failed and forgets the object otherwise.
+func (w *Writer) recordDeleteEvents(obj client.Object, err error) {
+	if err != nil {
+		w.recordEvents(obj, nil, err)
+		return
+	}
+	forgetObjectState(obj)
+}
+
+func forgetObjectState(obj client.Object) {
+	objectStates.remove(obj.GetUID())
+}
+
+func isSameError(err1, err2 *lsv1alpha1.Error) bool {
+	if err1 == nil || err2 == nil {
+		return err1 == err2
+	}
+	return err1.Operation == err2.Operation &&
+		err1.Reason == err2.Reason &&
+		err1.Message == err2.Message &&
+		reflect.DeepEqual(err1.Codes, err2.Codes)
+}
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package read_write_layer
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Object State Cache", func() {
+
+	It("should drop the states of the least recently used objects", func() {
+		cache := newObjectStateCache(2)
+		cache.store("a", objectState{resourceVersion: "1"})
+		cache.store("b", objectState{resourceVersion: "1"})
+		_, ok := cache.load("a")
+		Expect(ok).To(BeTrue())
+
+		cache.store("c", objectState{resourceVersion: "1"})
+		Expect(cache.len()).To(Equal(2))
+		_, ok = cache.load("b")
+		Expect(ok).To(BeFalse())
+		_, ok = cache.load("a")
+		Expect(ok).To(BeTrue())
+
+		cache.remove("a")
+		Expect(cache.len()).To(Equal(1))
+	})
+
+})
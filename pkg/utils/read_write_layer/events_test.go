@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package read_write_layer_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+var _ = Describe("Events", func() {
+
+	var (
+		ctx      context.Context
+		c        client.Client
+		recorder *record.FakeRecorder
+		writer   *read_write_layer.Writer
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		c = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+		recorder = record.NewFakeRecorder(1024)
+		writer = read_write_layer.NewWriterWithEventRecorder(c, recorder)
+	})
+
+	It("should derive the event reason from the error codes", func() {
+		Expect(read_write_layer.EventReason(nil, "Default")).To(Equal("Default"))
+		Expect(read_write_layer.EventReason([]lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout}, "Default")).To(Equal("Timeout"))
+		Expect(read_write_layer.EventReason([]lsv1alpha1.ErrorCode{
+			lsv1alpha1.ErrorReadinessCheckTimeout,
+			lsv1alpha1.ErrorTimeout,
+		}, "Default")).To(Equal("ReadinessCheckTimeout"))
+	})
+
+	It("should emit events for phase transitions and errors of installations", func() {
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = "inst"
+		inst.Namespace = "default"
+		inst.UID = "inst-uid"
+		Expect(c.Create(ctx, inst)).To(Succeed())
+		Expect(read_write_layer.GetInstallation(ctx, c, client.ObjectKeyFromObject(inst), inst)).To(Succeed())
+
+		inst.Status.InstallationPhase = lsv1alpha1.InstallationPhaseProgressing
+		Expect(writer.UpdateInstallationStatus(ctx, read_write_layer.W000001, inst)).To(Succeed())
+		Expect(recorder.Events).To(Receive(Equal(`Normal Progressing phase changed from "" to "Progressing"`)))
+
+		inst.Status.InstallationPhase = lsv1alpha1.InstallationPhaseFailed
+		inst.Status.LastError = lserrors.UpdatedError(nil, "op", "reason", "message", lsv1alpha1.ErrorTimeout)
+		Expect(writer.UpdateInstallationStatus(ctx, read_write_layer.W000001, inst)).To(Succeed())
+		Expect(recorder.Events).To(Receive(Equal(`Warning Failed phase changed from "Progressing" to "Failed"`)))
+		Expect(recorder.Events).To(Receive(Equal("Warning Timeout op: reason: message")))
+
+		// writing the same state again must not emit further events
+		Expect(writer.UpdateInstallationStatus(ctx, read_write_layer.W000001, inst)).To(Succeed())
+		Expect(recorder.Events).ToNot(Receive())
+	})
+
+	It("should take the previous state of objects that have been modified by others from the last read", func() {
+		di := &lsv1alpha1.DeployItem{}
+		di.Name = "di"
+		di.Namespace = "default"
+		di.UID = "di-uid"
+		di.Status.DeployItemPhase = lsv1alpha1.DeployItemPhaseProgressing
+		Expect(c.Create(ctx, di)).To(Succeed())
+		Expect(read_write_layer.GetDeployItem(ctx, c, client.ObjectKeyFromObject(di), di)).To(Succeed())
+
+		di.Status.DeployItemPhase = lsv1alpha1.DeployItemPhaseSucceeded
+		Expect(writer.UpdateDeployItemStatus(ctx, read_write_layer.W000001, di)).To(Succeed())
+		Expect(recorder.Events).To(Receive(Equal(`Normal Succeeded phase changed from "Progressing" to "Succeeded"`)))
+
+		modified := &lsv1alpha1.DeployItem{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(di), modified)).To(Succeed())
+		modified.Status.DeployItemPhase = lsv1alpha1.DeployItemPhaseInit
+		Expect(c.Status().Update(ctx, modified)).To(Succeed())
+
+		// the previous state of an object that has neither been read nor written is unknown
+		modified.Status.DeployItemPhase = lsv1alpha1.DeployItemPhaseFailed
+		Expect(writer.UpdateDeployItemStatus(ctx, read_write_layer.W000001, modified)).To(Succeed())
+		Expect(recorder.Events).ToNot(Receive())
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(di), modified)).To(Succeed())
+		modified.Status.DeployItemPhase = lsv1alpha1.DeployItemPhaseInit
+		Expect(c.Status().Update(ctx, modified)).To(Succeed())
+		Expect(read_write_layer.GetDeployItem(ctx, c, client.ObjectKeyFromObject(di), modified)).To(Succeed())
+
+		modified.Status.DeployItemPhase = lsv1alpha1.DeployItemPhaseFailed
+		Expect(writer.UpdateDeployItemStatus(ctx, read_write_layer.W000001, modified)).To(Succeed())
+		Expect(recorder.Events).To(Receive(Equal(`Warning Failed phase changed from "Init" to "Failed"`)))
+	})
+
+	It("should emit events for abort and interrupt operations", func() {
+		di := &lsv1alpha1.DeployItem{}
+		di.Name = "di"
+		di.Namespace = "default"
+		di.UID = "di-abort-uid"
+		Expect(c.Create(ctx, di)).To(Succeed())
+		Expect(read_write_layer.GetDeployItem(ctx, c, client.ObjectKeyFromObject(di), di)).To(Succeed())
+
+		lsv1alpha1helper.SetAbortOperationAndTimestamp(&di.ObjectMeta)
+		Expect(writer.UpdateDeployItem(ctx, read_write_layer.W000001, di)).To(Succeed())
+		Expect(recorder.Events).To(Receive(Equal("Warning Abort abort operation has been requested")))
+
+		exec := &lsv1alpha1.Execution{}
+		exec.Name = "exec"
+		exec.Namespace = "default"
+		exec.UID = "exec-uid"
+		Expect(c.Create(ctx, exec)).To(Succeed())
+		Expect(read_write_layer.GetExecution(ctx, c, client.ObjectKeyFromObject(exec), exec)).To(Succeed())
+
+		lsv1alpha1helper.SetOperation(&exec.ObjectMeta, lsv1alpha1.InterruptOperation)
+		Expect(writer.UpdateExecution(ctx, read_write_layer.W000001, exec)).To(Succeed())
+		Expect(recorder.Events).To(Receive(Equal("Warning Interrupt interrupt operation has been requested")))
+	})
+
+	It("should emit events for failed and recovered target syncs", func() {
+		ts := &lsv1alpha1.TargetSync{}
+		ts.Name = "sync"
+		ts.Namespace = "default"
+		ts.UID = "sync-uid"
+		Expect(c.Create(ctx, ts)).To(Succeed())
+		Expect(read_write_layer.GetTargetSync(ctx, c, client.ObjectKeyFromObject(ts), ts)).To(Succeed())
+
+		now := metav1.Now()
+		ts.Status.LastErrors = []string{"error1", "error2"}
+		ts.Status.LastUpdateTime = &now
+		Expect(writer.UpdateTargetSyncStatus(ctx, read_write_layer.W000001, ts)).To(Succeed())
+		Expect(recorder.Events).To(Receive(Equal("Warning SyncFailed error1; error2")))
+
+		ts.Status.LastErrors = nil
+		Expect(writer.UpdateTargetSyncStatus(ctx, read_write_layer.W000001, ts)).To(Succeed())
+		Expect(recorder.Events).To(Receive(Equal("Normal Synced target sync succeeded")))
+	})
+
+	It("should not emit events without an event recorder", func() {
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = "inst"
+		inst.Namespace = "default"
+		inst.UID = "inst-no-recorder-uid"
+		Expect(c.Create(ctx, inst)).To(Succeed())
+
+		inst.Status.InstallationPhase = lsv1alpha1.InstallationPhaseProgressing
+		Expect(read_write_layer.NewWriterWithEventRecorder(c, nil).UpdateInstallationStatus(ctx, read_write_layer.W000001, inst)).To(Succeed())
+		Expect(recorder.Events).ToNot(Receive())
+	})
+
+})
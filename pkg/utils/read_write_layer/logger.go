@@ -71,6 +71,78 @@ func (w *Writer) logTargetUpdate(ctx context.Context, writeID WriteID, msg strin
 	}
 }
 
+func (w *Writer) logTargetSyncUpdate(ctx context.Context, writeID WriteID, msg string, targetSync *lsv1alpha1.TargetSync,
+	generationOld int64, resourceVersionOld string, err error) {
+	if err == nil {
+		generationNew, resourceVersionNew := getGenerationAndResourceVersion(targetSync)
+		w.getLogger(ctx,
+			lc.KeyResource, fmt.Sprintf("%s/%s", targetSync.Namespace, targetSync.Name),
+		).Log(historyLogLevel, msg,
+			lc.KeyWriteID, writeID,
+			lc.KeyGenerationOld, generationOld,
+			lc.KeyGenerationNew, generationNew,
+			lc.KeyResourceVersionOld, resourceVersionOld,
+			lc.KeyResourceVersionNew, resourceVersionNew,
+		)
+	} else {
+		w.getLogger(ctx,
+			lc.KeyResource, fmt.Sprintf("%s/%s", targetSync.Namespace, targetSync.Name),
+		).Error(err, msg,
+			lc.KeyWriteID, writeID,
+			lc.KeyGenerationOld, generationOld,
+			lc.KeyResourceVersionOld, resourceVersionOld,
+		)
+	}
+}
+
+func (w *Writer) logComponentVersionOverwritesUpdate(ctx context.Context, writeID WriteID, msg string, cvo *lsv1alpha1.ComponentVersionOverwrites,
+	generationOld int64, resourceVersionOld string, err error) {
+	if err == nil {
+		generationNew, resourceVersionNew := getGenerationAndResourceVersion(cvo)
+		w.getLogger(ctx,
+			lc.KeyResource, fmt.Sprintf("%s/%s", cvo.Namespace, cvo.Name),
+		).Log(historyLogLevel, msg,
+			lc.KeyWriteID, writeID,
+			lc.KeyGenerationOld, generationOld,
+			lc.KeyGenerationNew, generationNew,
+			lc.KeyResourceVersionOld, resourceVersionOld,
+			lc.KeyResourceVersionNew, resourceVersionNew,
+		)
+	} else {
+		w.getLogger(ctx,
+			lc.KeyResource, fmt.Sprintf("%s/%s", cvo.Namespace, cvo.Name),
+		).Error(err, msg,
+			lc.KeyWriteID, writeID,
+			lc.KeyGenerationOld, generationOld,
+			lc.KeyResourceVersionOld, resourceVersionOld,
+		)
+	}
+}
+
+func (w *Writer) logOrphanReportUpdate(ctx context.Context, writeID WriteID, msg string, report *lsv1alpha1.OrphanReport,
+	generationOld int64, resourceVersionOld string, err error) {
+	if err == nil {
+		generationNew, resourceVersionNew := getGenerationAndResourceVersion(report)
+		w.getLogger(ctx,
+			lc.KeyResource, fmt.Sprintf("%s/%s", report.Namespace, report.Name),
+		).Log(historyLogLevel, msg,
+			lc.KeyWriteID, writeID,
+			lc.KeyGenerationOld, generationOld,
+			lc.KeyGenerationNew, generationNew,
+			lc.KeyResourceVersionOld, resourceVersionOld,
+			lc.KeyResourceVersionNew, resourceVersionNew,
+		)
+	} else {
+		w.getLogger(ctx,
+			lc.KeyResource, fmt.Sprintf("%s/%s", report.Namespace, report.Name),
+		).Error(err, msg,
+			lc.KeyWriteID, writeID,
+			lc.KeyGenerationOld, generationOld,
+			lc.KeyResourceVersionOld, resourceVersionOld,
+		)
+	}
+}
+
 func (w *Writer) logDataObjectUpdate(ctx context.Context, writeID WriteID, msg string, do *lsv1alpha1.DataObject,
 	generationOld int64, resourceVersionOld string, err error) {
 	if err == nil {
@@ -3,6 +3,9 @@ package read_write_layer
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -37,11 +40,51 @@ func ListDeployItems(ctx context.Context, c client.Reader, deployItems *lsv1alph
 	return list(ctx, c, deployItems, opts...)
 }
 
+// read methods for target syncs
+func GetTargetSync(ctx context.Context, c client.Reader, key client.ObjectKey, targetSync *lsv1alpha1.TargetSync) error {
+	return get(ctx, c, key, targetSync)
+}
+
+// read methods for data objects
+
+// GetDataObject reads a DataObject and resolves its data if it is stored in a blob store.
+func GetDataObject(ctx context.Context, c client.Reader, key client.ObjectKey, do *lsv1alpha1.DataObject) error {
+	if err := get(ctx, c, key, do); err != nil {
+		return err
+	}
+	return resolveData(ctx, do)
+}
+
+// ListDataObjects lists DataObjects and resolves their data if it is stored in a blob store.
+func ListDataObjects(ctx context.Context, c client.Reader, dataObjects *lsv1alpha1.DataObjectList, opts ...client.ListOption) error {
+	if err := list(ctx, c, dataObjects, opts...); err != nil {
+		return err
+	}
+	for i := range dataObjects.Items {
+		if err := resolveData(ctx, &dataObjects.Items[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // basic functions
 func get(ctx context.Context, c client.Reader, key client.ObjectKey, object client.Object) error {
-	return c.Get(ctx, key, object)
+	if err := c.Get(ctx, key, object); err != nil {
+		return err
+	}
+	rememberObjectState(object)
+	return nil
 }
 
 func list(ctx context.Context, c client.Reader, objects client.ObjectList, opts ...client.ListOption) error {
-	return c.List(ctx, objects, opts...)
+	if err := c.List(ctx, objects, opts...); err != nil {
+		return err
+	}
+	return meta.EachListItem(objects, func(o runtime.Object) error {
+		if object, ok := o.(client.Object); ok {
+			rememberObjectState(object)
+		}
+		return nil
+	})
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/gardener/landscaper/apis/errors"
 
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
@@ -15,12 +16,21 @@ import (
 )
 
 type Writer struct {
-	client client.Client
+	client        client.Client
+	eventRecorder record.EventRecorder
 }
 
+// NewWriter creates a writer that emits its events with the event recorder set by SetEventRecorder.
 func NewWriter(c client.Client) *Writer {
+	return NewWriterWithEventRecorder(c, defaultEventRecorder)
+}
+
+// NewWriterWithEventRecorder creates a writer that emits events for phase transitions, errors, aborts and interrupts
+// of the written objects with the given event recorder. No events are emitted if the event recorder is nil.
+func NewWriterWithEventRecorder(c client.Client, eventRecorder record.EventRecorder) *Writer {
 	return &Writer{
-		client: c,
+		client:        c,
+		eventRecorder: eventRecorder,
 	}
 }
 
@@ -41,14 +51,52 @@ func (w *Writer) CreateOrUpdateCoreTarget(ctx context.Context, writeID WriteID,
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(target)
 	result, err := createOrUpdateCore(ctx, w.client, target, f)
 	w.logTargetUpdate(ctx, writeID, opTargetCreateOrUpdate, target, generationOld, resourceVersionOld, err)
-	return result, errorWithWriteID(err, writeID)
+	lsErr := errorWithWriteID(err, writeID)
+	w.recordEvents(target, nil, lsErr)
+	return result, lsErr
 }
 
 func (w *Writer) DeleteTarget(ctx context.Context, writeID WriteID, target *lsv1alpha1.Target) error {
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(target)
 	err := delete(ctx, w.client, target)
 	w.logTargetUpdate(ctx, writeID, opInstDelete, target, generationOld, resourceVersionOld, err)
-	return errorWithWriteID(err, writeID)
+	lsErr := errorWithWriteID(err, writeID)
+	w.recordDeleteEvents(target, lsErr)
+	return lsErr
+}
+
+// methods for target syncs
+
+func (w *Writer) UpdateTargetSyncStatus(ctx context.Context, writeID WriteID, targetSync *lsv1alpha1.TargetSync) error {
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(targetSync)
+	previous := w.getPreviousState(targetSync)
+	err := updateStatus(ctx, w.client.Status(), targetSync)
+	w.logTargetSyncUpdate(ctx, writeID, opTargetSyncStatus, targetSync, generationOld, resourceVersionOld, err)
+	lsErr := errorWithWriteID(err, writeID)
+	w.recordEvents(targetSync, previous, lsErr)
+	return lsErr
+}
+
+// methods for component version overwrites
+
+func (w *Writer) UpdateComponentVersionOverwritesStatus(ctx context.Context, writeID WriteID, cvo *lsv1alpha1.ComponentVersionOverwrites) error {
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(cvo)
+	previous := w.getPreviousState(cvo)
+	err := updateStatus(ctx, w.client.Status(), cvo)
+	w.logComponentVersionOverwritesUpdate(ctx, writeID, opCVOStatus, cvo, generationOld, resourceVersionOld, err)
+	lsErr := errorWithWriteID(err, writeID)
+	w.recordEvents(cvo, previous, lsErr)
+	return lsErr
+}
+
+// methods for orphan reports
+
+func (w *Writer) CreateOrUpdateOrphanReport(ctx context.Context, writeID WriteID, report *lsv1alpha1.OrphanReport,
+	f controllerutil.MutateFn) (controllerutil.OperationResult, error) {
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(report)
+	result, err := kubernetes.CreateOrUpdate(ctx, w.client, report, f)
+	w.logOrphanReportUpdate(ctx, writeID, opOrphanReportCreateOrUpdate, report, generationOld, resourceVersionOld, err)
+	return result, errorWithWriteID(err, writeID)
 }
 
 // methods for data objects
@@ -56,7 +104,11 @@ func (w *Writer) DeleteTarget(ctx context.Context, writeID WriteID, target *lsv1
 func (w *Writer) CreateOrUpdateCoreDataObject(ctx context.Context, writeID WriteID, do *lsv1alpha1.DataObject,
 	f controllerutil.MutateFn) (controllerutil.OperationResult, error) {
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(do)
-	result, err := createOrUpdateCore(ctx, w.client, do, f)
+	doWrite := &dataObjectWrite{do: do}
+	result, err := createOrUpdateCore(ctx, w.client, do, doWrite.mutate(ctx, f))
+	if err == nil {
+		err = doWrite.finish(ctx)
+	}
 	w.logDataObjectUpdate(ctx, writeID, opDOCreateOrUpdate, do, generationOld, resourceVersionOld, err)
 	return result, errorWithWriteID(err, writeID)
 }
@@ -64,7 +116,11 @@ func (w *Writer) CreateOrUpdateCoreDataObject(ctx context.Context, writeID Write
 func (w *Writer) CreateOrUpdateDataObject(ctx context.Context, writeID WriteID, do *lsv1alpha1.DataObject,
 	f controllerutil.MutateFn) (controllerutil.OperationResult, error) {
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(do)
-	result, err := kubernetes.CreateOrUpdate(ctx, w.client, do, f)
+	doWrite := &dataObjectWrite{do: do}
+	result, err := kubernetes.CreateOrUpdate(ctx, w.client, do, doWrite.mutate(ctx, f))
+	if err == nil {
+		err = doWrite.finish(ctx)
+	}
 	w.logDataObjectUpdate(ctx, writeID, opDOCreateOrUpdate, do, generationOld, resourceVersionOld, err)
 	return result, errorWithWriteID(err, writeID)
 }
@@ -83,7 +139,9 @@ func (w *Writer) CreateOrUpdateInstallation(ctx context.Context, writeID WriteID
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(installation)
 	result, err := kubernetes.CreateOrUpdate(ctx, w.client, installation, f)
 	w.logInstallationUpdate(ctx, writeID, opInstCreateOrUpdate, installation, generationOld, resourceVersionOld, err)
-	return result, errorWithWriteID(err, writeID)
+	lsErr := errorWithWriteID(err, writeID)
+	w.recordEvents(installation, nil, lsErr)
+	return result, lsErr
 }
 
 func (w *Writer) CreateOrUpdateCoreInstallation(ctx context.Context, writeID WriteID, installation *lsv1alpha1.Installation,
@@ -91,28 +149,38 @@ func (w *Writer) CreateOrUpdateCoreInstallation(ctx context.Context, writeID Wri
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(installation)
 	result, err := createOrUpdateCore(ctx, w.client, installation, f)
 	w.logInstallationUpdate(ctx, writeID, opInstSpec, installation, generationOld, resourceVersionOld, err)
-	return result, errorWithWriteID(err, writeID)
+	lsErr := errorWithWriteID(err, writeID)
+	w.recordEvents(installation, nil, lsErr)
+	return result, lsErr
 }
 
 func (w *Writer) UpdateInstallation(ctx context.Context, writeID WriteID, installation *lsv1alpha1.Installation) error {
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(installation)
+	previous := w.getPreviousState(installation)
 	err := update(ctx, w.client, installation)
 	w.logInstallationUpdate(ctx, writeID, opInstSpec, installation, generationOld, resourceVersionOld, err)
-	return errorWithWriteID(err, writeID)
+	lsErr := errorWithWriteID(err, writeID)
+	w.recordEvents(installation, previous, lsErr)
+	return lsErr
 }
 
 func (w *Writer) UpdateInstallationStatus(ctx context.Context, writeID WriteID, installation *lsv1alpha1.Installation) error {
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(installation)
+	previous := w.getPreviousState(installation)
 	err := updateStatus(ctx, w.client.Status(), installation)
 	w.logInstallationUpdate(ctx, writeID, opInstStatus, installation, generationOld, resourceVersionOld, err)
-	return errorWithWriteID(err, writeID)
+	lsErr := errorWithWriteID(err, writeID)
+	w.recordEvents(installation, previous, lsErr)
+	return lsErr
 }
 
 func (w *Writer) DeleteInstallation(ctx context.Context, writeID WriteID, installation *lsv1alpha1.Installation) error {
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(installation)
 	err := delete(ctx, w.client, installation)
 	w.logInstallationUpdate(ctx, writeID, opInstDelete, installation, generationOld, resourceVersionOld, err)
-	return errorWithWriteID(err, writeID)
+	lsErr := errorWithWriteID(err, writeID)
+	w.recordDeleteEvents(installation, lsErr)
+	return lsErr
 }
 
 // methods for executions
@@ -122,28 +190,38 @@ func (w *Writer) CreateOrUpdateExecution(ctx context.Context, writeID WriteID, e
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(execution)
 	result, err := kubernetes.CreateOrUpdate(ctx, w.client, execution, f)
 	w.logExecutionUpdate(ctx, writeID, opExecCreateOrUpdate, execution, generationOld, resourceVersionOld, err)
-	return result, errorWithWriteID(err, writeID)
+	lsErr := errorWithWriteID(err, writeID)
+	w.recordEvents(execution, nil, lsErr)
+	return result, lsErr
 }
 
 func (w *Writer) UpdateExecution(ctx context.Context, writeID WriteID, execution *lsv1alpha1.Execution) error {
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(execution)
+	previous := w.getPreviousState(execution)
 	err := update(ctx, w.client, execution)
 	w.logExecutionUpdate(ctx, writeID, opExecSpec, execution, generationOld, resourceVersionOld, err)
-	return errorWithWriteID(err, writeID)
+	lsErr := errorWithWriteID(err, writeID)
+	w.recordEvents(execution, previous, lsErr)
+	return lsErr
 }
 
 func (w *Writer) UpdateExecutionStatus(ctx context.Context, writeID WriteID, execution *lsv1alpha1.Execution) error {
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(execution)
+	previous := w.getPreviousState(execution)
 	err := updateStatus(ctx, w.client.Status(), execution)
 	w.logExecutionUpdate(ctx, writeID, opExecStatus, execution, generationOld, resourceVersionOld, err)
-	return errorWithWriteID(err, writeID)
+	lsErr := errorWithWriteID(err, writeID)
+	w.recordEvents(execution, previous, lsErr)
+	return lsErr
 }
 
 func (w *Writer) DeleteExecution(ctx context.Context, writeID WriteID, execution *lsv1alpha1.Execution) error {
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(execution)
 	err := delete(ctx, w.client, execution)
 	w.logExecutionUpdate(ctx, writeID, opExecDelete, execution, generationOld, resourceVersionOld, err)
-	return errorWithWriteID(err, writeID)
+	lsErr := errorWithWriteID(err, writeID)
+	w.recordDeleteEvents(execution, lsErr)
+	return lsErr
 }
 
 // methods for deploy items
@@ -153,28 +231,38 @@ func (w *Writer) CreateOrUpdateDeployItem(ctx context.Context, writeID WriteID,
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(deployItem)
 	result, err := kubernetes.CreateOrUpdate(ctx, w.client, deployItem, f)
 	w.logDeployItemUpdate(ctx, writeID, opDICreateOrUpdate, deployItem, generationOld, resourceVersionOld, err)
-	return result, errorWithWriteID(err, writeID)
+	lsErr := errorWithWriteID(err, writeID)
+	w.recordEvents(deployItem, nil, lsErr)
+	return result, lsErr
 }
 
 func (w *Writer) UpdateDeployItem(ctx context.Context, writeID WriteID, deployItem *lsv1alpha1.DeployItem) error {
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(deployItem)
+	previous := w.getPreviousState(deployItem)
 	err := update(ctx, w.client, deployItem)
 	w.logDeployItemUpdate(ctx, writeID, opDISpec, deployItem, generationOld, resourceVersionOld, err)
-	return errorWithWriteID(err, writeID)
+	lsErr := errorWithWriteID(err, writeID)
+	w.recordEvents(deployItem, previous, lsErr)
+	return lsErr
 }
 
 func (w *Writer) UpdateDeployItemStatus(ctx context.Context, writeID WriteID, deployItem *lsv1alpha1.DeployItem) error {
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(deployItem)
+	previous := w.getPreviousState(deployItem)
 	err := updateStatus(ctx, w.client.Status(), deployItem)
 	w.logDeployItemUpdate(ctx, writeID, opDIStatus, deployItem, generationOld, resourceVersionOld, err)
-	return errorWithWriteID(err, writeID)
+	lsErr := errorWithWriteID(err, writeID)
+	w.recordEvents(deployItem, previous, lsErr)
+	return lsErr
 }
 
 func (w *Writer) DeleteDeployItem(ctx context.Context, writeID WriteID, deployItem *lsv1alpha1.DeployItem) error {
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(deployItem)
 	err := delete(ctx, w.client, deployItem)
 	w.logDeployItemUpdate(ctx, writeID, opDIDelete, deployItem, generationOld, resourceVersionOld, err)
-	return errorWithWriteID(err, writeID)
+	lsErr := errorWithWriteID(err, writeID)
+	w.recordDeleteEvents(deployItem, lsErr)
+	return lsErr
 }
 
 // base methods
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targettypes
+
+import (
+	"embed"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+)
+
+//go:embed schemas/*.json
+var schemas embed.FS
+
+// builtinTargetTypes maps the built-in target types to the file names of their schemas.
+// The kubernetes cluster target type is not part of it, because targets of this type reference a plain kubeconfig.
+var builtinTargetTypes = map[lsv1alpha1.TargetType]string{
+	targettypes.SSHTargetType:          "schemas/ssh.json",
+	targettypes.HTTPEndpointTargetType: "schemas/http-endpoint.json",
+	targettypes.OCIRegistryTargetType:  "schemas/oci-registry.json",
+}
+
+var defaultRegistry = mustNewDefaultRegistry()
+
+// DefaultRegistry returns the registry which contains the built-in target types.
+// Additional target types can be registered at this registry.
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// NewDefaultRegistry creates a new registry which contains the built-in target types.
+func NewDefaultRegistry() (*Registry, error) {
+	r := NewRegistry()
+	for targetType, file := range builtinTargetTypes {
+		schema, err := schemas.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.Register(TargetTypeDefinition{Type: targetType, Schema: schema}); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+func mustNewDefaultRegistry() *Registry {
+	r, err := NewDefaultRegistry()
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targettypes
+
+import (
+	"fmt"
+	"sync"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	lscore "github.com/gardener/landscaper/apis/core"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
+)
+
+// omittedValue replaces the values of target configurations in validation errors.
+const omittedValue = "<omitted>"
+
+// TargetTypeDefinition describes a target type and the schema of its configuration.
+type TargetTypeDefinition struct {
+	// Type is the target type.
+	Type lsv1alpha1.TargetType
+	// Schema is the JSON schema of the target configuration.
+	Schema []byte
+}
+
+// Registry is a registry of target types.
+// The configuration of targets with a registered type is validated against the schema of the type.
+// Targets of other types are treated as opaque.
+type Registry struct {
+	mux         sync.RWMutex
+	definitions map[lsv1alpha1.TargetType]TargetTypeDefinition
+}
+
+// NewRegistry creates a new empty target type registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		definitions: map[lsv1alpha1.TargetType]TargetTypeDefinition{},
+	}
+}
+
+// Register adds a target type to the registry.
+// It returns an error if the schema is invalid or the type is already registered.
+func (r *Registry) Register(def TargetTypeDefinition) error {
+	if len(def.Type) == 0 {
+		return fmt.Errorf("target type must not be empty")
+	}
+	if err := jsonschema.ValidateSchema(def.Schema); err != nil {
+		return fmt.Errorf("invalid schema for target type %q: %w", def.Type, err)
+	}
+
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if _, ok := r.definitions[def.Type]; ok {
+		return fmt.Errorf("target type %q is already registered", def.Type)
+	}
+	r.definitions[def.Type] = def
+	return nil
+}
+
+// Get returns the definition of the given target type.
+func (r *Registry) Get(targetType lsv1alpha1.TargetType) (TargetTypeDefinition, bool) {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	def, ok := r.definitions[targetType]
+	return def, ok
+}
+
+// ValidateConfiguration validates the given target configuration against the schema of its target type.
+// Configurations of unregistered target types are always valid.
+func (r *Registry) ValidateConfiguration(targetType lsv1alpha1.TargetType, config []byte) error {
+	def, ok := r.Get(targetType)
+	if !ok {
+		return nil
+	}
+	if err := jsonschema.ValidateBytes(def.Schema, config, nil); err != nil {
+		return fmt.Errorf("invalid configuration for target type %q: %w", targetType, omitValues(err))
+	}
+	return nil
+}
+
+// omitValues removes the invalid values from the field errors of a schema validation,
+// as target configurations may contain credentials.
+func omitValues(err error) error {
+	agg, ok := err.(utilerrors.Aggregate)
+	if !ok {
+		return err
+	}
+	errs := make([]error, 0, len(agg.Errors()))
+	for _, e := range agg.Errors() {
+		if fldErr, ok := e.(*field.Error); ok {
+			omitted := *fldErr
+			omitted.BadValue = omittedValue
+			e = &omitted
+		}
+		errs = append(errs, e)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// ValidateTarget validates the inline configuration of the given target against the schema of its target type.
+// A configuration which is referenced by a secret can only be validated when the target is resolved.
+func (r *Registry) ValidateTarget(target *lscore.Target) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if target.Spec.Configuration == nil {
+		return allErrs
+	}
+
+	fldPath := field.NewPath("spec", "config")
+	if err := r.ValidateConfiguration(lsv1alpha1.TargetType(target.Spec.Type), target.Spec.Configuration.RawMessage); err != nil {
+		// the configuration may contain credentials, so it must not be part of the error
+		allErrs = append(allErrs, field.Invalid(fldPath, omittedValue, err.Error()))
+	}
+	return allErrs
+}
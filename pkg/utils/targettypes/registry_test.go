@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targettypes_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lscore "github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	lstargettypes "github.com/gardener/landscaper/pkg/utils/targettypes"
+)
+
+var _ = Describe("Target Type Registry", func() {
+
+	var registry *lstargettypes.Registry
+
+	BeforeEach(func() {
+		var err error
+		registry, err = lstargettypes.NewDefaultRegistry()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should accept valid configurations of the built-in target types", func() {
+		Expect(registry.ValidateConfiguration(targettypes.SSHTargetType,
+			[]byte(`{"host": "example.com", "port": 2222, "user": "admin", "privateKey": "key"}`))).To(Succeed())
+		Expect(registry.ValidateConfiguration(targettypes.HTTPEndpointTargetType,
+			[]byte(`{"url": "https://example.com", "auth": {"token": "abc"}}`))).To(Succeed())
+		Expect(registry.ValidateConfiguration(targettypes.OCIRegistryTargetType,
+			[]byte(`{"host": "eu.gcr.io", "username": "user", "password": "pw"}`))).To(Succeed())
+	})
+
+	It("should reject invalid configurations of the built-in target types", func() {
+		Expect(registry.ValidateConfiguration(targettypes.SSHTargetType,
+			[]byte(`{"host": "example.com", "user": "admin"}`))).ToNot(Succeed())
+		Expect(registry.ValidateConfiguration(targettypes.HTTPEndpointTargetType,
+			[]byte(`{"url": "https://example.com", "auth": {"username": "user", "password": "pw", "token": "abc"}}`))).ToNot(Succeed())
+		Expect(registry.ValidateConfiguration(targettypes.OCIRegistryTargetType,
+			[]byte(`{"host": "eu.gcr.io", "username": "user"}`))).ToNot(Succeed())
+	})
+
+	It("should accept any configuration of unregistered target types", func() {
+		Expect(registry.ValidateConfiguration(targettypes.KubernetesClusterTargetType, []byte(`{"kubeconfig": "abc"}`))).To(Succeed())
+		Expect(registry.ValidateConfiguration("example.com/custom", []byte(`"abc"`))).To(Succeed())
+	})
+
+	It("should validate the inline configuration of targets against custom target types", func() {
+		Expect(registry.Register(lstargettypes.TargetTypeDefinition{
+			Type:   "example.com/custom",
+			Schema: []byte(`{"type": "object", "required": ["name"]}`),
+		})).To(Succeed())
+
+		target := &lscore.Target{}
+		target.Spec.Type = "example.com/custom"
+		target.Spec.Configuration = &lscore.AnyJSON{RawMessage: []byte(`{"name": "abc"}`)}
+		Expect(registry.ValidateTarget(target)).To(BeEmpty())
+
+		target.Spec.Configuration = &lscore.AnyJSON{RawMessage: []byte(`{"password": "my-secret-value"}`)}
+		allErrs := registry.ValidateTarget(target)
+		Expect(allErrs).To(HaveLen(1))
+		Expect(allErrs.ToAggregate().Error()).ToNot(ContainSubstring("my-secret-value"))
+	})
+
+	It("should not register a target type twice", func() {
+		err := registry.Register(lstargettypes.TargetTypeDefinition{
+			Type:   targettypes.SSHTargetType,
+			Schema: []byte(`{"type": "object"}`),
+		})
+		Expect(err).To(HaveOccurred())
+		_, ok := registry.Get(targettypes.SSHTargetType)
+		Expect(ok).To(BeTrue())
+	})
+
+})
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/apis/config"
+)
+
+const (
+	// TracerName is the name of the tracer that is used by all landscaper components.
+	TracerName = "github.com/gardener/landscaper"
+
+	// DefaultServiceName is the default service name that is reported with the traces of the landscaper.
+	DefaultServiceName = "landscaper"
+
+	// KeyJobID is the attribute key of the job id of the reconciled object.
+	KeyJobID = attribute.Key("landscaper.jobID")
+	// KeyKind is the attribute key of the kind of the reconciled object.
+	KeyKind = attribute.Key("landscaper.kind")
+	// KeyName is the attribute key of the name of the reconciled object.
+	KeyName = attribute.Key("landscaper.name")
+	// KeyNamespace is the attribute key of the namespace of the reconciled object.
+	KeyNamespace = attribute.Key("landscaper.namespace")
+	// KeyDeployItemType is the attribute key of the type of the reconciled deploy item.
+	KeyDeployItemType = attribute.Key("landscaper.deployItemType")
+)
+
+// ShutdownFunc flushes all pending spans and stops the export of traces.
+type ShutdownFunc func(ctx context.Context) error
+
+// Setup configures the global tracer provider to export all spans to the OTLP collector of the given configuration.
+// If no configuration is given, tracing stays disabled and all spans are dropped.
+func Setup(ctx context.Context, cfg *config.TracingConfiguration) (ShutdownFunc, error) {
+	if cfg == nil {
+		return func(_ context.Context) error { return nil }, nil
+	}
+	if len(cfg.Endpoint) == 0 {
+		return nil, errors.New("an endpoint must be provided for the export of traces")
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create trace exporter: %w", err)
+	}
+
+	serviceName := cfg.ServiceName
+	if len(serviceName) == 0 {
+		serviceName = DefaultServiceName
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer of the landscaper.
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// StartJobSpan starts the span of the reconcile of an installation, execution or deploy item.
+// The trace id of the span is derived from the job id of the object. As the job id is passed from an installation
+// to its subinstallations, execution and deploy items, the spans of all objects that are processed in the same job
+// belong to the same trace.
+func StartJobSpan(ctx context.Context, name, jobID string, obj client.Object, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if len(jobID) != 0 {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, JobSpanContext(jobID))
+	}
+	attrs = append(attrs,
+		KeyJobID.String(jobID),
+		KeyName.String(obj.GetName()),
+		KeyNamespace.String(obj.GetNamespace()),
+	)
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// StartSpan starts a child span of the span in the given context.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records the given error, if any, and ends the span.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// JobSpanContext returns the span context that is used as the parent of all spans of the given job.
+// It is computed from the job id, so that it is the same in all controllers and deployers.
+func JobSpanContext(jobID string) trace.SpanContext {
+	sum := sha256.Sum256([]byte(jobID))
+	var (
+		traceID trace.TraceID
+		spanID  trace.SpanID
+	)
+	copy(traceID[:], sum[:16])
+	copy(spanID[:], sum[16:24])
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/utils/tracing"
+)
+
+var _ = Describe("Tracing", func() {
+
+	var (
+		recorder         *tracetest.SpanRecorder
+		previousProvider trace.TracerProvider
+	)
+
+	BeforeEach(func() {
+		previousProvider = otel.GetTracerProvider()
+		recorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	})
+
+	AfterEach(func() {
+		otel.SetTracerProvider(previousProvider)
+	})
+
+	It("should not export traces if no configuration is given", func() {
+		shutdown, err := tracing.Setup(context.Background(), nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(shutdown(context.Background())).To(Succeed())
+	})
+
+	It("should derive the same trace for all objects of a job", func() {
+		inst := &lsv1alpha1.Installation{ObjectMeta: metav1.ObjectMeta{Name: "inst", Namespace: "default"}}
+		exec := &lsv1alpha1.Execution{ObjectMeta: metav1.ObjectMeta{Name: "exec", Namespace: "default"}}
+
+		_, instSpan := tracing.StartJobSpan(context.Background(), "Installation", "job-1", inst)
+		tracing.EndSpan(instSpan, nil)
+		ctx, execSpan := tracing.StartJobSpan(context.Background(), "Execution", "job-1", exec)
+		_, childSpan := tracing.StartSpan(ctx, "CollectExports")
+		tracing.EndSpan(childSpan, errors.New("export failed"))
+		tracing.EndSpan(execSpan, nil)
+		_, otherSpan := tracing.StartJobSpan(context.Background(), "Installation", "job-2", inst)
+		tracing.EndSpan(otherSpan, nil)
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(4))
+		jobSpanContext := tracing.JobSpanContext("job-1")
+		Expect(spans[0].SpanContext().TraceID()).To(Equal(jobSpanContext.TraceID()))
+		Expect(spans[0].Parent().SpanID()).To(Equal(jobSpanContext.SpanID()))
+		Expect(spans[0].Attributes()).To(ContainElement(tracing.KeyJobID.String("job-1")))
+
+		Expect(spans[1].Name()).To(Equal("CollectExports"))
+		Expect(spans[1].SpanContext().TraceID()).To(Equal(jobSpanContext.TraceID()))
+		Expect(spans[1].Parent().SpanID()).To(Equal(spans[2].SpanContext().SpanID()))
+		Expect(spans[1].Status().Code).To(Equal(codes.Error))
+
+		Expect(spans[2].SpanContext().TraceID()).To(Equal(jobSpanContext.TraceID()))
+		Expect(spans[3].SpanContext().TraceID()).ToNot(Equal(jobSpanContext.TraceID()))
+	})
+
+})
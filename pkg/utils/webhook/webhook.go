@@ -20,8 +20,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	lscore "github.com/gardener/landscaper/apis/core"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/apis/core/validation"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/utils/targettypes"
 )
 
 // ValidatorFromResourceType is a helper method that gets a resource type and returns the fitting validator
@@ -37,6 +39,8 @@ func ValidatorFromResourceType(log logging.Logger, kubeClient client.Client, sch
 		val = &ExecutionValidator{abstrVal}
 	case "targets":
 		val = &TargetValidator{abstrVal}
+	case "exportgrants":
+		val = &ExportGrantValidator{abstrVal}
 	default:
 		return nil, fmt.Errorf("unable to find validator for resource type %q", resource)
 	}
@@ -189,9 +193,46 @@ func (ev *ExecutionValidator) handlePrivate(ctx context.Context, req admission.R
 		return admission.Denied(errs.ToAggregate().Error())
 	}
 
+	var oldExec *lscore.Execution
+	if req.Operation == admissionv1.Update {
+		oldExec = &lscore.Execution{}
+		if _, _, err := ev.decoder.Decode(req.OldObject.Raw, nil, oldExec); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+	}
+	if err := validateExecutionApproval(req, exec, oldExec); err != nil {
+		logger.Debug("rejecting approval", "error", err.Error())
+		return admission.Errored(http.StatusForbidden, err)
+	}
+
 	return admission.Allowed("Execution is valid")
 }
 
+// validateExecutionApproval verifies the identity of the operator who approves a generation of an execution.
+// Whenever the approval annotations are set or changed, the approved-by annotation must contain the name
+// of the user who sends the request. Removing the annotations is always allowed.
+func validateExecutionApproval(req admission.Request, exec, oldExec *lscore.Execution) *field.Error {
+	approvedGeneration := exec.Annotations[lsv1alpha1.ApproveGenerationAnnotation]
+	approvedBy := exec.Annotations[lsv1alpha1.ApprovedByAnnotation]
+	if len(approvedGeneration) == 0 && len(approvedBy) == 0 {
+		return nil
+	}
+	if oldExec != nil &&
+		oldExec.Annotations[lsv1alpha1.ApproveGenerationAnnotation] == approvedGeneration &&
+		oldExec.Annotations[lsv1alpha1.ApprovedByAnnotation] == approvedBy {
+		return nil
+	}
+
+	fldPath := field.NewPath("metadata", "annotations").Key(lsv1alpha1.ApprovedByAnnotation)
+	if len(req.UserInfo.Username) == 0 {
+		return field.Forbidden(fldPath, "the identity of the approving user cannot be verified")
+	}
+	if approvedBy != req.UserInfo.Username {
+		return field.Forbidden(fldPath, fmt.Sprintf("must be set to the name of the approving user %q", req.UserInfo.Username))
+	}
+	return nil
+}
+
 // TARGET
 
 // TargetValidator represents a validator for a Target
@@ -224,5 +265,44 @@ func (tv *TargetValidator) handlePrivate(ctx context.Context, req admission.Requ
 		return admission.Denied(errs.ToAggregate().Error())
 	}
 
+	if errs := targettypes.DefaultRegistry().ValidateTarget(t); len(errs) > 0 {
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+
 	return admission.Allowed("Target is valid")
 }
+
+// EXPORTGRANT
+
+// ExportGrantValidator represents a validator for an ExportGrant
+type ExportGrantValidator struct{ abstractValidator }
+
+// Handle handles a request to the webhook
+func (egv *ExportGrantValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	logger := egv.log.WithValues(lc.KeyResourceGroup, req.Kind.Group, lc.KeyResourceKind, req.Kind.Kind, lc.KeyResourceVersion, req.Kind.Version)
+	ctx = logging.NewContext(ctx, logger)
+
+	timeBefore := time.Now()
+	result := egv.handlePrivate(ctx, req)
+
+	logIfDurationExceeded(ctx, timeBefore)
+
+	return result
+}
+
+func (egv *ExportGrantValidator) handlePrivate(ctx context.Context, req admission.Request) admission.Response {
+	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "ExportGrantValidator.handlePrivate"})
+
+	logger.Debug("Received request")
+
+	grant := &lscore.ExportGrant{}
+	if _, _, err := egv.decoder.Decode(req.Object.Raw, nil, grant); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if errs := validation.ValidateExportGrant(grant); len(errs) > 0 {
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+
+	return admission.Allowed("ExportGrant is valid")
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/utils/webhook"
+)
+
+var _ = Describe("Execution Validator", func() {
+
+	var validator webhook.GenericValidator
+
+	BeforeEach(func() {
+		var err error
+		validator, err = webhook.ValidatorFromResourceType(logging.Discard(), nil, api.LandscaperScheme, "executions")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	newExecution := func(annotations map[string]string) runtime.RawExtension {
+		exec := &lsv1alpha1.Execution{}
+		exec.TypeMeta = metav1.TypeMeta{
+			APIVersion: lsv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "Execution",
+		}
+		exec.Name = "exec"
+		exec.Namespace = "default"
+		exec.Generation = 2
+		exec.Annotations = annotations
+		raw, err := json.Marshal(exec)
+		Expect(err).ToNot(HaveOccurred())
+		return runtime.RawExtension{Raw: raw}
+	}
+
+	newUpdateRequest := func(user string, oldAnnotations, annotations map[string]string) admission.Request {
+		return admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Update,
+				UserInfo:  authenticationv1.UserInfo{Username: user},
+				OldObject: newExecution(oldAnnotations),
+				Object:    newExecution(annotations),
+			},
+		}
+	}
+
+	approval := func(approvedBy string) map[string]string {
+		annotations := map[string]string{lsv1alpha1.ApproveGenerationAnnotation: "2"}
+		if len(approvedBy) != 0 {
+			annotations[lsv1alpha1.ApprovedByAnnotation] = approvedBy
+		}
+		return annotations
+	}
+
+	It("should allow an approval by the user of the request", func() {
+		res := validator.Handle(context.Background(), newUpdateRequest("operator", nil, approval("operator")))
+		Expect(res.Allowed).To(BeTrue())
+	})
+
+	It("should reject an approval without or with a different approving user", func() {
+		res := validator.Handle(context.Background(), newUpdateRequest("operator", nil, approval("")))
+		Expect(res.Allowed).To(BeFalse())
+
+		res = validator.Handle(context.Background(), newUpdateRequest("operator", nil, approval("admin")))
+		Expect(res.Allowed).To(BeFalse())
+	})
+
+	It("should allow unchanged and removed approval annotations", func() {
+		res := validator.Handle(context.Background(), newUpdateRequest("landscaper", approval("operator"), approval("operator")))
+		Expect(res.Allowed).To(BeTrue())
+
+		res = validator.Handle(context.Background(), newUpdateRequest("landscaper", approval("operator"), nil))
+		Expect(res.Allowed).To(BeTrue())
+	})
+
+})